@@ -2,23 +2,41 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/keu-5/muzee/backend/config"
 	_ "github.com/keu-5/muzee/backend/docs"
+	"github.com/keu-5/muzee/backend/internal/domain"
 	"github.com/keu-5/muzee/backend/internal/helper"
 	"github.com/keu-5/muzee/backend/internal/infrastructure"
 	interfacepkg "github.com/keu-5/muzee/backend/internal/interface"
 	"github.com/keu-5/muzee/backend/internal/interface/handler"
 	"github.com/keu-5/muzee/backend/internal/repository"
 	"github.com/keu-5/muzee/backend/internal/usecase"
+	"github.com/keu-5/muzee/backend/internal/util"
 	_ "github.com/lib/pq"
-	"github.com/redis/go-redis/v9"
 	"go.uber.org/fx"
 )
 
-func NewFiberApp() *fiber.App {
-	return fiber.New()
+// NewFiberApp creates the app; client IPs are read from X-Real-IP only when the request comes from a trusted proxy.
+// Without trusted proxies every request behind a proxy shares the proxy's IP, which merges all IP-keyed rate limits
+// into one bucket, so production refuses to start in that configuration.
+func NewFiberApp(cfg *config.Config, logger *infrastructure.Logger) (*fiber.App, error) {
+	if cfg.ProxyHeader != "" && len(cfg.TrustedProxies) == 0 {
+		if cfg.GOEnv == "production" {
+			return nil, fmt.Errorf("PROXY_HEADER is %q but TRUSTED_PROXIES is empty; set TRUSTED_PROXIES to the reverse proxy address, or clear PROXY_HEADER when not behind a proxy", cfg.ProxyHeader)
+		}
+		logger.Warnw("PROXY_HEADER is ignored because TRUSTED_PROXIES is empty; client IPs will be the proxy address", "proxy_header", cfg.ProxyHeader)
+	}
+
+	return fiber.New(fiber.Config{
+		ProxyHeader:             cfg.ProxyHeader,
+		EnableTrustedProxyCheck: true,
+		TrustedProxies:          cfg.TrustedProxies,
+	}), nil
 }
 
 func LogConfigLoaded(cfg *config.Config, logger *infrastructure.Logger) {
@@ -47,9 +65,91 @@ func RegisterRoutes(
 	authHandler *handler.AuthHandler,
 	userHandler *handler.UserHandler,
 	userProfileHandler *handler.UserProfileHandler,
+	sessionHandler *handler.SessionHandler,
+	jwksHandler *handler.JWKSHandler,
+	mfaHandler *handler.MFAHandler,
+	oidcHandler *handler.OIDCHandler,
+	accountHandler *handler.AccountHandler,
+	magicLinkHandler *handler.MagicLinkHandler,
+	deviceHandler *handler.DeviceHandler,
+	securityEventHandler *handler.SecurityEventHandler,
+	roleHandler *handler.RoleHandler,
+	personalAccessTokenHandler *handler.PersonalAccessTokenHandler,
+	jwtKeys *util.JWTKeySet,
+	sessionHelper *helper.SessionHelper,
+	patUC usecase.PersonalAccessTokenUsecase,
+	rateLimiter *helper.RateLimiter,
 	cfg *config.Config,
 ) {
-	interfacepkg.RegisterRoutes(app, testHandler, authHandler, userHandler, userProfileHandler, cfg)
+	interfacepkg.RegisterRoutes(app, testHandler, authHandler, userHandler, userProfileHandler, sessionHandler, jwksHandler, mfaHandler, oidcHandler, accountHandler, magicLinkHandler, deviceHandler, securityEventHandler, roleHandler, personalAccessTokenHandler, jwtKeys, sessionHelper, patUC, rateLimiter, cfg)
+}
+
+// StartAccountDeletionWorker periodically purges accounts whose deletion grace period has ended
+func StartAccountDeletionWorker(lc fx.Lifecycle, deletionUC usecase.AccountDeletionUsecase, cfg *config.Config, logger *infrastructure.Logger) {
+	if cfg.AccountDeletionPurgeInterval <= 0 {
+		logger.Info("Account deletion worker is disabled")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(cfg.AccountDeletionPurgeInterval)
+				defer ticker.Stop()
+
+				for {
+					purged, err := deletionUC.PurgeDueAccounts(ctx)
+					if err != nil {
+						logger.Errorw("Failed to purge deleted accounts", "error", err)
+					}
+					if purged > 0 {
+						logger.Infow("Purged deleted accounts", "count", purged)
+					}
+
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+// BootstrapRoles creates the built-in roles and grants the admin role to the users listed in ADMIN_USER_IDS
+func BootstrapRoles(lc fx.Lifecycle, roleUC usecase.RoleUsecase, cfg *config.Config, logger *infrastructure.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if err := roleUC.EnsureBuiltinRoles(ctx); err != nil {
+				return err
+			}
+
+			for _, userID := range cfg.AdminUserIDs {
+				if err := roleUC.AssignRole(ctx, userID, domain.RoleAdmin); err != nil {
+					if errors.Is(err, usecase.ErrUserNotFound) {
+						logger.Warnw("Skipped admin bootstrap for unknown user", "user_id", userID)
+						continue
+					}
+					return err
+				}
+			}
+			return nil
+		},
+	})
 }
 
 // NewEmailSender provides EmailClient as EmailSender interface for fx
@@ -57,17 +157,99 @@ func NewEmailSender(emailClient *infrastructure.EmailClient) usecase.EmailSender
 	return emailClient
 }
 
+// NewFileDeleter provides StorageService as FileDeleter interface for fx
+func NewFileDeleter(storageService *infrastructure.StorageService) usecase.FileDeleter {
+	return storageService
+}
+
+// NewFileStorage provides StorageService as FileStorage interface for fx
+func NewFileStorage(storageService *infrastructure.StorageService) usecase.FileStorage {
+	return storageService
+}
+
+// NewSessionRevoker provides SessionHelper as SessionRevoker interface for fx
+func NewSessionRevoker(sessionHelper *helper.SessionHelper) usecase.SessionRevoker {
+	return sessionHelper
+}
+
+// NewOIDCProviders builds the configured OIDC providers keyed by name for fx
+func NewOIDCProviders(cfg *config.Config) map[string]usecase.OIDCProvider {
+	providers := make(map[string]usecase.OIDCProvider, len(cfg.OIDCProviders))
+	for _, providerCfg := range cfg.OIDCProviders {
+		providers[providerCfg.Name] = infrastructure.NewOIDCProvider(providerCfg, nil)
+	}
+	return providers
+}
+
+// NewPasswordRangeSource selects where breached passwords are looked up for fx; nil disables the check
+func NewPasswordRangeSource(cfg *config.Config) (helper.PasswordRangeSource, error) {
+	switch cfg.PasswordBreachCheck {
+	case "", "off":
+		return nil, nil
+	case "api":
+		return infrastructure.NewPwnedPasswordsAPI(cfg.PasswordRangeAPIURL, nil), nil
+	case "corpus":
+		if cfg.PasswordBreachCorpusDir == "" {
+			return nil, errors.New("PASSWORD_BREACH_CORPUS_DIR is required when PASSWORD_BREACH_CHECK is corpus")
+		}
+		return infrastructure.NewPwnedPasswordsCorpus(cfg.PasswordBreachCorpusDir), nil
+	default:
+		return nil, fmt.Errorf("unknown PASSWORD_BREACH_CHECK %q", cfg.PasswordBreachCheck)
+	}
+}
+
+// NewJWTKeySet loads the access token signing keys from config for fx
+func NewJWTKeySet(cfg *config.Config) (*util.JWTKeySet, error) {
+	return util.LoadJWTKeySet(cfg.JWTKeyDir, cfg.JWTActiveKeyID, cfg.JWTSecret)
+}
+
 // NewAuthHandlerWithConfig provides AuthHandler with config for fx
 func NewAuthHandlerWithConfig(
 	authUC usecase.AuthUsecase,
 	userUC usecase.UserUsecase,
 	userProfileUC usecase.UserProfileUsecase,
 	emailUC usecase.EmailUsecase,
-	redisClient *redis.Client,
+	mfaUC usecase.MFAUsecase,
+	deviceUC usecase.DeviceUsecase,
+	securityEventUC usecase.SecurityEventUsecase,
+	sessionHelper *helper.SessionHelper,
+	passwordPolicy *helper.PasswordPolicy,
+	jwtKeys *util.JWTKeySet,
 	cfg *config.Config,
 ) *handler.AuthHandler {
-	sessionHelper := helper.NewSessionHelper(redisClient)
-	return handler.NewAuthHandler(authUC, userUC, emailUC, sessionHelper, cfg.JWTSecret, cfg.GOEnv)
+	return handler.NewAuthHandler(authUC, userUC, emailUC, mfaUC, deviceUC, securityEventUC, sessionHelper, passwordPolicy, jwtKeys, cfg.GOEnv)
+}
+
+// NewSessionHandlerWithConfig provides SessionHandler with config for fx
+func NewSessionHandlerWithConfig(sessionHelper *helper.SessionHelper, securityEventUC usecase.SecurityEventUsecase, cfg *config.Config) *handler.SessionHandler {
+	return handler.NewSessionHandler(sessionHelper, securityEventUC, cfg.GOEnv)
+}
+
+// NewAccountHandlerWithConfig provides AccountHandler with config for fx
+func NewAccountHandlerWithConfig(
+	authUC usecase.AuthUsecase,
+	userUC usecase.UserUsecase,
+	emailUC usecase.EmailUsecase,
+	deletionUC usecase.AccountDeletionUsecase,
+	securityEventUC usecase.SecurityEventUsecase,
+	sessionHelper *helper.SessionHelper,
+	passwordPolicy *helper.PasswordPolicy,
+	jwtKeys *util.JWTKeySet,
+	cfg *config.Config,
+) *handler.AccountHandler {
+	return handler.NewAccountHandler(authUC, userUC, emailUC, deletionUC, securityEventUC, sessionHelper, passwordPolicy, jwtKeys, cfg.GOEnv, cfg.AppURL)
+}
+
+// NewMagicLinkHandlerWithConfig provides MagicLinkHandler with config for fx
+func NewMagicLinkHandlerWithConfig(
+	authHandler *handler.AuthHandler,
+	userUC usecase.UserUsecase,
+	emailUC usecase.EmailUsecase,
+	sessionHelper *helper.SessionHelper,
+	jwtKeys *util.JWTKeySet,
+	cfg *config.Config,
+) *handler.MagicLinkHandler {
+	return handler.NewMagicLinkHandler(authHandler, userUC, emailUC, sessionHelper, jwtKeys, cfg.AppURL)
 }
 
 // @title						Muzee API
@@ -90,15 +272,30 @@ func main() {
 			infrastructure.NewMinioClient,
 			infrastructure.NewStorageService,
 			NewEmailSender, // EmailClient -> EmailSender interface adapter
+			NewFileDeleter, // StorageService -> FileDeleter interface adapter
+			NewFileStorage, // StorageService -> FileStorage interface adapter
 			NewFiberApp,
+			NewJWTKeySet,
+			NewOIDCProviders,
+			NewPasswordRangeSource,
 
 			// Helper
 			helper.NewFileHelper,
+			helper.NewSessionHelper,
+			helper.NewRateLimiter,
+			helper.NewPasswordPolicy,
+			NewSessionRevoker, // SessionHelper -> SessionRevoker interface adapter
 
 			// Repository
 			repository.NewTestRepository,
 			repository.NewUserRepository,
 			repository.NewUserProfileRepository,
+			repository.NewUserIdentityRepository,
+			repository.NewAccountDeletionAuditRepository,
+			repository.NewUserDeviceRepository,
+			repository.NewSecurityEventRepository,
+			repository.NewRoleRepository,
+			repository.NewPersonalAccessTokenRepository,
 
 			// Usecase
 			usecase.NewTestUsecase,
@@ -106,18 +303,37 @@ func main() {
 			usecase.NewAuthUsecase,
 			usecase.NewUserProfileUsecase,
 			usecase.NewEmailUsecase,
+			usecase.NewMFAUsecase,
+			usecase.NewOIDCUsecase,
+			usecase.NewAccountDeletionUsecase,
+			usecase.NewDeviceUsecase,
+			usecase.NewSecurityEventUsecase,
+			usecase.NewRoleUsecase,
+			usecase.NewPersonalAccessTokenUsecase,
 
 			// Handler
 			handler.NewTestHandler,
 			NewAuthHandlerWithConfig,
 			handler.NewUserHandler,
 			handler.NewUserProfileHandler,
+			NewSessionHandlerWithConfig,
+			handler.NewJWKSHandler,
+			handler.NewMFAHandler,
+			handler.NewOIDCHandler,
+			NewAccountHandlerWithConfig,
+			NewMagicLinkHandlerWithConfig,
+			handler.NewDeviceHandler,
+			handler.NewSecurityEventHandler,
+			handler.NewRoleHandler,
+			handler.NewPersonalAccessTokenHandler,
 		),
 		fx.Invoke(
 			LogConfigLoaded,
 			infrastructure.AutoMigrate,
+			BootstrapRoles,
 			RegisterRoutes,
 			StartServer,
+			StartAccountDeletionWorker,
 		),
 	).Run()
 }
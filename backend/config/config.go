@@ -2,6 +2,9 @@ package config
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -13,6 +16,7 @@ type Config struct {
 	DBPassword string
 	DBName     string
 	GOEnv      string
+	AppURL     string
 
 	RedisAddr     string
 	RedisPassword string
@@ -28,7 +32,44 @@ type Config struct {
 	ResendEmailDomain string
 	ResendAPIKey      string
 
-	JWTSecret string
+	JWTSecret      string
+	JWTKeyDir      string
+	JWTActiveKeyID string
+
+	MFAEncryptionKey string
+	MFAIssuer        string
+
+	OIDCProviders []OIDCProviderConfig
+
+	AccountDeletionGracePeriod   time.Duration
+	AccountDeletionPurgeInterval time.Duration
+
+	NewDeviceVerification string
+
+	ProxyHeader    string
+	TrustedProxies []string
+
+	AdminUserIDs []int64
+
+	PasswordBreachCheck     string
+	PasswordRangeAPIURL     string
+	PasswordBreachCorpusDir string
+
+	Argon2Memory      uint32
+	Argon2Iterations  uint32
+	Argon2Parallelism uint8
+
+	ReservedUsernames []string
+}
+
+// OIDCProviderConfig configures one OpenID Connect provider for social login
+type OIDCProviderConfig struct {
+	Name         string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
 }
 
 func Load() *Config {
@@ -38,6 +79,7 @@ func Load() *Config {
 	viper.SetDefault("POSTGRES_PASSWORD", "apppassword")
 	viper.SetDefault("POSTGRES_DB", "appdb")
 	viper.SetDefault("GO_ENV", "development")
+	viper.SetDefault("APP_URL", "http://localhost:3000")
 
 	viper.SetDefault("REDIS_ADDR", "redis:6379")
 	viper.SetDefault("REDIS_PASSWORD", "redispassword")
@@ -54,6 +96,43 @@ func Load() *Config {
 	viper.SetDefault("RESEND_API_KEY", "")
 
 	viper.SetDefault("JWT_SECRET", "")
+	viper.SetDefault("JWT_KEY_DIR", "")
+	viper.SetDefault("JWT_ACTIVE_KEY_ID", "")
+
+	viper.SetDefault("MFA_ENCRYPTION_KEY", "")
+	viper.SetDefault("MFA_ISSUER", "Muzee")
+
+	// カンマ区切りのプロバイダ名。各プロバイダはOIDC_<NAME>_*で設定する
+	viper.SetDefault("OIDC_PROVIDERS", "")
+
+	// 退会申請からデータ削除までの猶予期間と、削除ジョブの実行間隔
+	viper.SetDefault("ACCOUNT_DELETION_GRACE_PERIOD", "720h")
+	viper.SetDefault("ACCOUNT_DELETION_PURGE_INTERVAL", "1h")
+
+	// 未登録のclient_idからのログイン時の動作。off: 何もしない, alert: 通知メールを送る, step_up: メールの確認コードを要求する
+	viper.SetDefault("NEW_DEVICE_VERIFICATION", "alert")
+
+	// クライアントIPを渡すヘッダと、それを信頼するプロキシ（カンマ区切りのIPまたはCIDR）。
+	// docker-compose では nginx の固定アドレスを設定する。空のままだと本番環境では起動しない
+	viper.SetDefault("PROXY_HEADER", "X-Real-IP")
+	viper.SetDefault("TRUSTED_PROXIES", "")
+
+	// 管理APIを利用できるユーザーID（カンマ区切り）
+	viper.SetDefault("ADMIN_USER_IDS", "")
+
+	// 漏洩パスワードの照合先。off: 照合しない, api: 範囲APIに問い合わせる, corpus: ローカルのプレフィックス別ファイルを読む。
+	// 未設定の場合、外部APIへの問い合わせは本番環境だけで行う（開発環境やテストでは off）
+	viper.SetDefault("PASSWORD_BREACH_CHECK", "")
+	viper.SetDefault("PASSWORD_RANGE_API_URL", "https://api.pwnedpasswords.com/range/")
+	viper.SetDefault("PASSWORD_BREACH_CORPUS_DIR", "")
+
+	// パスワードハッシュ（Argon2id）のパラメータ。メモリはKiB単位。変更するとログイン時に既存のハッシュが再計算される
+	viper.SetDefault("ARGON2_MEMORY", 64*1024)
+	viper.SetDefault("ARGON2_ITERATIONS", 3)
+	viper.SetDefault("ARGON2_PARALLELISM", 2)
+
+	// 登録できないユーザーネーム（カンマ区切り）。大文字小文字や紛らわしい文字の違いも同じ名前として扱う
+	viper.SetDefault("RESERVED_USERNAMES", "admin,administrator,api,auth,help,login,logout,me,moderator,muzee,official,root,security,settings,signup,staff,support,system,user-profiles,users,www")
 
 	viper.AutomaticEnv()
 
@@ -73,6 +152,7 @@ func Load() *Config {
 		DBPassword: viper.GetString("POSTGRES_PASSWORD"),
 		DBName:     viper.GetString("POSTGRES_DB"),
 		GOEnv:      viper.GetString("GO_ENV"),
+		AppURL:     viper.GetString("APP_URL"),
 
 		RedisAddr:     viper.GetString("REDIS_ADDR"),
 		RedisPassword: viper.GetString("REDIS_PASSWORD"),
@@ -88,8 +168,100 @@ func Load() *Config {
 		ResendEmailDomain: viper.GetString("RESEND_EMAIL_DOMAIN"),
 		ResendAPIKey:      viper.GetString("RESEND_API_KEY"),
 
-		JWTSecret: viper.GetString("JWT_SECRET"),
+		JWTSecret:      viper.GetString("JWT_SECRET"),
+		JWTKeyDir:      viper.GetString("JWT_KEY_DIR"),
+		JWTActiveKeyID: viper.GetString("JWT_ACTIVE_KEY_ID"),
+
+		MFAEncryptionKey: viper.GetString("MFA_ENCRYPTION_KEY"),
+		MFAIssuer:        viper.GetString("MFA_ISSUER"),
+
+		OIDCProviders: loadOIDCProviders(),
+
+		AccountDeletionGracePeriod:   viper.GetDuration("ACCOUNT_DELETION_GRACE_PERIOD"),
+		AccountDeletionPurgeInterval: viper.GetDuration("ACCOUNT_DELETION_PURGE_INTERVAL"),
+
+		NewDeviceVerification: strings.ToLower(strings.TrimSpace(viper.GetString("NEW_DEVICE_VERIFICATION"))),
+
+		ProxyHeader:    viper.GetString("PROXY_HEADER"),
+		TrustedProxies: splitList(viper.GetString("TRUSTED_PROXIES")),
+
+		AdminUserIDs: parseIDList(viper.GetString("ADMIN_USER_IDS")),
+
+		PasswordBreachCheck:     passwordBreachCheck(viper.GetString("PASSWORD_BREACH_CHECK"), viper.GetString("GO_ENV")),
+		PasswordRangeAPIURL:     viper.GetString("PASSWORD_RANGE_API_URL"),
+		PasswordBreachCorpusDir: viper.GetString("PASSWORD_BREACH_CORPUS_DIR"),
+
+		Argon2Memory:      viper.GetUint32("ARGON2_MEMORY"),
+		Argon2Iterations:  viper.GetUint32("ARGON2_ITERATIONS"),
+		Argon2Parallelism: uint8(viper.GetUint("ARGON2_PARALLELISM")),
+
+		ReservedUsernames: splitList(viper.GetString("RESERVED_USERNAMES")),
+	}
+}
+
+// loadOIDCProviders reads OIDC_<NAME>_ISSUER_URL, _CLIENT_ID, _CLIENT_SECRET,
+// _REDIRECT_URL and _SCOPES for every name listed in OIDC_PROVIDERS
+func loadOIDCProviders() []OIDCProviderConfig {
+	var providers []OIDCProviderConfig
+	for _, name := range strings.Split(viper.GetString("OIDC_PROVIDERS"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		scopes := strings.Fields(strings.ReplaceAll(viper.GetString(prefix+"SCOPES"), ",", " "))
+		if len(scopes) == 0 {
+			scopes = []string{"openid", "email", "profile"}
+		}
+
+		providers = append(providers, OIDCProviderConfig{
+			Name:         name,
+			IssuerURL:    viper.GetString(prefix + "ISSUER_URL"),
+			ClientID:     viper.GetString(prefix + "CLIENT_ID"),
+			ClientSecret: viper.GetString(prefix + "CLIENT_SECRET"),
+			RedirectURL:  viper.GetString(prefix + "REDIRECT_URL"),
+			Scopes:       scopes,
+		})
+	}
+	return providers
+}
+
+// splitList splits a comma-separated setting and drops empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseIDList reads a comma-separated list of numeric IDs and skips entries that are not numbers
+func parseIDList(value string) []int64 {
+	var ids []int64
+	for _, item := range splitList(value) {
+		id, err := strconv.ParseInt(item, 10, 64)
+		if err != nil {
+			fmt.Printf("無効なID %q を無視します\n", item)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// passwordBreachCheck normalizes PASSWORD_BREACH_CHECK; when unset, only production queries the external API
+func passwordBreachCheck(value, goEnv string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value != "" {
+		return value
+	}
+	if goEnv == "production" {
+		return "api"
 	}
+	return "off"
 }
 
 func (c *Config) DSN() string {
@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/keu-5/muzee/backend/ent/accountdeletionaudit"
+)
+
+// AccountDeletionAudit is the model entity for the AccountDeletionAudit schema.
+type AccountDeletionAudit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Step holds the value of the "step" field.
+	Step accountdeletionaudit.Step `json:"step,omitempty"`
+	// Status holds the value of the "status" field.
+	Status accountdeletionaudit.Status `json:"status,omitempty"`
+	// Detail holds the value of the "detail" field.
+	Detail *string `json:"detail,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccountDeletionAudit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accountdeletionaudit.FieldID, accountdeletionaudit.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case accountdeletionaudit.FieldStep, accountdeletionaudit.FieldStatus, accountdeletionaudit.FieldDetail:
+			values[i] = new(sql.NullString)
+		case accountdeletionaudit.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccountDeletionAudit fields.
+func (_m *AccountDeletionAudit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accountdeletionaudit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case accountdeletionaudit.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.Int64
+			}
+		case accountdeletionaudit.FieldStep:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field step", values[i])
+			} else if value.Valid {
+				_m.Step = accountdeletionaudit.Step(value.String)
+			}
+		case accountdeletionaudit.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = accountdeletionaudit.Status(value.String)
+			}
+		case accountdeletionaudit.FieldDetail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field detail", values[i])
+			} else if value.Valid {
+				_m.Detail = new(string)
+				*_m.Detail = value.String
+			}
+		case accountdeletionaudit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccountDeletionAudit.
+// This includes values selected through modifiers, order, etc.
+func (_m *AccountDeletionAudit) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AccountDeletionAudit.
+// Note that you need to call AccountDeletionAudit.Unwrap() before calling this method if this AccountDeletionAudit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AccountDeletionAudit) Update() *AccountDeletionAuditUpdateOne {
+	return NewAccountDeletionAuditClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AccountDeletionAudit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AccountDeletionAudit) Unwrap() *AccountDeletionAudit {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccountDeletionAudit is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AccountDeletionAudit) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccountDeletionAudit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Step))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.Detail; v != nil {
+		builder.WriteString("detail=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccountDeletionAudits is a parsable slice of AccountDeletionAudit.
+type AccountDeletionAudits []*AccountDeletionAudit
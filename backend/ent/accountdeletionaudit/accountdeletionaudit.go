@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountdeletionaudit
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the accountdeletionaudit type in the database.
+	Label = "account_deletion_audit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldStep holds the string denoting the step field in the database.
+	FieldStep = "step"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDetail holds the string denoting the detail field in the database.
+	FieldDetail = "detail"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the accountdeletionaudit in the database.
+	Table = "account_deletion_audits"
+)
+
+// Columns holds all SQL columns for accountdeletionaudit fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldStep,
+	FieldStatus,
+	FieldDetail,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DetailValidator is a validator for the "detail" field. It is called by the builders before save.
+	DetailValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Step defines the type for the "step" enum field.
+type Step string
+
+// Step values.
+const (
+	StepScheduled Step = "scheduled"
+	StepSessions  Step = "sessions"
+	StepIcon      Step = "icon"
+	StepBanner    Step = "banner"
+	StepProfile   Step = "profile"
+	StepUser      Step = "user"
+)
+
+func (s Step) String() string {
+	return string(s)
+}
+
+// StepValidator is a validator for the "step" field enum values. It is called by the builders before save.
+func StepValidator(s Step) error {
+	switch s {
+	case StepScheduled, StepSessions, StepIcon, StepBanner, StepProfile, StepUser:
+		return nil
+	default:
+		return fmt.Errorf("accountdeletionaudit: invalid enum value for step field: %q", s)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusSucceeded, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("accountdeletionaudit: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the AccountDeletionAudit queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByStep orders the results by the step field.
+func ByStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStep, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDetail orders the results by the detail field.
+func ByDetail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetail, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountdeletionaudit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/keu-5/muzee/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldEQ(FieldUserID, v))
+}
+
+// Detail applies equality check predicate on the "detail" field. It's identical to DetailEQ.
+func Detail(v string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldEQ(FieldDetail, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldLTE(FieldUserID, v))
+}
+
+// StepEQ applies the EQ predicate on the "step" field.
+func StepEQ(v Step) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldEQ(FieldStep, v))
+}
+
+// StepNEQ applies the NEQ predicate on the "step" field.
+func StepNEQ(v Step) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNEQ(FieldStep, v))
+}
+
+// StepIn applies the In predicate on the "step" field.
+func StepIn(vs ...Step) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldIn(FieldStep, vs...))
+}
+
+// StepNotIn applies the NotIn predicate on the "step" field.
+func StepNotIn(vs ...Step) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNotIn(FieldStep, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// DetailEQ applies the EQ predicate on the "detail" field.
+func DetailEQ(v string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldEQ(FieldDetail, v))
+}
+
+// DetailNEQ applies the NEQ predicate on the "detail" field.
+func DetailNEQ(v string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNEQ(FieldDetail, v))
+}
+
+// DetailIn applies the In predicate on the "detail" field.
+func DetailIn(vs ...string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldIn(FieldDetail, vs...))
+}
+
+// DetailNotIn applies the NotIn predicate on the "detail" field.
+func DetailNotIn(vs ...string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNotIn(FieldDetail, vs...))
+}
+
+// DetailGT applies the GT predicate on the "detail" field.
+func DetailGT(v string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldGT(FieldDetail, v))
+}
+
+// DetailGTE applies the GTE predicate on the "detail" field.
+func DetailGTE(v string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldGTE(FieldDetail, v))
+}
+
+// DetailLT applies the LT predicate on the "detail" field.
+func DetailLT(v string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldLT(FieldDetail, v))
+}
+
+// DetailLTE applies the LTE predicate on the "detail" field.
+func DetailLTE(v string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldLTE(FieldDetail, v))
+}
+
+// DetailContains applies the Contains predicate on the "detail" field.
+func DetailContains(v string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldContains(FieldDetail, v))
+}
+
+// DetailHasPrefix applies the HasPrefix predicate on the "detail" field.
+func DetailHasPrefix(v string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldHasPrefix(FieldDetail, v))
+}
+
+// DetailHasSuffix applies the HasSuffix predicate on the "detail" field.
+func DetailHasSuffix(v string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldHasSuffix(FieldDetail, v))
+}
+
+// DetailIsNil applies the IsNil predicate on the "detail" field.
+func DetailIsNil() predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldIsNull(FieldDetail))
+}
+
+// DetailNotNil applies the NotNil predicate on the "detail" field.
+func DetailNotNil() predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNotNull(FieldDetail))
+}
+
+// DetailEqualFold applies the EqualFold predicate on the "detail" field.
+func DetailEqualFold(v string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldEqualFold(FieldDetail, v))
+}
+
+// DetailContainsFold applies the ContainsFold predicate on the "detail" field.
+func DetailContainsFold(v string) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldContainsFold(FieldDetail, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccountDeletionAudit) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccountDeletionAudit) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccountDeletionAudit) predicate.AccountDeletionAudit {
+	return predicate.AccountDeletionAudit(sql.NotPredicates(p))
+}
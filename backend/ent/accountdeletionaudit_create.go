@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keu-5/muzee/backend/ent/accountdeletionaudit"
+)
+
+// AccountDeletionAuditCreate is the builder for creating a AccountDeletionAudit entity.
+type AccountDeletionAuditCreate struct {
+	config
+	mutation *AccountDeletionAuditMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AccountDeletionAuditCreate) SetUserID(v int64) *AccountDeletionAuditCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetStep sets the "step" field.
+func (_c *AccountDeletionAuditCreate) SetStep(v accountdeletionaudit.Step) *AccountDeletionAuditCreate {
+	_c.mutation.SetStep(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *AccountDeletionAuditCreate) SetStatus(v accountdeletionaudit.Status) *AccountDeletionAuditCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetDetail sets the "detail" field.
+func (_c *AccountDeletionAuditCreate) SetDetail(v string) *AccountDeletionAuditCreate {
+	_c.mutation.SetDetail(v)
+	return _c
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_c *AccountDeletionAuditCreate) SetNillableDetail(v *string) *AccountDeletionAuditCreate {
+	if v != nil {
+		_c.SetDetail(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccountDeletionAuditCreate) SetCreatedAt(v time.Time) *AccountDeletionAuditCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccountDeletionAuditCreate) SetNillableCreatedAt(v *time.Time) *AccountDeletionAuditCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AccountDeletionAuditCreate) SetID(v int64) *AccountDeletionAuditCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AccountDeletionAuditMutation object of the builder.
+func (_c *AccountDeletionAuditCreate) Mutation() *AccountDeletionAuditMutation {
+	return _c.mutation
+}
+
+// Save creates the AccountDeletionAudit in the database.
+func (_c *AccountDeletionAuditCreate) Save(ctx context.Context) (*AccountDeletionAudit, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccountDeletionAuditCreate) SaveX(ctx context.Context) *AccountDeletionAudit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountDeletionAuditCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountDeletionAuditCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccountDeletionAuditCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := accountdeletionaudit.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccountDeletionAuditCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AccountDeletionAudit.user_id"`)}
+	}
+	if _, ok := _c.mutation.Step(); !ok {
+		return &ValidationError{Name: "step", err: errors.New(`ent: missing required field "AccountDeletionAudit.step"`)}
+	}
+	if v, ok := _c.mutation.Step(); ok {
+		if err := accountdeletionaudit.StepValidator(v); err != nil {
+			return &ValidationError{Name: "step", err: fmt.Errorf(`ent: validator failed for field "AccountDeletionAudit.step": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AccountDeletionAudit.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := accountdeletionaudit.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AccountDeletionAudit.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Detail(); ok {
+		if err := accountdeletionaudit.DetailValidator(v); err != nil {
+			return &ValidationError{Name: "detail", err: fmt.Errorf(`ent: validator failed for field "AccountDeletionAudit.detail": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccountDeletionAudit.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AccountDeletionAuditCreate) sqlSave(ctx context.Context) (*AccountDeletionAudit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccountDeletionAuditCreate) createSpec() (*AccountDeletionAudit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountDeletionAudit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accountdeletionaudit.Table, sqlgraph.NewFieldSpec(accountdeletionaudit.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(accountdeletionaudit.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Step(); ok {
+		_spec.SetField(accountdeletionaudit.FieldStep, field.TypeEnum, value)
+		_node.Step = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(accountdeletionaudit.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Detail(); ok {
+		_spec.SetField(accountdeletionaudit.FieldDetail, field.TypeString, value)
+		_node.Detail = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(accountdeletionaudit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AccountDeletionAuditCreateBulk is the builder for creating many AccountDeletionAudit entities in bulk.
+type AccountDeletionAuditCreateBulk struct {
+	config
+	err      error
+	builders []*AccountDeletionAuditCreate
+}
+
+// Save creates the AccountDeletionAudit entities in the database.
+func (_c *AccountDeletionAuditCreateBulk) Save(ctx context.Context) ([]*AccountDeletionAudit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccountDeletionAudit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountDeletionAuditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccountDeletionAuditCreateBulk) SaveX(ctx context.Context) []*AccountDeletionAudit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountDeletionAuditCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountDeletionAuditCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
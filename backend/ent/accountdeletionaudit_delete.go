@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keu-5/muzee/backend/ent/accountdeletionaudit"
+	"github.com/keu-5/muzee/backend/ent/predicate"
+)
+
+// AccountDeletionAuditDelete is the builder for deleting a AccountDeletionAudit entity.
+type AccountDeletionAuditDelete struct {
+	config
+	hooks    []Hook
+	mutation *AccountDeletionAuditMutation
+}
+
+// Where appends a list predicates to the AccountDeletionAuditDelete builder.
+func (_d *AccountDeletionAuditDelete) Where(ps ...predicate.AccountDeletionAudit) *AccountDeletionAuditDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AccountDeletionAuditDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AccountDeletionAuditDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AccountDeletionAuditDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(accountdeletionaudit.Table, sqlgraph.NewFieldSpec(accountdeletionaudit.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AccountDeletionAuditDeleteOne is the builder for deleting a single AccountDeletionAudit entity.
+type AccountDeletionAuditDeleteOne struct {
+	_d *AccountDeletionAuditDelete
+}
+
+// Where appends a list predicates to the AccountDeletionAuditDelete builder.
+func (_d *AccountDeletionAuditDeleteOne) Where(ps ...predicate.AccountDeletionAudit) *AccountDeletionAuditDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AccountDeletionAuditDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{accountdeletionaudit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AccountDeletionAuditDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/keu-5/muzee/backend/ent/accountdeletionaudit"
+	"github.com/keu-5/muzee/backend/ent/predicate"
+)
+
+// AccountDeletionAuditUpdate is the builder for updating AccountDeletionAudit entities.
+type AccountDeletionAuditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccountDeletionAuditMutation
+}
+
+// Where appends a list predicates to the AccountDeletionAuditUpdate builder.
+func (_u *AccountDeletionAuditUpdate) Where(ps ...predicate.AccountDeletionAudit) *AccountDeletionAuditUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the AccountDeletionAuditMutation object of the builder.
+func (_u *AccountDeletionAuditUpdate) Mutation() *AccountDeletionAuditMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountDeletionAuditUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountDeletionAuditUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountDeletionAuditUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountDeletionAuditUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AccountDeletionAuditUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(accountdeletionaudit.Table, accountdeletionaudit.Columns, sqlgraph.NewFieldSpec(accountdeletionaudit.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(accountdeletionaudit.FieldDetail, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountdeletionaudit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountDeletionAuditUpdateOne is the builder for updating a single AccountDeletionAudit entity.
+type AccountDeletionAuditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccountDeletionAuditMutation
+}
+
+// Mutation returns the AccountDeletionAuditMutation object of the builder.
+func (_u *AccountDeletionAuditUpdateOne) Mutation() *AccountDeletionAuditMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AccountDeletionAuditUpdate builder.
+func (_u *AccountDeletionAuditUpdateOne) Where(ps ...predicate.AccountDeletionAudit) *AccountDeletionAuditUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountDeletionAuditUpdateOne) Select(field string, fields ...string) *AccountDeletionAuditUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccountDeletionAudit entity.
+func (_u *AccountDeletionAuditUpdateOne) Save(ctx context.Context) (*AccountDeletionAudit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountDeletionAuditUpdateOne) SaveX(ctx context.Context) *AccountDeletionAudit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccountDeletionAuditUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountDeletionAuditUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AccountDeletionAuditUpdateOne) sqlSave(ctx context.Context) (_node *AccountDeletionAudit, err error) {
+	_spec := sqlgraph.NewUpdateSpec(accountdeletionaudit.Table, accountdeletionaudit.Columns, sqlgraph.NewFieldSpec(accountdeletionaudit.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccountDeletionAudit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accountdeletionaudit.FieldID)
+		for _, f := range fields {
+			if !accountdeletionaudit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accountdeletionaudit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(accountdeletionaudit.FieldDetail, field.TypeString)
+	}
+	_node = &AccountDeletionAudit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountdeletionaudit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
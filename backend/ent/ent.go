@@ -12,8 +12,14 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/keu-5/muzee/backend/ent/accountdeletionaudit"
+	"github.com/keu-5/muzee/backend/ent/personalaccesstoken"
+	"github.com/keu-5/muzee/backend/ent/role"
+	"github.com/keu-5/muzee/backend/ent/securityevent"
 	"github.com/keu-5/muzee/backend/ent/test"
 	"github.com/keu-5/muzee/backend/ent/user"
+	"github.com/keu-5/muzee/backend/ent/userdevice"
+	"github.com/keu-5/muzee/backend/ent/useridentity"
 	"github.com/keu-5/muzee/backend/ent/userprofile"
 )
 
@@ -75,9 +81,15 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			test.Table:        test.ValidColumn,
-			user.Table:        user.ValidColumn,
-			userprofile.Table: userprofile.ValidColumn,
+			accountdeletionaudit.Table: accountdeletionaudit.ValidColumn,
+			personalaccesstoken.Table:  personalaccesstoken.ValidColumn,
+			role.Table:                 role.ValidColumn,
+			securityevent.Table:        securityevent.ValidColumn,
+			test.Table:                 test.ValidColumn,
+			user.Table:                 user.ValidColumn,
+			userdevice.Table:           userdevice.ValidColumn,
+			useridentity.Table:         useridentity.ValidColumn,
+			userprofile.Table:          userprofile.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -8,6 +8,133 @@ import (
 )
 
 var (
+	// AccountDeletionAuditsColumns holds the columns for the "account_deletion_audits" table.
+	AccountDeletionAuditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "step", Type: field.TypeEnum, Enums: []string{"scheduled", "sessions", "icon", "banner", "profile", "user"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"succeeded", "failed"}},
+		{Name: "detail", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AccountDeletionAuditsTable holds the schema information for the "account_deletion_audits" table.
+	AccountDeletionAuditsTable = &schema.Table{
+		Name:       "account_deletion_audits",
+		Columns:    AccountDeletionAuditsColumns,
+		PrimaryKey: []*schema.Column{AccountDeletionAuditsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accountdeletionaudit_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccountDeletionAuditsColumns[1]},
+			},
+			{
+				Name:    "accountdeletionaudit_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccountDeletionAuditsColumns[5]},
+			},
+		},
+	}
+	// PersonalAccessTokensColumns holds the columns for the "personal_access_tokens" table.
+	PersonalAccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "token_prefix", Type: field.TypeString, Size: 16},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_access_tokens", Type: field.TypeInt64},
+	}
+	// PersonalAccessTokensTable holds the schema information for the "personal_access_tokens" table.
+	PersonalAccessTokensTable = &schema.Table{
+		Name:       "personal_access_tokens",
+		Columns:    PersonalAccessTokensColumns,
+		PrimaryKey: []*schema.Column{PersonalAccessTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "personal_access_tokens_users_access_tokens",
+				Columns:    []*schema.Column{PersonalAccessTokensColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "personalaccesstoken_token_hash",
+				Unique:  true,
+				Columns: []*schema.Column{PersonalAccessTokensColumns[2]},
+			},
+			{
+				Name:    "personalaccesstoken_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{PersonalAccessTokensColumns[5]},
+			},
+		},
+	}
+	// RolesColumns holds the columns for the "roles" table.
+	RolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "description", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "permissions", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RolesTable holds the schema information for the "roles" table.
+	RolesTable = &schema.Table{
+		Name:       "roles",
+		Columns:    RolesColumns,
+		PrimaryKey: []*schema.Column{RolesColumns[0]},
+	}
+	// SecurityEventsColumns holds the columns for the "security_events" table.
+	SecurityEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "user_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "event_type", Type: field.TypeEnum, Enums: []string{"login", "token_refresh", "logout", "code_sent", "password_changed", "password_reset", "email_changed", "session_revoked", "role_changed", "personal_access_token_created", "personal_access_token_revoked"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"succeeded", "failed"}},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "client_id", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "detail", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SecurityEventsTable holds the schema information for the "security_events" table.
+	SecurityEventsTable = &schema.Table{
+		Name:       "security_events",
+		Columns:    SecurityEventsColumns,
+		PrimaryKey: []*schema.Column{SecurityEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "securityevent_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[1], SecurityEventsColumns[9]},
+			},
+			{
+				Name:    "securityevent_email",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[4]},
+			},
+			{
+				Name:    "securityevent_ip_address",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[5]},
+			},
+			{
+				Name:    "securityevent_event_type_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[2], SecurityEventsColumns[9]},
+			},
+			{
+				Name:    "securityevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[9]},
+			},
+		},
+	}
 	// TestsColumns holds the columns for the "tests" table.
 	TestsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -23,6 +150,11 @@ var (
 		{Name: "id", Type: field.TypeInt64, Increment: true},
 		{Name: "email", Type: field.TypeString, Unique: true, Size: 255},
 		{Name: "password_hash", Type: field.TypeString, Size: 255},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "totp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_last_used_step", Type: field.TypeInt64, Default: 0},
+		{Name: "totp_recovery_code_hashes", Type: field.TypeJSON, Nullable: true},
+		{Name: "deletion_scheduled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -40,7 +172,75 @@ var (
 			{
 				Name:    "user_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[3]},
+				Columns: []*schema.Column{UsersColumns[8]},
+			},
+			{
+				Name:    "user_deletion_scheduled_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[7]},
+			},
+		},
+	}
+	// UserDevicesColumns holds the columns for the "user_devices" table.
+	UserDevicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "client_id", Type: field.TypeString, Size: 255},
+		{Name: "trusted", Type: field.TypeBool, Default: false},
+		{Name: "ip_address", Type: field.TypeString, Size: 64},
+		{Name: "user_agent", Type: field.TypeString, Size: 512},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "user_devices", Type: field.TypeInt64},
+	}
+	// UserDevicesTable holds the schema information for the "user_devices" table.
+	UserDevicesTable = &schema.Table{
+		Name:       "user_devices",
+		Columns:    UserDevicesColumns,
+		PrimaryKey: []*schema.Column{UserDevicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_devices_users_devices",
+				Columns:    []*schema.Column{UserDevicesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userdevice_client_id_user_devices",
+				Unique:  true,
+				Columns: []*schema.Column{UserDevicesColumns[1], UserDevicesColumns[7]},
+			},
+		},
+	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "provider", Type: field.TypeString, Size: 50},
+		{Name: "subject", Type: field.TypeString, Size: 255},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_identities", Type: field.TypeInt64},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_identities_users_identities",
+				Columns:    []*schema.Column{UserIdentitiesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[1], UserIdentitiesColumns[2]},
 			},
 		},
 	}
@@ -49,7 +249,16 @@ var (
 		{Name: "id", Type: field.TypeInt64, Increment: true},
 		{Name: "name", Type: field.TypeString, Size: 100},
 		{Name: "username", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "username_canonical", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "username_skeleton", Type: field.TypeString, Nullable: true, Size: 50},
 		{Name: "icon_path", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "banner_path", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "bio", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "links", Type: field.TypeJSON, Nullable: true},
+		{Name: "location", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "birthday", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "pronouns", Type: field.TypeString, Nullable: true, Size: 40},
+		{Name: "field_visibility", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_profile", Type: field.TypeInt64, Unique: true},
@@ -62,7 +271,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_profiles_users_profile",
-				Columns:    []*schema.Column{UserProfilesColumns[6]},
+				Columns:    []*schema.Column{UserProfilesColumns[15]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -74,20 +283,67 @@ var (
 				Columns: []*schema.Column{UserProfilesColumns[2]},
 			},
 			{
-				Name:    "userprofile_created_at",
+				Name:    "userprofile_username_canonical",
+				Unique:  true,
+				Columns: []*schema.Column{UserProfilesColumns[3]},
+			},
+			{
+				Name:    "userprofile_username_skeleton",
 				Unique:  false,
 				Columns: []*schema.Column{UserProfilesColumns[4]},
 			},
+			{
+				Name:    "userprofile_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserProfilesColumns[13]},
+			},
+		},
+	}
+	// UserRolesColumns holds the columns for the "user_roles" table.
+	UserRolesColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "role_id", Type: field.TypeInt64},
+	}
+	// UserRolesTable holds the schema information for the "user_roles" table.
+	UserRolesTable = &schema.Table{
+		Name:       "user_roles",
+		Columns:    UserRolesColumns,
+		PrimaryKey: []*schema.Column{UserRolesColumns[0], UserRolesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_roles_user_id",
+				Columns:    []*schema.Column{UserRolesColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_roles_role_id",
+				Columns:    []*schema.Column{UserRolesColumns[1]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccountDeletionAuditsTable,
+		PersonalAccessTokensTable,
+		RolesTable,
+		SecurityEventsTable,
 		TestsTable,
 		UsersTable,
+		UserDevicesTable,
+		UserIdentitiesTable,
 		UserProfilesTable,
+		UserRolesTable,
 	}
 )
 
 func init() {
+	PersonalAccessTokensTable.ForeignKeys[0].RefTable = UsersTable
+	UserDevicesTable.ForeignKeys[0].RefTable = UsersTable
+	UserIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
 	UserProfilesTable.ForeignKeys[0].RefTable = UsersTable
+	UserRolesTable.ForeignKeys[0].RefTable = UsersTable
+	UserRolesTable.ForeignKeys[1].RefTable = RolesTable
 }
@@ -5,8 +5,14 @@ package ent
 import (
 	"time"
 
+	"github.com/keu-5/muzee/backend/ent/accountdeletionaudit"
+	"github.com/keu-5/muzee/backend/ent/personalaccesstoken"
+	"github.com/keu-5/muzee/backend/ent/role"
 	"github.com/keu-5/muzee/backend/ent/schema"
+	"github.com/keu-5/muzee/backend/ent/securityevent"
 	"github.com/keu-5/muzee/backend/ent/user"
+	"github.com/keu-5/muzee/backend/ent/userdevice"
+	"github.com/keu-5/muzee/backend/ent/useridentity"
 	"github.com/keu-5/muzee/backend/ent/userprofile"
 )
 
@@ -14,6 +20,128 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accountdeletionauditFields := schema.AccountDeletionAudit{}.Fields()
+	_ = accountdeletionauditFields
+	// accountdeletionauditDescDetail is the schema descriptor for detail field.
+	accountdeletionauditDescDetail := accountdeletionauditFields[4].Descriptor()
+	// accountdeletionaudit.DetailValidator is a validator for the "detail" field. It is called by the builders before save.
+	accountdeletionaudit.DetailValidator = accountdeletionauditDescDetail.Validators[0].(func(string) error)
+	// accountdeletionauditDescCreatedAt is the schema descriptor for created_at field.
+	accountdeletionauditDescCreatedAt := accountdeletionauditFields[5].Descriptor()
+	// accountdeletionaudit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountdeletionaudit.DefaultCreatedAt = accountdeletionauditDescCreatedAt.Default.(func() time.Time)
+	personalaccesstokenFields := schema.PersonalAccessToken{}.Fields()
+	_ = personalaccesstokenFields
+	// personalaccesstokenDescName is the schema descriptor for name field.
+	personalaccesstokenDescName := personalaccesstokenFields[1].Descriptor()
+	// personalaccesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	personalaccesstoken.NameValidator = func() func(string) error {
+		validators := personalaccesstokenDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// personalaccesstokenDescTokenHash is the schema descriptor for token_hash field.
+	personalaccesstokenDescTokenHash := personalaccesstokenFields[2].Descriptor()
+	// personalaccesstoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	personalaccesstoken.TokenHashValidator = func() func(string) error {
+		validators := personalaccesstokenDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// personalaccesstokenDescTokenPrefix is the schema descriptor for token_prefix field.
+	personalaccesstokenDescTokenPrefix := personalaccesstokenFields[3].Descriptor()
+	// personalaccesstoken.TokenPrefixValidator is a validator for the "token_prefix" field. It is called by the builders before save.
+	personalaccesstoken.TokenPrefixValidator = personalaccesstokenDescTokenPrefix.Validators[0].(func(string) error)
+	// personalaccesstokenDescLastUsedIP is the schema descriptor for last_used_ip field.
+	personalaccesstokenDescLastUsedIP := personalaccesstokenFields[7].Descriptor()
+	// personalaccesstoken.LastUsedIPValidator is a validator for the "last_used_ip" field. It is called by the builders before save.
+	personalaccesstoken.LastUsedIPValidator = personalaccesstokenDescLastUsedIP.Validators[0].(func(string) error)
+	// personalaccesstokenDescCreatedAt is the schema descriptor for created_at field.
+	personalaccesstokenDescCreatedAt := personalaccesstokenFields[8].Descriptor()
+	// personalaccesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	personalaccesstoken.DefaultCreatedAt = personalaccesstokenDescCreatedAt.Default.(func() time.Time)
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[1].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = func() func(string) error {
+		validators := roleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// roleDescDescription is the schema descriptor for description field.
+	roleDescDescription := roleFields[2].Descriptor()
+	// role.DefaultDescription holds the default value on creation for the description field.
+	role.DefaultDescription = roleDescDescription.Default.(string)
+	// role.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	role.DescriptionValidator = roleDescDescription.Validators[0].(func(string) error)
+	// roleDescCreatedAt is the schema descriptor for created_at field.
+	roleDescCreatedAt := roleFields[4].Descriptor()
+	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
+	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	// roleDescUpdatedAt is the schema descriptor for updated_at field.
+	roleDescUpdatedAt := roleFields[5].Descriptor()
+	// role.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
+	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	securityeventFields := schema.SecurityEvent{}.Fields()
+	_ = securityeventFields
+	// securityeventDescEmail is the schema descriptor for email field.
+	securityeventDescEmail := securityeventFields[4].Descriptor()
+	// securityevent.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	securityevent.EmailValidator = securityeventDescEmail.Validators[0].(func(string) error)
+	// securityeventDescIPAddress is the schema descriptor for ip_address field.
+	securityeventDescIPAddress := securityeventFields[5].Descriptor()
+	// securityevent.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	securityevent.IPAddressValidator = securityeventDescIPAddress.Validators[0].(func(string) error)
+	// securityeventDescUserAgent is the schema descriptor for user_agent field.
+	securityeventDescUserAgent := securityeventFields[6].Descriptor()
+	// securityevent.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	securityevent.UserAgentValidator = securityeventDescUserAgent.Validators[0].(func(string) error)
+	// securityeventDescClientID is the schema descriptor for client_id field.
+	securityeventDescClientID := securityeventFields[7].Descriptor()
+	// securityevent.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	securityevent.ClientIDValidator = securityeventDescClientID.Validators[0].(func(string) error)
+	// securityeventDescDetail is the schema descriptor for detail field.
+	securityeventDescDetail := securityeventFields[8].Descriptor()
+	// securityevent.DetailValidator is a validator for the "detail" field. It is called by the builders before save.
+	securityevent.DetailValidator = securityeventDescDetail.Validators[0].(func(string) error)
+	// securityeventDescCreatedAt is the schema descriptor for created_at field.
+	securityeventDescCreatedAt := securityeventFields[9].Descriptor()
+	// securityevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	securityevent.DefaultCreatedAt = securityeventDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescEmail is the schema descriptor for email field.
@@ -52,16 +180,120 @@ func init() {
 			return nil
 		}
 	}()
+	// userDescTotpSecret is the schema descriptor for totp_secret field.
+	userDescTotpSecret := userFields[3].Descriptor()
+	// user.TotpSecretValidator is a validator for the "totp_secret" field. It is called by the builders before save.
+	user.TotpSecretValidator = userDescTotpSecret.Validators[0].(func(string) error)
+	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
+	userDescTotpEnabled := userFields[4].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
+	// userDescTotpLastUsedStep is the schema descriptor for totp_last_used_step field.
+	userDescTotpLastUsedStep := userFields[5].Descriptor()
+	// user.DefaultTotpLastUsedStep holds the default value on creation for the totp_last_used_step field.
+	user.DefaultTotpLastUsedStep = userDescTotpLastUsedStep.Default.(int64)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[3].Descriptor()
+	userDescCreatedAt := userFields[8].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[4].Descriptor()
+	userDescUpdatedAt := userFields[9].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userdeviceFields := schema.UserDevice{}.Fields()
+	_ = userdeviceFields
+	// userdeviceDescClientID is the schema descriptor for client_id field.
+	userdeviceDescClientID := userdeviceFields[1].Descriptor()
+	// userdevice.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	userdevice.ClientIDValidator = func() func(string) error {
+		validators := userdeviceDescClientID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userdeviceDescTrusted is the schema descriptor for trusted field.
+	userdeviceDescTrusted := userdeviceFields[2].Descriptor()
+	// userdevice.DefaultTrusted holds the default value on creation for the trusted field.
+	userdevice.DefaultTrusted = userdeviceDescTrusted.Default.(bool)
+	// userdeviceDescIPAddress is the schema descriptor for ip_address field.
+	userdeviceDescIPAddress := userdeviceFields[3].Descriptor()
+	// userdevice.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	userdevice.IPAddressValidator = userdeviceDescIPAddress.Validators[0].(func(string) error)
+	// userdeviceDescUserAgent is the schema descriptor for user_agent field.
+	userdeviceDescUserAgent := userdeviceFields[4].Descriptor()
+	// userdevice.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	userdevice.UserAgentValidator = userdeviceDescUserAgent.Validators[0].(func(string) error)
+	// userdeviceDescCreatedAt is the schema descriptor for created_at field.
+	userdeviceDescCreatedAt := userdeviceFields[5].Descriptor()
+	// userdevice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userdevice.DefaultCreatedAt = userdeviceDescCreatedAt.Default.(func() time.Time)
+	// userdeviceDescLastSeenAt is the schema descriptor for last_seen_at field.
+	userdeviceDescLastSeenAt := userdeviceFields[6].Descriptor()
+	// userdevice.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	userdevice.DefaultLastSeenAt = userdeviceDescLastSeenAt.Default.(func() time.Time)
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescProvider is the schema descriptor for provider field.
+	useridentityDescProvider := useridentityFields[1].Descriptor()
+	// useridentity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	useridentity.ProviderValidator = func() func(string) error {
+		validators := useridentityDescProvider.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(provider string) error {
+			for _, fn := range fns {
+				if err := fn(provider); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// useridentityDescSubject is the schema descriptor for subject field.
+	useridentityDescSubject := useridentityFields[2].Descriptor()
+	// useridentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	useridentity.SubjectValidator = func() func(string) error {
+		validators := useridentityDescSubject.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject string) error {
+			for _, fn := range fns {
+				if err := fn(subject); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// useridentityDescEmail is the schema descriptor for email field.
+	useridentityDescEmail := useridentityFields[3].Descriptor()
+	// useridentity.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	useridentity.EmailValidator = useridentityDescEmail.Validators[0].(func(string) error)
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityFields[4].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
+	// useridentityDescUpdatedAt is the schema descriptor for updated_at field.
+	useridentityDescUpdatedAt := useridentityFields[5].Descriptor()
+	// useridentity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	useridentity.DefaultUpdatedAt = useridentityDescUpdatedAt.Default.(func() time.Time)
+	// useridentity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	useridentity.UpdateDefaultUpdatedAt = useridentityDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userprofileFields := schema.UserProfile{}.Fields()
 	_ = userprofileFields
 	// userprofileDescName is the schema descriptor for name field.
@@ -101,16 +333,40 @@ func init() {
 			return nil
 		}
 	}()
+	// userprofileDescUsernameCanonical is the schema descriptor for username_canonical field.
+	userprofileDescUsernameCanonical := userprofileFields[3].Descriptor()
+	// userprofile.UsernameCanonicalValidator is a validator for the "username_canonical" field. It is called by the builders before save.
+	userprofile.UsernameCanonicalValidator = userprofileDescUsernameCanonical.Validators[0].(func(string) error)
+	// userprofileDescUsernameSkeleton is the schema descriptor for username_skeleton field.
+	userprofileDescUsernameSkeleton := userprofileFields[4].Descriptor()
+	// userprofile.UsernameSkeletonValidator is a validator for the "username_skeleton" field. It is called by the builders before save.
+	userprofile.UsernameSkeletonValidator = userprofileDescUsernameSkeleton.Validators[0].(func(string) error)
 	// userprofileDescIconPath is the schema descriptor for icon_path field.
-	userprofileDescIconPath := userprofileFields[3].Descriptor()
+	userprofileDescIconPath := userprofileFields[5].Descriptor()
 	// userprofile.IconPathValidator is a validator for the "icon_path" field. It is called by the builders before save.
 	userprofile.IconPathValidator = userprofileDescIconPath.Validators[0].(func(string) error)
+	// userprofileDescBannerPath is the schema descriptor for banner_path field.
+	userprofileDescBannerPath := userprofileFields[6].Descriptor()
+	// userprofile.BannerPathValidator is a validator for the "banner_path" field. It is called by the builders before save.
+	userprofile.BannerPathValidator = userprofileDescBannerPath.Validators[0].(func(string) error)
+	// userprofileDescBio is the schema descriptor for bio field.
+	userprofileDescBio := userprofileFields[7].Descriptor()
+	// userprofile.BioValidator is a validator for the "bio" field. It is called by the builders before save.
+	userprofile.BioValidator = userprofileDescBio.Validators[0].(func(string) error)
+	// userprofileDescLocation is the schema descriptor for location field.
+	userprofileDescLocation := userprofileFields[9].Descriptor()
+	// userprofile.LocationValidator is a validator for the "location" field. It is called by the builders before save.
+	userprofile.LocationValidator = userprofileDescLocation.Validators[0].(func(string) error)
+	// userprofileDescPronouns is the schema descriptor for pronouns field.
+	userprofileDescPronouns := userprofileFields[11].Descriptor()
+	// userprofile.PronounsValidator is a validator for the "pronouns" field. It is called by the builders before save.
+	userprofile.PronounsValidator = userprofileDescPronouns.Validators[0].(func(string) error)
 	// userprofileDescCreatedAt is the schema descriptor for created_at field.
-	userprofileDescCreatedAt := userprofileFields[4].Descriptor()
+	userprofileDescCreatedAt := userprofileFields[13].Descriptor()
 	// userprofile.DefaultCreatedAt holds the default value on creation for the created_at field.
 	userprofile.DefaultCreatedAt = userprofileDescCreatedAt.Default.(func() time.Time)
 	// userprofileDescUpdatedAt is the schema descriptor for updated_at field.
-	userprofileDescUpdatedAt := userprofileFields[5].Descriptor()
+	userprofileDescUpdatedAt := userprofileFields[14].Descriptor()
 	// userprofile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	userprofile.DefaultUpdatedAt = userprofileDescUpdatedAt.Default.(func() time.Time)
 	// userprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
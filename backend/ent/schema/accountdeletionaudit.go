@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccountDeletionAudit holds the schema definition for the AccountDeletionAudit entity.
+// Each row records one step of scheduling or purging an account. It has no edge to User
+// because the records must outlive the deleted user.
+type AccountDeletionAudit struct {
+	ent.Schema
+}
+
+// Fields of the AccountDeletionAudit.
+func (AccountDeletionAudit) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+
+		field.Int64("user_id").
+			Immutable(),
+
+		field.Enum("step").
+			Values("scheduled", "sessions", "icon", "banner", "profile", "user").
+			Immutable(),
+
+		field.Enum("status").
+			Values("succeeded", "failed").
+			Immutable(),
+
+		// 削除したオブジェクト名や失敗時のエラー内容
+		field.String("detail").
+			MaxLen(1000).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (AccountDeletionAudit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PersonalAccessToken holds the schema definition for the PersonalAccessToken entity.
+// Long-lived tokens users create for scripts and integrations.
+type PersonalAccessToken struct {
+	ent.Schema
+}
+
+// Fields of the PersonalAccessToken.
+func (PersonalAccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		// トークンはSHA-256ハッシュのみ保存する
+		field.String("token_hash").
+			MaxLen(64).
+			NotEmpty().
+			Unique().
+			Immutable().
+			Sensitive(),
+
+		// 一覧でトークンを見分けるための先頭部分
+		field.String("token_prefix").
+			MaxLen(16).
+			Immutable(),
+
+		field.Strings("scopes").
+			Immutable(),
+
+		field.Time("expires_at").
+			Immutable(),
+
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+
+		field.String("last_used_ip").
+			MaxLen(64).
+			Optional(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PersonalAccessToken.
+func (PersonalAccessToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("access_tokens").
+			Unique().
+			Required(),
+	}
+}
+
+func (PersonalAccessToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token_hash").Unique(),
+		index.Fields("expires_at"),
+	}
+}
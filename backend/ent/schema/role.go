@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Role holds the schema definition for the Role entity.
+// A role is a named set of permissions that can be granted to users.
+type Role struct {
+	ent.Schema
+}
+
+// Fields of the Role.
+func (Role) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+
+		field.String("name").
+			MaxLen(50).
+			NotEmpty().
+			Unique(),
+
+		field.String("description").
+			MaxLen(255).
+			Default(""),
+
+		// 権限名（例: security_events:read）の一覧。アクセストークンにはロールの権限の和集合が入る
+		field.Strings("permissions").
+			Optional(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Role.
+func (Role) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("users", User.Type).
+			Ref("roles"),
+	}
+}
@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SecurityEvent holds the schema definition for the SecurityEvent entity.
+// Each row records one security-relevant action such as a login attempt or a password change.
+// Like AccountDeletionAudit it has no edge to User, so the history outlives deleted users.
+type SecurityEvent struct {
+	ent.Schema
+}
+
+// Fields of the SecurityEvent.
+func (SecurityEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+
+		// ログイン失敗時など、ユーザーを特定できない場合は空
+		field.Int64("user_id").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Enum("event_type").
+			Values(
+				"login",
+				"token_refresh",
+				"logout",
+				"code_sent",
+				"password_changed",
+				"password_reset",
+				"email_changed",
+				"session_revoked",
+				"role_changed",
+				"personal_access_token_created",
+				"personal_access_token_revoked",
+			).
+			Immutable(),
+
+		field.Enum("status").
+			Values("succeeded", "failed").
+			Immutable(),
+
+		// 試行されたメールアドレス（未登録のアドレスでのログイン失敗も追跡できるように保持する）
+		field.String("email").
+			MaxLen(255).
+			Optional().
+			Immutable(),
+
+		field.String("ip_address").
+			MaxLen(64).
+			Optional().
+			Immutable(),
+
+		field.String("user_agent").
+			MaxLen(512).
+			Optional().
+			Immutable(),
+
+		field.String("client_id").
+			MaxLen(255).
+			Optional().
+			Immutable(),
+
+		// ログイン方法、コードの用途、失敗理由など
+		field.String("detail").
+			MaxLen(1000).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (SecurityEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("email"),
+		index.Fields("ip_address"),
+		index.Fields("event_type", "created_at"),
+		index.Fields("created_at"),
+	}
+}
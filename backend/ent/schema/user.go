@@ -29,6 +29,30 @@ func (User) Fields() []ent.Field {
 			NotEmpty().
 			Sensitive(),
 
+		// TOTPシークレットはAES-GCMで暗号化して保存する
+		field.String("totp_secret").
+			MaxLen(255).
+			Optional().
+			Nillable().
+			Sensitive(),
+
+		field.Bool("totp_enabled").
+			Default(false),
+
+		// 同じコードの再利用を防ぐため、最後に受理したタイムステップを保持する
+		field.Int64("totp_last_used_step").
+			Default(0),
+
+		// リカバリーコードはSHA-256ハッシュのみ保存する
+		field.Strings("totp_recovery_code_hashes").
+			Optional().
+			Sensitive(),
+
+		// 退会の猶予期間の終了日時。この日時を過ぎるとアカウントのデータが完全に削除される
+		field.Time("deletion_scheduled_at").
+			Optional().
+			Nillable(),
+
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -45,6 +69,18 @@ func (User) Edges() []ent.Edge {
 		edge.To("profile", UserProfile.Type).
 			Unique().
 			StructTag(`json:"profile,omitempty"`),
+
+		edge.To("identities", UserIdentity.Type).
+			StructTag(`json:"identities,omitempty"`),
+
+		edge.To("devices", UserDevice.Type).
+			StructTag(`json:"devices,omitempty"`),
+
+		edge.To("roles", Role.Type).
+			StructTag(`json:"roles,omitempty"`),
+
+		edge.To("access_tokens", PersonalAccessToken.Type).
+			StructTag(`json:"access_tokens,omitempty"`),
 	}
 }
 
@@ -52,5 +88,6 @@ func (User) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("email").Unique(),
 		index.Fields("created_at"),
+		index.Fields("deletion_scheduled_at"),
 	}
 }
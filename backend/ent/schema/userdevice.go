@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserDevice holds the schema definition for the UserDevice entity.
+// Each row is a client_id the user has completed a login from.
+type UserDevice struct {
+	ent.Schema
+}
+
+// Fields of the UserDevice.
+func (UserDevice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+
+		field.String("client_id").
+			MaxLen(255).
+			NotEmpty().
+			Immutable(),
+
+		// 信頼済みのデバイスは、ステップアップ認証を求めない
+		field.Bool("trusted").
+			Default(false),
+
+		// 最後にログインしたときの接続元（参照用）
+		field.String("ip_address").
+			MaxLen(64),
+
+		field.String("user_agent").
+			MaxLen(512),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		field.Time("last_seen_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the UserDevice.
+func (UserDevice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("devices").
+			Unique().
+			Required(),
+	}
+}
+
+func (UserDevice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("client_id").
+			Edges("user").
+			Unique(),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -20,8 +21,9 @@ func (UserProfile) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("id"),
 
+		// 表示用の文字列はバリデーションと同じく文字数で制限する（MaxLenはバイト数）
 		field.String("name").
-			MaxLen(100).
+			MaxRuneLen(100).
 			NotEmpty(),
 
 		field.String("username").
@@ -30,11 +32,55 @@ func (UserProfile) Fields() []ent.Field {
 			NotEmpty().
 			Unique(),
 
+		// 大文字小文字を区別しない一意性のための小文字化したユーザーネーム
+		field.String("username_canonical").
+			MaxLen(50).
+			Optional().
+			Nillable(),
+
+		// 紛らわしいユーザーネーム（0とo、1とlなど）を検出するための正規化形
+		field.String("username_skeleton").
+			MaxLen(50).
+			Optional().
+			Nillable(),
+
 		field.String("icon_path").
 			MaxLen(255).
 			Optional().
 			Nillable(),
 
+		field.String("banner_path").
+			MaxLen(255).
+			Optional().
+			Nillable(),
+
+		field.String("bio").
+			MaxRuneLen(500).
+			Optional().
+			Nillable(),
+
+		field.Strings("links").
+			Optional(),
+
+		field.String("location").
+			MaxRuneLen(100).
+			Optional().
+			Nillable(),
+
+		field.Time("birthday").
+			SchemaType(map[string]string{dialect.Postgres: "date"}).
+			Optional().
+			Nillable(),
+
+		field.String("pronouns").
+			MaxRuneLen(40).
+			Optional().
+			Nillable(),
+
+		// 項目名 -> 公開範囲（public / followers / only_me）。未設定の項目はpublic
+		field.JSON("field_visibility", map[string]string{}).
+			Optional(),
+
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -58,6 +104,8 @@ func (UserProfile) Edges() []ent.Edge {
 func (UserProfile) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("username").Unique(),
+		index.Fields("username_canonical").Unique(),
+		index.Fields("username_skeleton"),
 		index.Fields("created_at"),
 	}
 }
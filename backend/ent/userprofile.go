@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -22,8 +23,26 @@ type UserProfile struct {
 	Name string `json:"name,omitempty"`
 	// Username holds the value of the "username" field.
 	Username string `json:"username,omitempty"`
+	// UsernameCanonical holds the value of the "username_canonical" field.
+	UsernameCanonical *string `json:"username_canonical,omitempty"`
+	// UsernameSkeleton holds the value of the "username_skeleton" field.
+	UsernameSkeleton *string `json:"username_skeleton,omitempty"`
 	// IconPath holds the value of the "icon_path" field.
 	IconPath *string `json:"icon_path,omitempty"`
+	// BannerPath holds the value of the "banner_path" field.
+	BannerPath *string `json:"banner_path,omitempty"`
+	// Bio holds the value of the "bio" field.
+	Bio *string `json:"bio,omitempty"`
+	// Links holds the value of the "links" field.
+	Links []string `json:"links,omitempty"`
+	// Location holds the value of the "location" field.
+	Location *string `json:"location,omitempty"`
+	// Birthday holds the value of the "birthday" field.
+	Birthday *time.Time `json:"birthday,omitempty"`
+	// Pronouns holds the value of the "pronouns" field.
+	Pronouns *string `json:"pronouns,omitempty"`
+	// FieldVisibility holds the value of the "field_visibility" field.
+	FieldVisibility map[string]string `json:"field_visibility,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -60,11 +79,13 @@ func (*UserProfile) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case userprofile.FieldLinks, userprofile.FieldFieldVisibility:
+			values[i] = new([]byte)
 		case userprofile.FieldID:
 			values[i] = new(sql.NullInt64)
-		case userprofile.FieldName, userprofile.FieldUsername, userprofile.FieldIconPath:
+		case userprofile.FieldName, userprofile.FieldUsername, userprofile.FieldUsernameCanonical, userprofile.FieldUsernameSkeleton, userprofile.FieldIconPath, userprofile.FieldBannerPath, userprofile.FieldBio, userprofile.FieldLocation, userprofile.FieldPronouns:
 			values[i] = new(sql.NullString)
-		case userprofile.FieldCreatedAt, userprofile.FieldUpdatedAt:
+		case userprofile.FieldBirthday, userprofile.FieldCreatedAt, userprofile.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case userprofile.ForeignKeys[0]: // user_profile
 			values[i] = new(sql.NullInt64)
@@ -101,6 +122,20 @@ func (_m *UserProfile) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Username = value.String
 			}
+		case userprofile.FieldUsernameCanonical:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username_canonical", values[i])
+			} else if value.Valid {
+				_m.UsernameCanonical = new(string)
+				*_m.UsernameCanonical = value.String
+			}
+		case userprofile.FieldUsernameSkeleton:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username_skeleton", values[i])
+			} else if value.Valid {
+				_m.UsernameSkeleton = new(string)
+				*_m.UsernameSkeleton = value.String
+			}
 		case userprofile.FieldIconPath:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field icon_path", values[i])
@@ -108,6 +143,57 @@ func (_m *UserProfile) assignValues(columns []string, values []any) error {
 				_m.IconPath = new(string)
 				*_m.IconPath = value.String
 			}
+		case userprofile.FieldBannerPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field banner_path", values[i])
+			} else if value.Valid {
+				_m.BannerPath = new(string)
+				*_m.BannerPath = value.String
+			}
+		case userprofile.FieldBio:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bio", values[i])
+			} else if value.Valid {
+				_m.Bio = new(string)
+				*_m.Bio = value.String
+			}
+		case userprofile.FieldLinks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field links", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Links); err != nil {
+					return fmt.Errorf("unmarshal field links: %w", err)
+				}
+			}
+		case userprofile.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				_m.Location = new(string)
+				*_m.Location = value.String
+			}
+		case userprofile.FieldBirthday:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field birthday", values[i])
+			} else if value.Valid {
+				_m.Birthday = new(time.Time)
+				*_m.Birthday = value.Time
+			}
+		case userprofile.FieldPronouns:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pronouns", values[i])
+			} else if value.Valid {
+				_m.Pronouns = new(string)
+				*_m.Pronouns = value.String
+			}
+		case userprofile.FieldFieldVisibility:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field field_visibility", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FieldVisibility); err != nil {
+					return fmt.Errorf("unmarshal field field_visibility: %w", err)
+				}
+			}
 		case userprofile.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -174,11 +260,52 @@ func (_m *UserProfile) String() string {
 	builder.WriteString("username=")
 	builder.WriteString(_m.Username)
 	builder.WriteString(", ")
+	if v := _m.UsernameCanonical; v != nil {
+		builder.WriteString("username_canonical=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.UsernameSkeleton; v != nil {
+		builder.WriteString("username_skeleton=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.IconPath; v != nil {
 		builder.WriteString("icon_path=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.BannerPath; v != nil {
+		builder.WriteString("banner_path=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Bio; v != nil {
+		builder.WriteString("bio=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("links=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Links))
+	builder.WriteString(", ")
+	if v := _m.Location; v != nil {
+		builder.WriteString("location=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Birthday; v != nil {
+		builder.WriteString("birthday=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Pronouns; v != nil {
+		builder.WriteString("pronouns=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("field_visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FieldVisibility))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -18,8 +18,26 @@ const (
 	FieldName = "name"
 	// FieldUsername holds the string denoting the username field in the database.
 	FieldUsername = "username"
+	// FieldUsernameCanonical holds the string denoting the username_canonical field in the database.
+	FieldUsernameCanonical = "username_canonical"
+	// FieldUsernameSkeleton holds the string denoting the username_skeleton field in the database.
+	FieldUsernameSkeleton = "username_skeleton"
 	// FieldIconPath holds the string denoting the icon_path field in the database.
 	FieldIconPath = "icon_path"
+	// FieldBannerPath holds the string denoting the banner_path field in the database.
+	FieldBannerPath = "banner_path"
+	// FieldBio holds the string denoting the bio field in the database.
+	FieldBio = "bio"
+	// FieldLinks holds the string denoting the links field in the database.
+	FieldLinks = "links"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
+	// FieldBirthday holds the string denoting the birthday field in the database.
+	FieldBirthday = "birthday"
+	// FieldPronouns holds the string denoting the pronouns field in the database.
+	FieldPronouns = "pronouns"
+	// FieldFieldVisibility holds the string denoting the field_visibility field in the database.
+	FieldFieldVisibility = "field_visibility"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -42,7 +60,16 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldUsername,
+	FieldUsernameCanonical,
+	FieldUsernameSkeleton,
 	FieldIconPath,
+	FieldBannerPath,
+	FieldBio,
+	FieldLinks,
+	FieldLocation,
+	FieldBirthday,
+	FieldPronouns,
+	FieldFieldVisibility,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -73,8 +100,20 @@ var (
 	NameValidator func(string) error
 	// UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	UsernameValidator func(string) error
+	// UsernameCanonicalValidator is a validator for the "username_canonical" field. It is called by the builders before save.
+	UsernameCanonicalValidator func(string) error
+	// UsernameSkeletonValidator is a validator for the "username_skeleton" field. It is called by the builders before save.
+	UsernameSkeletonValidator func(string) error
 	// IconPathValidator is a validator for the "icon_path" field. It is called by the builders before save.
 	IconPathValidator func(string) error
+	// BannerPathValidator is a validator for the "banner_path" field. It is called by the builders before save.
+	BannerPathValidator func(string) error
+	// BioValidator is a validator for the "bio" field. It is called by the builders before save.
+	BioValidator func(string) error
+	// LocationValidator is a validator for the "location" field. It is called by the builders before save.
+	LocationValidator func(string) error
+	// PronounsValidator is a validator for the "pronouns" field. It is called by the builders before save.
+	PronounsValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -101,11 +140,46 @@ func ByUsername(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsername, opts...).ToFunc()
 }
 
+// ByUsernameCanonical orders the results by the username_canonical field.
+func ByUsernameCanonical(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsernameCanonical, opts...).ToFunc()
+}
+
+// ByUsernameSkeleton orders the results by the username_skeleton field.
+func ByUsernameSkeleton(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsernameSkeleton, opts...).ToFunc()
+}
+
 // ByIconPath orders the results by the icon_path field.
 func ByIconPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIconPath, opts...).ToFunc()
 }
 
+// ByBannerPath orders the results by the banner_path field.
+func ByBannerPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBannerPath, opts...).ToFunc()
+}
+
+// ByBio orders the results by the bio field.
+func ByBio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBio, opts...).ToFunc()
+}
+
+// ByLocation orders the results by the location field.
+func ByLocation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocation, opts...).ToFunc()
+}
+
+// ByBirthday orders the results by the birthday field.
+func ByBirthday(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBirthday, opts...).ToFunc()
+}
+
+// ByPronouns orders the results by the pronouns field.
+func ByPronouns(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPronouns, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -65,11 +65,46 @@ func Username(v string) predicate.UserProfile {
 	return predicate.UserProfile(sql.FieldEQ(FieldUsername, v))
 }
 
+// UsernameCanonical applies equality check predicate on the "username_canonical" field. It's identical to UsernameCanonicalEQ.
+func UsernameCanonical(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldUsernameCanonical, v))
+}
+
+// UsernameSkeleton applies equality check predicate on the "username_skeleton" field. It's identical to UsernameSkeletonEQ.
+func UsernameSkeleton(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldUsernameSkeleton, v))
+}
+
 // IconPath applies equality check predicate on the "icon_path" field. It's identical to IconPathEQ.
 func IconPath(v string) predicate.UserProfile {
 	return predicate.UserProfile(sql.FieldEQ(FieldIconPath, v))
 }
 
+// BannerPath applies equality check predicate on the "banner_path" field. It's identical to BannerPathEQ.
+func BannerPath(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldBannerPath, v))
+}
+
+// Bio applies equality check predicate on the "bio" field. It's identical to BioEQ.
+func Bio(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldBio, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldLocation, v))
+}
+
+// Birthday applies equality check predicate on the "birthday" field. It's identical to BirthdayEQ.
+func Birthday(v time.Time) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldBirthday, v))
+}
+
+// Pronouns applies equality check predicate on the "pronouns" field. It's identical to PronounsEQ.
+func Pronouns(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldPronouns, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.UserProfile {
 	return predicate.UserProfile(sql.FieldEQ(FieldCreatedAt, v))
@@ -210,6 +245,156 @@ func UsernameContainsFold(v string) predicate.UserProfile {
 	return predicate.UserProfile(sql.FieldContainsFold(FieldUsername, v))
 }
 
+// UsernameCanonicalEQ applies the EQ predicate on the "username_canonical" field.
+func UsernameCanonicalEQ(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalNEQ applies the NEQ predicate on the "username_canonical" field.
+func UsernameCanonicalNEQ(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNEQ(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalIn applies the In predicate on the "username_canonical" field.
+func UsernameCanonicalIn(vs ...string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIn(FieldUsernameCanonical, vs...))
+}
+
+// UsernameCanonicalNotIn applies the NotIn predicate on the "username_canonical" field.
+func UsernameCanonicalNotIn(vs ...string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotIn(FieldUsernameCanonical, vs...))
+}
+
+// UsernameCanonicalGT applies the GT predicate on the "username_canonical" field.
+func UsernameCanonicalGT(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGT(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalGTE applies the GTE predicate on the "username_canonical" field.
+func UsernameCanonicalGTE(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGTE(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalLT applies the LT predicate on the "username_canonical" field.
+func UsernameCanonicalLT(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLT(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalLTE applies the LTE predicate on the "username_canonical" field.
+func UsernameCanonicalLTE(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLTE(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalContains applies the Contains predicate on the "username_canonical" field.
+func UsernameCanonicalContains(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldContains(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalHasPrefix applies the HasPrefix predicate on the "username_canonical" field.
+func UsernameCanonicalHasPrefix(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldHasPrefix(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalHasSuffix applies the HasSuffix predicate on the "username_canonical" field.
+func UsernameCanonicalHasSuffix(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldHasSuffix(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalIsNil applies the IsNil predicate on the "username_canonical" field.
+func UsernameCanonicalIsNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIsNull(FieldUsernameCanonical))
+}
+
+// UsernameCanonicalNotNil applies the NotNil predicate on the "username_canonical" field.
+func UsernameCanonicalNotNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotNull(FieldUsernameCanonical))
+}
+
+// UsernameCanonicalEqualFold applies the EqualFold predicate on the "username_canonical" field.
+func UsernameCanonicalEqualFold(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEqualFold(FieldUsernameCanonical, v))
+}
+
+// UsernameCanonicalContainsFold applies the ContainsFold predicate on the "username_canonical" field.
+func UsernameCanonicalContainsFold(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldContainsFold(FieldUsernameCanonical, v))
+}
+
+// UsernameSkeletonEQ applies the EQ predicate on the "username_skeleton" field.
+func UsernameSkeletonEQ(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldUsernameSkeleton, v))
+}
+
+// UsernameSkeletonNEQ applies the NEQ predicate on the "username_skeleton" field.
+func UsernameSkeletonNEQ(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNEQ(FieldUsernameSkeleton, v))
+}
+
+// UsernameSkeletonIn applies the In predicate on the "username_skeleton" field.
+func UsernameSkeletonIn(vs ...string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIn(FieldUsernameSkeleton, vs...))
+}
+
+// UsernameSkeletonNotIn applies the NotIn predicate on the "username_skeleton" field.
+func UsernameSkeletonNotIn(vs ...string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotIn(FieldUsernameSkeleton, vs...))
+}
+
+// UsernameSkeletonGT applies the GT predicate on the "username_skeleton" field.
+func UsernameSkeletonGT(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGT(FieldUsernameSkeleton, v))
+}
+
+// UsernameSkeletonGTE applies the GTE predicate on the "username_skeleton" field.
+func UsernameSkeletonGTE(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGTE(FieldUsernameSkeleton, v))
+}
+
+// UsernameSkeletonLT applies the LT predicate on the "username_skeleton" field.
+func UsernameSkeletonLT(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLT(FieldUsernameSkeleton, v))
+}
+
+// UsernameSkeletonLTE applies the LTE predicate on the "username_skeleton" field.
+func UsernameSkeletonLTE(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLTE(FieldUsernameSkeleton, v))
+}
+
+// UsernameSkeletonContains applies the Contains predicate on the "username_skeleton" field.
+func UsernameSkeletonContains(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldContains(FieldUsernameSkeleton, v))
+}
+
+// UsernameSkeletonHasPrefix applies the HasPrefix predicate on the "username_skeleton" field.
+func UsernameSkeletonHasPrefix(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldHasPrefix(FieldUsernameSkeleton, v))
+}
+
+// UsernameSkeletonHasSuffix applies the HasSuffix predicate on the "username_skeleton" field.
+func UsernameSkeletonHasSuffix(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldHasSuffix(FieldUsernameSkeleton, v))
+}
+
+// UsernameSkeletonIsNil applies the IsNil predicate on the "username_skeleton" field.
+func UsernameSkeletonIsNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIsNull(FieldUsernameSkeleton))
+}
+
+// UsernameSkeletonNotNil applies the NotNil predicate on the "username_skeleton" field.
+func UsernameSkeletonNotNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotNull(FieldUsernameSkeleton))
+}
+
+// UsernameSkeletonEqualFold applies the EqualFold predicate on the "username_skeleton" field.
+func UsernameSkeletonEqualFold(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEqualFold(FieldUsernameSkeleton, v))
+}
+
+// UsernameSkeletonContainsFold applies the ContainsFold predicate on the "username_skeleton" field.
+func UsernameSkeletonContainsFold(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldContainsFold(FieldUsernameSkeleton, v))
+}
+
 // IconPathEQ applies the EQ predicate on the "icon_path" field.
 func IconPathEQ(v string) predicate.UserProfile {
 	return predicate.UserProfile(sql.FieldEQ(FieldIconPath, v))
@@ -285,6 +470,376 @@ func IconPathContainsFold(v string) predicate.UserProfile {
 	return predicate.UserProfile(sql.FieldContainsFold(FieldIconPath, v))
 }
 
+// BannerPathEQ applies the EQ predicate on the "banner_path" field.
+func BannerPathEQ(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldBannerPath, v))
+}
+
+// BannerPathNEQ applies the NEQ predicate on the "banner_path" field.
+func BannerPathNEQ(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNEQ(FieldBannerPath, v))
+}
+
+// BannerPathIn applies the In predicate on the "banner_path" field.
+func BannerPathIn(vs ...string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIn(FieldBannerPath, vs...))
+}
+
+// BannerPathNotIn applies the NotIn predicate on the "banner_path" field.
+func BannerPathNotIn(vs ...string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotIn(FieldBannerPath, vs...))
+}
+
+// BannerPathGT applies the GT predicate on the "banner_path" field.
+func BannerPathGT(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGT(FieldBannerPath, v))
+}
+
+// BannerPathGTE applies the GTE predicate on the "banner_path" field.
+func BannerPathGTE(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGTE(FieldBannerPath, v))
+}
+
+// BannerPathLT applies the LT predicate on the "banner_path" field.
+func BannerPathLT(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLT(FieldBannerPath, v))
+}
+
+// BannerPathLTE applies the LTE predicate on the "banner_path" field.
+func BannerPathLTE(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLTE(FieldBannerPath, v))
+}
+
+// BannerPathContains applies the Contains predicate on the "banner_path" field.
+func BannerPathContains(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldContains(FieldBannerPath, v))
+}
+
+// BannerPathHasPrefix applies the HasPrefix predicate on the "banner_path" field.
+func BannerPathHasPrefix(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldHasPrefix(FieldBannerPath, v))
+}
+
+// BannerPathHasSuffix applies the HasSuffix predicate on the "banner_path" field.
+func BannerPathHasSuffix(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldHasSuffix(FieldBannerPath, v))
+}
+
+// BannerPathIsNil applies the IsNil predicate on the "banner_path" field.
+func BannerPathIsNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIsNull(FieldBannerPath))
+}
+
+// BannerPathNotNil applies the NotNil predicate on the "banner_path" field.
+func BannerPathNotNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotNull(FieldBannerPath))
+}
+
+// BannerPathEqualFold applies the EqualFold predicate on the "banner_path" field.
+func BannerPathEqualFold(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEqualFold(FieldBannerPath, v))
+}
+
+// BannerPathContainsFold applies the ContainsFold predicate on the "banner_path" field.
+func BannerPathContainsFold(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldContainsFold(FieldBannerPath, v))
+}
+
+// BioEQ applies the EQ predicate on the "bio" field.
+func BioEQ(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldBio, v))
+}
+
+// BioNEQ applies the NEQ predicate on the "bio" field.
+func BioNEQ(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNEQ(FieldBio, v))
+}
+
+// BioIn applies the In predicate on the "bio" field.
+func BioIn(vs ...string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIn(FieldBio, vs...))
+}
+
+// BioNotIn applies the NotIn predicate on the "bio" field.
+func BioNotIn(vs ...string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotIn(FieldBio, vs...))
+}
+
+// BioGT applies the GT predicate on the "bio" field.
+func BioGT(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGT(FieldBio, v))
+}
+
+// BioGTE applies the GTE predicate on the "bio" field.
+func BioGTE(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGTE(FieldBio, v))
+}
+
+// BioLT applies the LT predicate on the "bio" field.
+func BioLT(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLT(FieldBio, v))
+}
+
+// BioLTE applies the LTE predicate on the "bio" field.
+func BioLTE(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLTE(FieldBio, v))
+}
+
+// BioContains applies the Contains predicate on the "bio" field.
+func BioContains(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldContains(FieldBio, v))
+}
+
+// BioHasPrefix applies the HasPrefix predicate on the "bio" field.
+func BioHasPrefix(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldHasPrefix(FieldBio, v))
+}
+
+// BioHasSuffix applies the HasSuffix predicate on the "bio" field.
+func BioHasSuffix(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldHasSuffix(FieldBio, v))
+}
+
+// BioIsNil applies the IsNil predicate on the "bio" field.
+func BioIsNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIsNull(FieldBio))
+}
+
+// BioNotNil applies the NotNil predicate on the "bio" field.
+func BioNotNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotNull(FieldBio))
+}
+
+// BioEqualFold applies the EqualFold predicate on the "bio" field.
+func BioEqualFold(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEqualFold(FieldBio, v))
+}
+
+// BioContainsFold applies the ContainsFold predicate on the "bio" field.
+func BioContainsFold(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldContainsFold(FieldBio, v))
+}
+
+// LinksIsNil applies the IsNil predicate on the "links" field.
+func LinksIsNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIsNull(FieldLinks))
+}
+
+// LinksNotNil applies the NotNil predicate on the "links" field.
+func LinksNotNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotNull(FieldLinks))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotNull(FieldLocation))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// BirthdayEQ applies the EQ predicate on the "birthday" field.
+func BirthdayEQ(v time.Time) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldBirthday, v))
+}
+
+// BirthdayNEQ applies the NEQ predicate on the "birthday" field.
+func BirthdayNEQ(v time.Time) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNEQ(FieldBirthday, v))
+}
+
+// BirthdayIn applies the In predicate on the "birthday" field.
+func BirthdayIn(vs ...time.Time) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIn(FieldBirthday, vs...))
+}
+
+// BirthdayNotIn applies the NotIn predicate on the "birthday" field.
+func BirthdayNotIn(vs ...time.Time) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotIn(FieldBirthday, vs...))
+}
+
+// BirthdayGT applies the GT predicate on the "birthday" field.
+func BirthdayGT(v time.Time) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGT(FieldBirthday, v))
+}
+
+// BirthdayGTE applies the GTE predicate on the "birthday" field.
+func BirthdayGTE(v time.Time) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGTE(FieldBirthday, v))
+}
+
+// BirthdayLT applies the LT predicate on the "birthday" field.
+func BirthdayLT(v time.Time) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLT(FieldBirthday, v))
+}
+
+// BirthdayLTE applies the LTE predicate on the "birthday" field.
+func BirthdayLTE(v time.Time) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLTE(FieldBirthday, v))
+}
+
+// BirthdayIsNil applies the IsNil predicate on the "birthday" field.
+func BirthdayIsNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIsNull(FieldBirthday))
+}
+
+// BirthdayNotNil applies the NotNil predicate on the "birthday" field.
+func BirthdayNotNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotNull(FieldBirthday))
+}
+
+// PronounsEQ applies the EQ predicate on the "pronouns" field.
+func PronounsEQ(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEQ(FieldPronouns, v))
+}
+
+// PronounsNEQ applies the NEQ predicate on the "pronouns" field.
+func PronounsNEQ(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNEQ(FieldPronouns, v))
+}
+
+// PronounsIn applies the In predicate on the "pronouns" field.
+func PronounsIn(vs ...string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIn(FieldPronouns, vs...))
+}
+
+// PronounsNotIn applies the NotIn predicate on the "pronouns" field.
+func PronounsNotIn(vs ...string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotIn(FieldPronouns, vs...))
+}
+
+// PronounsGT applies the GT predicate on the "pronouns" field.
+func PronounsGT(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGT(FieldPronouns, v))
+}
+
+// PronounsGTE applies the GTE predicate on the "pronouns" field.
+func PronounsGTE(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldGTE(FieldPronouns, v))
+}
+
+// PronounsLT applies the LT predicate on the "pronouns" field.
+func PronounsLT(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLT(FieldPronouns, v))
+}
+
+// PronounsLTE applies the LTE predicate on the "pronouns" field.
+func PronounsLTE(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldLTE(FieldPronouns, v))
+}
+
+// PronounsContains applies the Contains predicate on the "pronouns" field.
+func PronounsContains(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldContains(FieldPronouns, v))
+}
+
+// PronounsHasPrefix applies the HasPrefix predicate on the "pronouns" field.
+func PronounsHasPrefix(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldHasPrefix(FieldPronouns, v))
+}
+
+// PronounsHasSuffix applies the HasSuffix predicate on the "pronouns" field.
+func PronounsHasSuffix(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldHasSuffix(FieldPronouns, v))
+}
+
+// PronounsIsNil applies the IsNil predicate on the "pronouns" field.
+func PronounsIsNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIsNull(FieldPronouns))
+}
+
+// PronounsNotNil applies the NotNil predicate on the "pronouns" field.
+func PronounsNotNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotNull(FieldPronouns))
+}
+
+// PronounsEqualFold applies the EqualFold predicate on the "pronouns" field.
+func PronounsEqualFold(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldEqualFold(FieldPronouns, v))
+}
+
+// PronounsContainsFold applies the ContainsFold predicate on the "pronouns" field.
+func PronounsContainsFold(v string) predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldContainsFold(FieldPronouns, v))
+}
+
+// FieldVisibilityIsNil applies the IsNil predicate on the "field_visibility" field.
+func FieldVisibilityIsNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldIsNull(FieldFieldVisibility))
+}
+
+// FieldVisibilityNotNil applies the NotNil predicate on the "field_visibility" field.
+func FieldVisibilityNotNil() predicate.UserProfile {
+	return predicate.UserProfile(sql.FieldNotNull(FieldFieldVisibility))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.UserProfile {
 	return predicate.UserProfile(sql.FieldEQ(FieldCreatedAt, v))
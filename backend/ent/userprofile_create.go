@@ -33,6 +33,34 @@ func (_c *UserProfileCreate) SetUsername(v string) *UserProfileCreate {
 	return _c
 }
 
+// SetUsernameCanonical sets the "username_canonical" field.
+func (_c *UserProfileCreate) SetUsernameCanonical(v string) *UserProfileCreate {
+	_c.mutation.SetUsernameCanonical(v)
+	return _c
+}
+
+// SetNillableUsernameCanonical sets the "username_canonical" field if the given value is not nil.
+func (_c *UserProfileCreate) SetNillableUsernameCanonical(v *string) *UserProfileCreate {
+	if v != nil {
+		_c.SetUsernameCanonical(*v)
+	}
+	return _c
+}
+
+// SetUsernameSkeleton sets the "username_skeleton" field.
+func (_c *UserProfileCreate) SetUsernameSkeleton(v string) *UserProfileCreate {
+	_c.mutation.SetUsernameSkeleton(v)
+	return _c
+}
+
+// SetNillableUsernameSkeleton sets the "username_skeleton" field if the given value is not nil.
+func (_c *UserProfileCreate) SetNillableUsernameSkeleton(v *string) *UserProfileCreate {
+	if v != nil {
+		_c.SetUsernameSkeleton(*v)
+	}
+	return _c
+}
+
 // SetIconPath sets the "icon_path" field.
 func (_c *UserProfileCreate) SetIconPath(v string) *UserProfileCreate {
 	_c.mutation.SetIconPath(v)
@@ -47,6 +75,88 @@ func (_c *UserProfileCreate) SetNillableIconPath(v *string) *UserProfileCreate {
 	return _c
 }
 
+// SetBannerPath sets the "banner_path" field.
+func (_c *UserProfileCreate) SetBannerPath(v string) *UserProfileCreate {
+	_c.mutation.SetBannerPath(v)
+	return _c
+}
+
+// SetNillableBannerPath sets the "banner_path" field if the given value is not nil.
+func (_c *UserProfileCreate) SetNillableBannerPath(v *string) *UserProfileCreate {
+	if v != nil {
+		_c.SetBannerPath(*v)
+	}
+	return _c
+}
+
+// SetBio sets the "bio" field.
+func (_c *UserProfileCreate) SetBio(v string) *UserProfileCreate {
+	_c.mutation.SetBio(v)
+	return _c
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_c *UserProfileCreate) SetNillableBio(v *string) *UserProfileCreate {
+	if v != nil {
+		_c.SetBio(*v)
+	}
+	return _c
+}
+
+// SetLinks sets the "links" field.
+func (_c *UserProfileCreate) SetLinks(v []string) *UserProfileCreate {
+	_c.mutation.SetLinks(v)
+	return _c
+}
+
+// SetLocation sets the "location" field.
+func (_c *UserProfileCreate) SetLocation(v string) *UserProfileCreate {
+	_c.mutation.SetLocation(v)
+	return _c
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_c *UserProfileCreate) SetNillableLocation(v *string) *UserProfileCreate {
+	if v != nil {
+		_c.SetLocation(*v)
+	}
+	return _c
+}
+
+// SetBirthday sets the "birthday" field.
+func (_c *UserProfileCreate) SetBirthday(v time.Time) *UserProfileCreate {
+	_c.mutation.SetBirthday(v)
+	return _c
+}
+
+// SetNillableBirthday sets the "birthday" field if the given value is not nil.
+func (_c *UserProfileCreate) SetNillableBirthday(v *time.Time) *UserProfileCreate {
+	if v != nil {
+		_c.SetBirthday(*v)
+	}
+	return _c
+}
+
+// SetPronouns sets the "pronouns" field.
+func (_c *UserProfileCreate) SetPronouns(v string) *UserProfileCreate {
+	_c.mutation.SetPronouns(v)
+	return _c
+}
+
+// SetNillablePronouns sets the "pronouns" field if the given value is not nil.
+func (_c *UserProfileCreate) SetNillablePronouns(v *string) *UserProfileCreate {
+	if v != nil {
+		_c.SetPronouns(*v)
+	}
+	return _c
+}
+
+// SetFieldVisibility sets the "field_visibility" field.
+func (_c *UserProfileCreate) SetFieldVisibility(v map[string]string) *UserProfileCreate {
+	_c.mutation.SetFieldVisibility(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserProfileCreate) SetCreatedAt(v time.Time) *UserProfileCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -155,11 +265,41 @@ func (_c *UserProfileCreate) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UserProfile.username": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.UsernameCanonical(); ok {
+		if err := userprofile.UsernameCanonicalValidator(v); err != nil {
+			return &ValidationError{Name: "username_canonical", err: fmt.Errorf(`ent: validator failed for field "UserProfile.username_canonical": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UsernameSkeleton(); ok {
+		if err := userprofile.UsernameSkeletonValidator(v); err != nil {
+			return &ValidationError{Name: "username_skeleton", err: fmt.Errorf(`ent: validator failed for field "UserProfile.username_skeleton": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.IconPath(); ok {
 		if err := userprofile.IconPathValidator(v); err != nil {
 			return &ValidationError{Name: "icon_path", err: fmt.Errorf(`ent: validator failed for field "UserProfile.icon_path": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.BannerPath(); ok {
+		if err := userprofile.BannerPathValidator(v); err != nil {
+			return &ValidationError{Name: "banner_path", err: fmt.Errorf(`ent: validator failed for field "UserProfile.banner_path": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Bio(); ok {
+		if err := userprofile.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "UserProfile.bio": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Location(); ok {
+		if err := userprofile.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`ent: validator failed for field "UserProfile.location": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Pronouns(); ok {
+		if err := userprofile.PronounsValidator(v); err != nil {
+			return &ValidationError{Name: "pronouns", err: fmt.Errorf(`ent: validator failed for field "UserProfile.pronouns": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserProfile.created_at"`)}
 	}
@@ -209,10 +349,46 @@ func (_c *UserProfileCreate) createSpec() (*UserProfile, *sqlgraph.CreateSpec) {
 		_spec.SetField(userprofile.FieldUsername, field.TypeString, value)
 		_node.Username = value
 	}
+	if value, ok := _c.mutation.UsernameCanonical(); ok {
+		_spec.SetField(userprofile.FieldUsernameCanonical, field.TypeString, value)
+		_node.UsernameCanonical = &value
+	}
+	if value, ok := _c.mutation.UsernameSkeleton(); ok {
+		_spec.SetField(userprofile.FieldUsernameSkeleton, field.TypeString, value)
+		_node.UsernameSkeleton = &value
+	}
 	if value, ok := _c.mutation.IconPath(); ok {
 		_spec.SetField(userprofile.FieldIconPath, field.TypeString, value)
 		_node.IconPath = &value
 	}
+	if value, ok := _c.mutation.BannerPath(); ok {
+		_spec.SetField(userprofile.FieldBannerPath, field.TypeString, value)
+		_node.BannerPath = &value
+	}
+	if value, ok := _c.mutation.Bio(); ok {
+		_spec.SetField(userprofile.FieldBio, field.TypeString, value)
+		_node.Bio = &value
+	}
+	if value, ok := _c.mutation.Links(); ok {
+		_spec.SetField(userprofile.FieldLinks, field.TypeJSON, value)
+		_node.Links = value
+	}
+	if value, ok := _c.mutation.Location(); ok {
+		_spec.SetField(userprofile.FieldLocation, field.TypeString, value)
+		_node.Location = &value
+	}
+	if value, ok := _c.mutation.Birthday(); ok {
+		_spec.SetField(userprofile.FieldBirthday, field.TypeTime, value)
+		_node.Birthday = &value
+	}
+	if value, ok := _c.mutation.Pronouns(); ok {
+		_spec.SetField(userprofile.FieldPronouns, field.TypeString, value)
+		_node.Pronouns = &value
+	}
+	if value, ok := _c.mutation.FieldVisibility(); ok {
+		_spec.SetField(userprofile.FieldFieldVisibility, field.TypeJSON, value)
+		_node.FieldVisibility = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(userprofile.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/keu-5/muzee/backend/ent/predicate"
 	"github.com/keu-5/muzee/backend/ent/user"
@@ -57,6 +58,46 @@ func (_u *UserProfileUpdate) SetNillableUsername(v *string) *UserProfileUpdate {
 	return _u
 }
 
+// SetUsernameCanonical sets the "username_canonical" field.
+func (_u *UserProfileUpdate) SetUsernameCanonical(v string) *UserProfileUpdate {
+	_u.mutation.SetUsernameCanonical(v)
+	return _u
+}
+
+// SetNillableUsernameCanonical sets the "username_canonical" field if the given value is not nil.
+func (_u *UserProfileUpdate) SetNillableUsernameCanonical(v *string) *UserProfileUpdate {
+	if v != nil {
+		_u.SetUsernameCanonical(*v)
+	}
+	return _u
+}
+
+// ClearUsernameCanonical clears the value of the "username_canonical" field.
+func (_u *UserProfileUpdate) ClearUsernameCanonical() *UserProfileUpdate {
+	_u.mutation.ClearUsernameCanonical()
+	return _u
+}
+
+// SetUsernameSkeleton sets the "username_skeleton" field.
+func (_u *UserProfileUpdate) SetUsernameSkeleton(v string) *UserProfileUpdate {
+	_u.mutation.SetUsernameSkeleton(v)
+	return _u
+}
+
+// SetNillableUsernameSkeleton sets the "username_skeleton" field if the given value is not nil.
+func (_u *UserProfileUpdate) SetNillableUsernameSkeleton(v *string) *UserProfileUpdate {
+	if v != nil {
+		_u.SetUsernameSkeleton(*v)
+	}
+	return _u
+}
+
+// ClearUsernameSkeleton clears the value of the "username_skeleton" field.
+func (_u *UserProfileUpdate) ClearUsernameSkeleton() *UserProfileUpdate {
+	_u.mutation.ClearUsernameSkeleton()
+	return _u
+}
+
 // SetIconPath sets the "icon_path" field.
 func (_u *UserProfileUpdate) SetIconPath(v string) *UserProfileUpdate {
 	_u.mutation.SetIconPath(v)
@@ -77,6 +118,136 @@ func (_u *UserProfileUpdate) ClearIconPath() *UserProfileUpdate {
 	return _u
 }
 
+// SetBannerPath sets the "banner_path" field.
+func (_u *UserProfileUpdate) SetBannerPath(v string) *UserProfileUpdate {
+	_u.mutation.SetBannerPath(v)
+	return _u
+}
+
+// SetNillableBannerPath sets the "banner_path" field if the given value is not nil.
+func (_u *UserProfileUpdate) SetNillableBannerPath(v *string) *UserProfileUpdate {
+	if v != nil {
+		_u.SetBannerPath(*v)
+	}
+	return _u
+}
+
+// ClearBannerPath clears the value of the "banner_path" field.
+func (_u *UserProfileUpdate) ClearBannerPath() *UserProfileUpdate {
+	_u.mutation.ClearBannerPath()
+	return _u
+}
+
+// SetBio sets the "bio" field.
+func (_u *UserProfileUpdate) SetBio(v string) *UserProfileUpdate {
+	_u.mutation.SetBio(v)
+	return _u
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_u *UserProfileUpdate) SetNillableBio(v *string) *UserProfileUpdate {
+	if v != nil {
+		_u.SetBio(*v)
+	}
+	return _u
+}
+
+// ClearBio clears the value of the "bio" field.
+func (_u *UserProfileUpdate) ClearBio() *UserProfileUpdate {
+	_u.mutation.ClearBio()
+	return _u
+}
+
+// SetLinks sets the "links" field.
+func (_u *UserProfileUpdate) SetLinks(v []string) *UserProfileUpdate {
+	_u.mutation.SetLinks(v)
+	return _u
+}
+
+// AppendLinks appends value to the "links" field.
+func (_u *UserProfileUpdate) AppendLinks(v []string) *UserProfileUpdate {
+	_u.mutation.AppendLinks(v)
+	return _u
+}
+
+// ClearLinks clears the value of the "links" field.
+func (_u *UserProfileUpdate) ClearLinks() *UserProfileUpdate {
+	_u.mutation.ClearLinks()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *UserProfileUpdate) SetLocation(v string) *UserProfileUpdate {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *UserProfileUpdate) SetNillableLocation(v *string) *UserProfileUpdate {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *UserProfileUpdate) ClearLocation() *UserProfileUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// SetBirthday sets the "birthday" field.
+func (_u *UserProfileUpdate) SetBirthday(v time.Time) *UserProfileUpdate {
+	_u.mutation.SetBirthday(v)
+	return _u
+}
+
+// SetNillableBirthday sets the "birthday" field if the given value is not nil.
+func (_u *UserProfileUpdate) SetNillableBirthday(v *time.Time) *UserProfileUpdate {
+	if v != nil {
+		_u.SetBirthday(*v)
+	}
+	return _u
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (_u *UserProfileUpdate) ClearBirthday() *UserProfileUpdate {
+	_u.mutation.ClearBirthday()
+	return _u
+}
+
+// SetPronouns sets the "pronouns" field.
+func (_u *UserProfileUpdate) SetPronouns(v string) *UserProfileUpdate {
+	_u.mutation.SetPronouns(v)
+	return _u
+}
+
+// SetNillablePronouns sets the "pronouns" field if the given value is not nil.
+func (_u *UserProfileUpdate) SetNillablePronouns(v *string) *UserProfileUpdate {
+	if v != nil {
+		_u.SetPronouns(*v)
+	}
+	return _u
+}
+
+// ClearPronouns clears the value of the "pronouns" field.
+func (_u *UserProfileUpdate) ClearPronouns() *UserProfileUpdate {
+	_u.mutation.ClearPronouns()
+	return _u
+}
+
+// SetFieldVisibility sets the "field_visibility" field.
+func (_u *UserProfileUpdate) SetFieldVisibility(v map[string]string) *UserProfileUpdate {
+	_u.mutation.SetFieldVisibility(v)
+	return _u
+}
+
+// ClearFieldVisibility clears the value of the "field_visibility" field.
+func (_u *UserProfileUpdate) ClearFieldVisibility() *UserProfileUpdate {
+	_u.mutation.ClearFieldVisibility()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserProfileUpdate) SetUpdatedAt(v time.Time) *UserProfileUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -153,11 +324,41 @@ func (_u *UserProfileUpdate) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UserProfile.username": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.UsernameCanonical(); ok {
+		if err := userprofile.UsernameCanonicalValidator(v); err != nil {
+			return &ValidationError{Name: "username_canonical", err: fmt.Errorf(`ent: validator failed for field "UserProfile.username_canonical": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UsernameSkeleton(); ok {
+		if err := userprofile.UsernameSkeletonValidator(v); err != nil {
+			return &ValidationError{Name: "username_skeleton", err: fmt.Errorf(`ent: validator failed for field "UserProfile.username_skeleton": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.IconPath(); ok {
 		if err := userprofile.IconPathValidator(v); err != nil {
 			return &ValidationError{Name: "icon_path", err: fmt.Errorf(`ent: validator failed for field "UserProfile.icon_path": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.BannerPath(); ok {
+		if err := userprofile.BannerPathValidator(v); err != nil {
+			return &ValidationError{Name: "banner_path", err: fmt.Errorf(`ent: validator failed for field "UserProfile.banner_path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bio(); ok {
+		if err := userprofile.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "UserProfile.bio": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Location(); ok {
+		if err := userprofile.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`ent: validator failed for field "UserProfile.location": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Pronouns(); ok {
+		if err := userprofile.PronounsValidator(v); err != nil {
+			return &ValidationError{Name: "pronouns", err: fmt.Errorf(`ent: validator failed for field "UserProfile.pronouns": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "UserProfile.user"`)
 	}
@@ -182,12 +383,71 @@ func (_u *UserProfileUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Username(); ok {
 		_spec.SetField(userprofile.FieldUsername, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.UsernameCanonical(); ok {
+		_spec.SetField(userprofile.FieldUsernameCanonical, field.TypeString, value)
+	}
+	if _u.mutation.UsernameCanonicalCleared() {
+		_spec.ClearField(userprofile.FieldUsernameCanonical, field.TypeString)
+	}
+	if value, ok := _u.mutation.UsernameSkeleton(); ok {
+		_spec.SetField(userprofile.FieldUsernameSkeleton, field.TypeString, value)
+	}
+	if _u.mutation.UsernameSkeletonCleared() {
+		_spec.ClearField(userprofile.FieldUsernameSkeleton, field.TypeString)
+	}
 	if value, ok := _u.mutation.IconPath(); ok {
 		_spec.SetField(userprofile.FieldIconPath, field.TypeString, value)
 	}
 	if _u.mutation.IconPathCleared() {
 		_spec.ClearField(userprofile.FieldIconPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.BannerPath(); ok {
+		_spec.SetField(userprofile.FieldBannerPath, field.TypeString, value)
+	}
+	if _u.mutation.BannerPathCleared() {
+		_spec.ClearField(userprofile.FieldBannerPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(userprofile.FieldBio, field.TypeString, value)
+	}
+	if _u.mutation.BioCleared() {
+		_spec.ClearField(userprofile.FieldBio, field.TypeString)
+	}
+	if value, ok := _u.mutation.Links(); ok {
+		_spec.SetField(userprofile.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, userprofile.FieldLinks, value)
+		})
+	}
+	if _u.mutation.LinksCleared() {
+		_spec.ClearField(userprofile.FieldLinks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(userprofile.FieldLocation, field.TypeString, value)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(userprofile.FieldLocation, field.TypeString)
+	}
+	if value, ok := _u.mutation.Birthday(); ok {
+		_spec.SetField(userprofile.FieldBirthday, field.TypeTime, value)
+	}
+	if _u.mutation.BirthdayCleared() {
+		_spec.ClearField(userprofile.FieldBirthday, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Pronouns(); ok {
+		_spec.SetField(userprofile.FieldPronouns, field.TypeString, value)
+	}
+	if _u.mutation.PronounsCleared() {
+		_spec.ClearField(userprofile.FieldPronouns, field.TypeString)
+	}
+	if value, ok := _u.mutation.FieldVisibility(); ok {
+		_spec.SetField(userprofile.FieldFieldVisibility, field.TypeJSON, value)
+	}
+	if _u.mutation.FieldVisibilityCleared() {
+		_spec.ClearField(userprofile.FieldFieldVisibility, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(userprofile.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -268,6 +528,46 @@ func (_u *UserProfileUpdateOne) SetNillableUsername(v *string) *UserProfileUpdat
 	return _u
 }
 
+// SetUsernameCanonical sets the "username_canonical" field.
+func (_u *UserProfileUpdateOne) SetUsernameCanonical(v string) *UserProfileUpdateOne {
+	_u.mutation.SetUsernameCanonical(v)
+	return _u
+}
+
+// SetNillableUsernameCanonical sets the "username_canonical" field if the given value is not nil.
+func (_u *UserProfileUpdateOne) SetNillableUsernameCanonical(v *string) *UserProfileUpdateOne {
+	if v != nil {
+		_u.SetUsernameCanonical(*v)
+	}
+	return _u
+}
+
+// ClearUsernameCanonical clears the value of the "username_canonical" field.
+func (_u *UserProfileUpdateOne) ClearUsernameCanonical() *UserProfileUpdateOne {
+	_u.mutation.ClearUsernameCanonical()
+	return _u
+}
+
+// SetUsernameSkeleton sets the "username_skeleton" field.
+func (_u *UserProfileUpdateOne) SetUsernameSkeleton(v string) *UserProfileUpdateOne {
+	_u.mutation.SetUsernameSkeleton(v)
+	return _u
+}
+
+// SetNillableUsernameSkeleton sets the "username_skeleton" field if the given value is not nil.
+func (_u *UserProfileUpdateOne) SetNillableUsernameSkeleton(v *string) *UserProfileUpdateOne {
+	if v != nil {
+		_u.SetUsernameSkeleton(*v)
+	}
+	return _u
+}
+
+// ClearUsernameSkeleton clears the value of the "username_skeleton" field.
+func (_u *UserProfileUpdateOne) ClearUsernameSkeleton() *UserProfileUpdateOne {
+	_u.mutation.ClearUsernameSkeleton()
+	return _u
+}
+
 // SetIconPath sets the "icon_path" field.
 func (_u *UserProfileUpdateOne) SetIconPath(v string) *UserProfileUpdateOne {
 	_u.mutation.SetIconPath(v)
@@ -288,6 +588,136 @@ func (_u *UserProfileUpdateOne) ClearIconPath() *UserProfileUpdateOne {
 	return _u
 }
 
+// SetBannerPath sets the "banner_path" field.
+func (_u *UserProfileUpdateOne) SetBannerPath(v string) *UserProfileUpdateOne {
+	_u.mutation.SetBannerPath(v)
+	return _u
+}
+
+// SetNillableBannerPath sets the "banner_path" field if the given value is not nil.
+func (_u *UserProfileUpdateOne) SetNillableBannerPath(v *string) *UserProfileUpdateOne {
+	if v != nil {
+		_u.SetBannerPath(*v)
+	}
+	return _u
+}
+
+// ClearBannerPath clears the value of the "banner_path" field.
+func (_u *UserProfileUpdateOne) ClearBannerPath() *UserProfileUpdateOne {
+	_u.mutation.ClearBannerPath()
+	return _u
+}
+
+// SetBio sets the "bio" field.
+func (_u *UserProfileUpdateOne) SetBio(v string) *UserProfileUpdateOne {
+	_u.mutation.SetBio(v)
+	return _u
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_u *UserProfileUpdateOne) SetNillableBio(v *string) *UserProfileUpdateOne {
+	if v != nil {
+		_u.SetBio(*v)
+	}
+	return _u
+}
+
+// ClearBio clears the value of the "bio" field.
+func (_u *UserProfileUpdateOne) ClearBio() *UserProfileUpdateOne {
+	_u.mutation.ClearBio()
+	return _u
+}
+
+// SetLinks sets the "links" field.
+func (_u *UserProfileUpdateOne) SetLinks(v []string) *UserProfileUpdateOne {
+	_u.mutation.SetLinks(v)
+	return _u
+}
+
+// AppendLinks appends value to the "links" field.
+func (_u *UserProfileUpdateOne) AppendLinks(v []string) *UserProfileUpdateOne {
+	_u.mutation.AppendLinks(v)
+	return _u
+}
+
+// ClearLinks clears the value of the "links" field.
+func (_u *UserProfileUpdateOne) ClearLinks() *UserProfileUpdateOne {
+	_u.mutation.ClearLinks()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *UserProfileUpdateOne) SetLocation(v string) *UserProfileUpdateOne {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *UserProfileUpdateOne) SetNillableLocation(v *string) *UserProfileUpdateOne {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *UserProfileUpdateOne) ClearLocation() *UserProfileUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// SetBirthday sets the "birthday" field.
+func (_u *UserProfileUpdateOne) SetBirthday(v time.Time) *UserProfileUpdateOne {
+	_u.mutation.SetBirthday(v)
+	return _u
+}
+
+// SetNillableBirthday sets the "birthday" field if the given value is not nil.
+func (_u *UserProfileUpdateOne) SetNillableBirthday(v *time.Time) *UserProfileUpdateOne {
+	if v != nil {
+		_u.SetBirthday(*v)
+	}
+	return _u
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (_u *UserProfileUpdateOne) ClearBirthday() *UserProfileUpdateOne {
+	_u.mutation.ClearBirthday()
+	return _u
+}
+
+// SetPronouns sets the "pronouns" field.
+func (_u *UserProfileUpdateOne) SetPronouns(v string) *UserProfileUpdateOne {
+	_u.mutation.SetPronouns(v)
+	return _u
+}
+
+// SetNillablePronouns sets the "pronouns" field if the given value is not nil.
+func (_u *UserProfileUpdateOne) SetNillablePronouns(v *string) *UserProfileUpdateOne {
+	if v != nil {
+		_u.SetPronouns(*v)
+	}
+	return _u
+}
+
+// ClearPronouns clears the value of the "pronouns" field.
+func (_u *UserProfileUpdateOne) ClearPronouns() *UserProfileUpdateOne {
+	_u.mutation.ClearPronouns()
+	return _u
+}
+
+// SetFieldVisibility sets the "field_visibility" field.
+func (_u *UserProfileUpdateOne) SetFieldVisibility(v map[string]string) *UserProfileUpdateOne {
+	_u.mutation.SetFieldVisibility(v)
+	return _u
+}
+
+// ClearFieldVisibility clears the value of the "field_visibility" field.
+func (_u *UserProfileUpdateOne) ClearFieldVisibility() *UserProfileUpdateOne {
+	_u.mutation.ClearFieldVisibility()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserProfileUpdateOne) SetUpdatedAt(v time.Time) *UserProfileUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -377,11 +807,41 @@ func (_u *UserProfileUpdateOne) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UserProfile.username": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.UsernameCanonical(); ok {
+		if err := userprofile.UsernameCanonicalValidator(v); err != nil {
+			return &ValidationError{Name: "username_canonical", err: fmt.Errorf(`ent: validator failed for field "UserProfile.username_canonical": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UsernameSkeleton(); ok {
+		if err := userprofile.UsernameSkeletonValidator(v); err != nil {
+			return &ValidationError{Name: "username_skeleton", err: fmt.Errorf(`ent: validator failed for field "UserProfile.username_skeleton": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.IconPath(); ok {
 		if err := userprofile.IconPathValidator(v); err != nil {
 			return &ValidationError{Name: "icon_path", err: fmt.Errorf(`ent: validator failed for field "UserProfile.icon_path": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.BannerPath(); ok {
+		if err := userprofile.BannerPathValidator(v); err != nil {
+			return &ValidationError{Name: "banner_path", err: fmt.Errorf(`ent: validator failed for field "UserProfile.banner_path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bio(); ok {
+		if err := userprofile.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "UserProfile.bio": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Location(); ok {
+		if err := userprofile.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`ent: validator failed for field "UserProfile.location": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Pronouns(); ok {
+		if err := userprofile.PronounsValidator(v); err != nil {
+			return &ValidationError{Name: "pronouns", err: fmt.Errorf(`ent: validator failed for field "UserProfile.pronouns": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "UserProfile.user"`)
 	}
@@ -423,12 +883,71 @@ func (_u *UserProfileUpdateOne) sqlSave(ctx context.Context) (_node *UserProfile
 	if value, ok := _u.mutation.Username(); ok {
 		_spec.SetField(userprofile.FieldUsername, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.UsernameCanonical(); ok {
+		_spec.SetField(userprofile.FieldUsernameCanonical, field.TypeString, value)
+	}
+	if _u.mutation.UsernameCanonicalCleared() {
+		_spec.ClearField(userprofile.FieldUsernameCanonical, field.TypeString)
+	}
+	if value, ok := _u.mutation.UsernameSkeleton(); ok {
+		_spec.SetField(userprofile.FieldUsernameSkeleton, field.TypeString, value)
+	}
+	if _u.mutation.UsernameSkeletonCleared() {
+		_spec.ClearField(userprofile.FieldUsernameSkeleton, field.TypeString)
+	}
 	if value, ok := _u.mutation.IconPath(); ok {
 		_spec.SetField(userprofile.FieldIconPath, field.TypeString, value)
 	}
 	if _u.mutation.IconPathCleared() {
 		_spec.ClearField(userprofile.FieldIconPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.BannerPath(); ok {
+		_spec.SetField(userprofile.FieldBannerPath, field.TypeString, value)
+	}
+	if _u.mutation.BannerPathCleared() {
+		_spec.ClearField(userprofile.FieldBannerPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(userprofile.FieldBio, field.TypeString, value)
+	}
+	if _u.mutation.BioCleared() {
+		_spec.ClearField(userprofile.FieldBio, field.TypeString)
+	}
+	if value, ok := _u.mutation.Links(); ok {
+		_spec.SetField(userprofile.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, userprofile.FieldLinks, value)
+		})
+	}
+	if _u.mutation.LinksCleared() {
+		_spec.ClearField(userprofile.FieldLinks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(userprofile.FieldLocation, field.TypeString, value)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(userprofile.FieldLocation, field.TypeString)
+	}
+	if value, ok := _u.mutation.Birthday(); ok {
+		_spec.SetField(userprofile.FieldBirthday, field.TypeTime, value)
+	}
+	if _u.mutation.BirthdayCleared() {
+		_spec.ClearField(userprofile.FieldBirthday, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Pronouns(); ok {
+		_spec.SetField(userprofile.FieldPronouns, field.TypeString, value)
+	}
+	if _u.mutation.PronounsCleared() {
+		_spec.ClearField(userprofile.FieldPronouns, field.TypeString)
+	}
+	if value, ok := _u.mutation.FieldVisibility(); ok {
+		_spec.SetField(userprofile.FieldFieldVisibility, field.TypeJSON, value)
+	}
+	if _u.mutation.FieldVisibilityCleared() {
+		_spec.ClearField(userprofile.FieldFieldVisibility, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(userprofile.FieldUpdatedAt, field.TypeTime, value)
 	}
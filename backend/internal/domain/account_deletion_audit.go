@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// AccountDeletionStep identifies one step of scheduling or purging an account
+type AccountDeletionStep string
+
+const (
+	AccountDeletionStepScheduled AccountDeletionStep = "scheduled"
+	AccountDeletionStepSessions  AccountDeletionStep = "sessions"
+	AccountDeletionStepIcon      AccountDeletionStep = "icon"
+	AccountDeletionStepBanner    AccountDeletionStep = "banner"
+	AccountDeletionStepProfile   AccountDeletionStep = "profile"
+	AccountDeletionStepUser      AccountDeletionStep = "user"
+)
+
+type AccountDeletionAudit struct {
+	ID        int64               `json:"id"`
+	UserID    int64               `json:"user_id"`
+	Step      AccountDeletionStep `json:"step"`
+	Succeeded bool                `json:"succeeded"`
+	Detail    *string             `json:"detail"`
+	CreatedAt time.Time           `json:"created_at"`
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// Scopes of personal access tokens. Each route group requires <group>:read for
+// GET requests and <group>:write for everything else.
+const (
+	TokenScopeUsersRead  = "users:read"
+	TokenScopeMeRead     = "me:read"
+	TokenScopeMeWrite    = "me:write"
+	TokenScopeAdminRead  = "admin:read"
+	TokenScopeAdminWrite = "admin:write"
+)
+
+// AllTokenScopes lists every scope a personal access token can be granted
+var AllTokenScopes = []string{
+	TokenScopeUsersRead,
+	TokenScopeMeRead,
+	TokenScopeMeWrite,
+	TokenScopeAdminRead,
+	TokenScopeAdminWrite,
+}
+
+// PersonalAccessToken is a long-lived token a user created for scripts and integrations
+type PersonalAccessToken struct {
+	ID          int64      `json:"id"`
+	UserID      int64      `json:"user_id"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	LastUsedIP  string     `json:"last_used_ip"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// Permissions checked by RequirePermission
+const (
+	PermissionSecurityEventsRead = "security_events:read"
+	PermissionRolesRead          = "roles:read"
+	PermissionRolesManage        = "roles:manage"
+)
+
+// AllPermissions lists every permission known to the application
+var AllPermissions = []string{
+	PermissionSecurityEventsRead,
+	PermissionRolesRead,
+	PermissionRolesManage,
+}
+
+// Built-in roles, created at startup
+const (
+	RoleAdmin   = "admin"
+	RoleSupport = "support"
+)
+
+// Role is a named set of permissions granted to users
+type Role struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// RoleNames returns the names of the roles
+func RoleNames(roles []*Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RolePermissions returns the union of the permissions of the roles, sorted
+func RolePermissions(roles []*Role) []string {
+	seen := make(map[string]struct{})
+	permissions := make([]string, 0)
+	for _, role := range roles {
+		for _, permission := range role.Permissions {
+			if _, ok := seen[permission]; ok {
+				continue
+			}
+			seen[permission] = struct{}{}
+			permissions = append(permissions, permission)
+		}
+	}
+	sort.Strings(permissions)
+	return permissions
+}
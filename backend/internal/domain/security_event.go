@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// SecurityEventType identifies the kind of action a SecurityEvent records
+type SecurityEventType string
+
+const (
+	SecurityEventLogin           SecurityEventType = "login"
+	SecurityEventTokenRefresh    SecurityEventType = "token_refresh"
+	SecurityEventLogout          SecurityEventType = "logout"
+	SecurityEventCodeSent        SecurityEventType = "code_sent"
+	SecurityEventPasswordChanged SecurityEventType = "password_changed"
+	SecurityEventPasswordReset   SecurityEventType = "password_reset"
+	SecurityEventEmailChanged    SecurityEventType = "email_changed"
+	SecurityEventSessionRevoked  SecurityEventType = "session_revoked"
+	SecurityEventRoleChanged     SecurityEventType = "role_changed"
+
+	SecurityEventPersonalAccessTokenCreated SecurityEventType = "personal_access_token_created"
+	SecurityEventPersonalAccessTokenRevoked SecurityEventType = "personal_access_token_revoked"
+)
+
+type SecurityEvent struct {
+	ID        int64             `json:"id"`
+	UserID    *int64            `json:"user_id"`
+	Type      SecurityEventType `json:"event_type"`
+	Succeeded bool              `json:"succeeded"`
+	Email     string            `json:"email"`
+	IPAddress string            `json:"ip_address"`
+	UserAgent string            `json:"user_agent"`
+	ClientID  string            `json:"client_id"`
+	Detail    *string           `json:"detail"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// SecurityEventFilter narrows a security event query; zero values match everything.
+// Results are newest first, and BeforeID pages through them.
+type SecurityEventFilter struct {
+	UserID    *int64
+	Email     string
+	Type      SecurityEventType
+	Succeeded *bool
+	IPAddress string
+	Since     *time.Time
+	Until     *time.Time
+	BeforeID  int64
+	Limit     int
+}
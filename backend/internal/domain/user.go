@@ -3,9 +3,15 @@ package domain
 import "time"
 
 type User struct {
-	ID           int64     `json:"id"`
-	Email        string    `json:"email"`
-	PasswordHash string    `json:"-"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID                     int64      `json:"id"`
+	Email                  string     `json:"email"`
+	PasswordHash           string     `json:"-"`
+	TOTPSecret             *string    `json:"-"`
+	TOTPEnabled            bool       `json:"totp_enabled"`
+	TOTPLastUsedStep       int64      `json:"-"`
+	TOTPRecoveryCodeHashes []string   `json:"-"`
+	DeletionScheduledAt    *time.Time `json:"deletion_scheduled_at"`
+	Roles                  []*Role    `json:"roles"`
+	CreatedAt              time.Time  `json:"created_at"`
+	UpdatedAt              time.Time  `json:"updated_at"`
 }
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// UserDevice is a client the user has completed a login from
+type UserDevice struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"user_id"`
+	ClientID   string    `json:"client_id"`
+	Trusted    bool      `json:"trusted"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
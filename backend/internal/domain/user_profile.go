@@ -1,13 +1,206 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+)
+
+// IconSizes are the square renditions generated for every uploaded icon, in pixels
+var IconSizes = []int{48, 128, 400}
+
+// BannerSizes are the widths of the 3:1 renditions generated for every uploaded banner, in pixels
+var BannerSizes = []int{600, 1500}
 
 type UserProfile struct {
-	ID        int64     `json:"id"`
-	UserID    int64     `json:"user_id"`
-	Name      string    `json:"name"`
-	Username  string    `json:"username"`
-	IconPath  *string   `json:"icon_path"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"user_id"`
+	Name       string     `json:"name"`
+	Username   string     `json:"username"`
+	IconPath   *string    `json:"icon_path"`
+	BannerPath *string    `json:"banner_path"`
+	Bio        *string    `json:"bio"`
+	Links      []string   `json:"links"`
+	Location   *string    `json:"location"`
+	Birthday   *time.Time `json:"birthday"`
+	Pronouns   *string    `json:"pronouns"`
+	// Visibility holds the visibility of the optional fields; missing fields are public
+	Visibility map[ProfileField]Visibility `json:"visibility"`
+	CreatedAt  time.Time                   `json:"created_at"`
+	UpdatedAt  time.Time                   `json:"updated_at"`
+}
+
+// ProfileField names an optional profile field that has its own visibility
+type ProfileField string
+
+const (
+	ProfileFieldBio      ProfileField = "bio"
+	ProfileFieldLinks    ProfileField = "links"
+	ProfileFieldBanner   ProfileField = "banner"
+	ProfileFieldLocation ProfileField = "location"
+	ProfileFieldBirthday ProfileField = "birthday"
+	ProfileFieldPronouns ProfileField = "pronouns"
+)
+
+var ProfileFields = []ProfileField{
+	ProfileFieldBio,
+	ProfileFieldLinks,
+	ProfileFieldBanner,
+	ProfileFieldLocation,
+	ProfileFieldBirthday,
+	ProfileFieldPronouns,
+}
+
+// Visibility is who can see a profile field
+type Visibility string
+
+const (
+	VisibilityPublic    Visibility = "public"
+	VisibilityFollowers Visibility = "followers"
+	VisibilityOnlyMe    Visibility = "only_me"
+)
+
+// ProfileRelationship describes how the viewer of a public profile relates to its owner
+type ProfileRelationship struct {
+	IsSelf bool
+	// IsFollower is set once users can follow each other; until then followers-only
+	// fields are only shown to the owner
+	IsFollower bool
+}
+
+// FieldVisibility returns the visibility of a profile field, defaulting to public
+func FieldVisibility(profile *UserProfile, field ProfileField) Visibility {
+	if v, ok := profile.Visibility[field]; ok {
+		return v
+	}
+	return VisibilityPublic
+}
+
+// VisibleTo reports whether a field with the given visibility may be shown to the viewer.
+// A nil relationship is an anonymous viewer.
+func VisibleTo(visibility Visibility, relationship *ProfileRelationship) bool {
+	switch visibility {
+	case VisibilityPublic:
+		return true
+	case VisibilityFollowers:
+		return relationship != nil && (relationship.IsSelf || relationship.IsFollower)
+	default:
+		return relationship != nil && relationship.IsSelf
+	}
+}
+
+// FilterUserProfile returns a copy of the profile without the fields the viewer may not see
+func FilterUserProfile(profile *UserProfile, relationship *ProfileRelationship) *UserProfile {
+	filtered := *profile
+	hidden := func(field ProfileField) bool {
+		return !VisibleTo(FieldVisibility(profile, field), relationship)
+	}
+
+	if hidden(ProfileFieldBio) {
+		filtered.Bio = nil
+	}
+	if hidden(ProfileFieldLinks) {
+		filtered.Links = nil
+	}
+	if hidden(ProfileFieldBanner) {
+		filtered.BannerPath = nil
+	}
+	if hidden(ProfileFieldLocation) {
+		filtered.Location = nil
+	}
+	if hidden(ProfileFieldBirthday) {
+		filtered.Birthday = nil
+	}
+	if hidden(ProfileFieldPronouns) {
+		filtered.Pronouns = nil
+	}
+	return &filtered
+}
+
+// RenditionPath returns the object name of one rendition under an image prefix
+func RenditionPath(imagePrefix string, size int) string {
+	return fmt.Sprintf("%s/%d.jpg", imagePrefix, size)
+}
+
+// RenditionPaths returns the object names of all renditions under an image prefix
+func RenditionPaths(imagePrefix string, sizes []int) []string {
+	names := make([]string, 0, len(sizes))
+	for _, size := range sizes {
+		names = append(names, RenditionPath(imagePrefix, size))
+	}
+	return names
+}
+
+// IconRenditions returns the object names of an icon keyed by size, or nil without an icon.
+// UserProfile.IconPath holds the prefix of the renditions; icons uploaded before renditions were
+// generated are a single object with a file extension, which is used for every size.
+func IconRenditions(iconPath *string) map[string]string {
+	return imageRenditions(iconPath, IconSizes)
+}
+
+// IconObjectNames returns every stored object that belongs to an icon
+func IconObjectNames(iconPath *string) []string {
+	return imageObjectNames(iconPath, IconSizes)
+}
+
+// BannerRenditions returns the object names of a banner keyed by width, or nil without a banner
+func BannerRenditions(bannerPath *string) map[string]string {
+	return imageRenditions(bannerPath, BannerSizes)
+}
+
+// BannerObjectNames returns every stored object that belongs to a banner
+func BannerObjectNames(bannerPath *string) []string {
+	return imageObjectNames(bannerPath, BannerSizes)
+}
+
+func imageRenditions(imagePath *string, sizes []int) map[string]string {
+	if imagePath == nil {
+		return nil
+	}
+
+	renditions := make(map[string]string, len(sizes))
+	for _, size := range sizes {
+		if path.Ext(*imagePath) != "" {
+			renditions[strconv.Itoa(size)] = *imagePath
+		} else {
+			renditions[strconv.Itoa(size)] = RenditionPath(*imagePath, size)
+		}
+	}
+	return renditions
+}
+
+func imageObjectNames(imagePath *string, sizes []int) []string {
+	if imagePath == nil {
+		return nil
+	}
+	if path.Ext(*imagePath) != "" {
+		return []string{*imagePath}
+	}
+	return RenditionPaths(*imagePath, sizes)
+}
+
+// UserProfileDetails holds the optional profile fields of a create or update request.
+// nil fields are left unchanged; an empty string, an empty Links slice or a zero Birthday clears the field.
+type UserProfileDetails struct {
+	Bio      *string
+	Links    *[]string
+	Location *string
+	Birthday *time.Time
+	Pronouns *string
+	// Visibility changes only the listed fields
+	Visibility map[ProfileField]Visibility
+}
+
+// UserProfileUpdate holds the fields of a partial profile update; nil fields are left unchanged
+type UserProfileUpdate struct {
+	Name       *string
+	Username   *string
+	IconPath   *string
+	BannerPath *string
+	Details    UserProfileDetails
+	// ClearIcon removes the icon; IconPath is ignored when set
+	ClearIcon bool
+	// ClearBanner removes the banner; BannerPath is ignored when set
+	ClearBanner bool
 }
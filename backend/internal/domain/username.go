@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MaxUsernameLength matches the length of the username column
+const MaxUsernameLength = 50
+
+var usernamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// UsernameUnavailableReason explains why a username cannot be used
+type UsernameUnavailableReason string
+
+const (
+	UsernameInvalid    UsernameUnavailableReason = "invalid"
+	UsernameReserved   UsernameUnavailableReason = "reserved"
+	UsernameTaken      UsernameUnavailableReason = "taken"
+	UsernameConfusable UsernameUnavailableReason = "confusable"
+)
+
+// UsernameAvailability is the result of an availability check; Suggestions are ordered best first
+type UsernameAvailability struct {
+	Available   bool
+	Reason      UsernameUnavailableReason
+	Suggestions []string
+}
+
+// ValidUsername reports whether the username uses only ASCII letters, digits, underscores and hyphens.
+// Restricting usernames to ASCII rules out look-alike letters from other scripts.
+func ValidUsername(username string) bool {
+	return len(username) <= MaxUsernameLength && usernamePattern.MatchString(username)
+}
+
+// CanonicalUsername returns the form used for case-insensitive uniqueness
+func CanonicalUsername(username string) string {
+	return strings.ToLower(username)
+}
+
+// usernameConfusables maps characters and sequences to the character they are easily mistaken for
+var usernameConfusables = strings.NewReplacer(
+	"rn", "m",
+	"vv", "w",
+	"0", "o",
+	"1", "l",
+	"i", "l",
+	"-", "_",
+)
+
+// UsernameSkeleton returns a form in which usernames that look alike are equal,
+// e.g. "PayPal", "paypa1" and "paypai" all become "paypal"
+func UsernameSkeleton(username string) string {
+	skeleton := usernameConfusables.Replace(CanonicalUsername(username))
+	for strings.Contains(skeleton, "__") {
+		skeleton = strings.ReplaceAll(skeleton, "__", "_")
+	}
+	return skeleton
+}
+
+// UsernameCandidates returns alternatives to a username, best first: the username with invalid
+// characters removed, then numbered variants of it, shortest first
+func UsernameCandidates(username string) []string {
+	var b strings.Builder
+	for _, r := range username {
+		if r < 0x80 && usernamePattern.MatchString(string(r)) {
+			b.WriteRune(r)
+		}
+	}
+	sanitized := b.String()
+
+	// 末尾の番号は付け直すので取り除く
+	base := strings.TrimRight(sanitized, "0123456789_-")
+	if base == "" {
+		base = "user"
+	}
+
+	var candidates []string
+	if sanitized != username && ValidUsername(sanitized) {
+		candidates = append(candidates, sanitized)
+	}
+	add := func(suffix string) {
+		prefix := base
+		if len(prefix)+len(suffix) > MaxUsernameLength {
+			prefix = prefix[:MaxUsernameLength-len(suffix)]
+		}
+		candidates = append(candidates, prefix+suffix)
+	}
+	for n := 1; n <= 9; n++ {
+		add(strconv.Itoa(n))
+	}
+	for n := 1; n <= 9; n++ {
+		add("_" + strconv.Itoa(n))
+	}
+	for n := 10; n <= 99; n++ {
+		add(strconv.Itoa(n))
+	}
+	return candidates
+}
@@ -0,0 +1,62 @@
+package helper
+
+import (
+	"crypto/subtle"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+// CSRF double-submit: the token is stored in a cookie readable by the web app,
+// which must echo it in the CSRF header on unsafe requests authenticated by cookie.
+const (
+	CSRFCookieName = "csrf_token"
+	CSRFHeaderName = "X-CSRF-Token"
+)
+
+// csrfCookieMaxAge matches the lifetime of the refresh token cookie
+const csrfCookieMaxAge = 7 * 24 * 60 * 60
+
+// IssueCSRFCookie sets a new CSRF token; called whenever a login sets the auth cookies
+func IssueCSRFCookie(c *fiber.Ctx, secure bool) error {
+	token, err := util.GenerateURLSafeToken(32)
+	if err != nil {
+		return err
+	}
+	setCSRFCookie(c, token, csrfCookieMaxAge, secure)
+	return nil
+}
+
+// RenewCSRFCookie extends the current CSRF token, or issues one if the client has none.
+// Keeping the value lets requests already in flight with the old header succeed.
+func RenewCSRFCookie(c *fiber.Ctx, secure bool) error {
+	if token := c.Cookies(CSRFCookieName); token != "" {
+		setCSRFCookie(c, token, csrfCookieMaxAge, secure)
+		return nil
+	}
+	return IssueCSRFCookie(c, secure)
+}
+
+// HasValidCSRFToken reports whether the CSRF header matches the CSRF cookie
+func HasValidCSRFToken(c *fiber.Ctx) bool {
+	cookieToken := c.Cookies(CSRFCookieName)
+	headerToken := c.Get(CSRFHeaderName)
+	return cookieToken != "" && subtle.ConstantTimeCompare([]byte(cookieToken), []byte(headerToken)) == 1
+}
+
+// ClearCSRFCookie deletes the CSRF token together with the auth cookies
+func ClearCSRFCookie(c *fiber.Ctx, secure bool) {
+	setCSRFCookie(c, "", -1, secure)
+}
+
+func setCSRFCookie(c *fiber.Ctx, token string, maxAge int, secure bool) {
+	c.Cookie(&fiber.Cookie{
+		Name:     CSRFCookieName,
+		Value:    token,
+		HTTPOnly: false, // JavaScriptから読み取ってヘッダーに設定する
+		Secure:   secure,
+		SameSite: "Lax",
+		MaxAge:   maxAge,
+		Path:     "/",
+	})
+}
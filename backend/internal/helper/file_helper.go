@@ -1,22 +1,19 @@
 package helper
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"strings"
+
+	"github.com/keu-5/muzee/backend/internal/util"
 )
 
 const (
 	MaxImageSize = 5 * 1024 * 1024 // 5MB
 )
 
-var allowedImageTypes = map[string]bool{
-	"image/jpeg": true,
-	"image/png":  true,
-	"image/gif":  true,
-	"image/webp": true,
-}
-
 type FileHelper struct{}
 
 func NewFileHelper() *FileHelper {
@@ -32,8 +29,21 @@ func (h *FileHelper) ValidateImageFile(file *multipart.FileHeader) error {
 		return fmt.Errorf("ファイルサイズが大きすぎます。最大5MBまでです")
 	}
 
-	contentType := file.Header.Get("Content-Type")
-	if !allowedImageTypes[contentType] {
+	// Content-Typeヘッダーではなくファイルの中身で判定する
+	src, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("ファイルを読み込めませんでした")
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(io.LimitReader(src, MaxImageSize))
+	if err != nil {
+		return fmt.Errorf("ファイルを読み込めませんでした")
+	}
+	if _, err := util.InspectImage(data); err != nil {
+		if errors.Is(err, util.ErrImageTooLarge) {
+			return fmt.Errorf("画像の解像度が大きすぎます")
+		}
 		return fmt.Errorf("サポートされていないファイル形式です。JPEG、PNG、GIF、WebPのみサポートされています")
 	}
 
@@ -0,0 +1,52 @@
+package helper
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+// passwordRangePrefixLen is how many leading hex characters of the SHA-1 hash are sent to the range source
+const passwordRangePrefixLen = 5
+
+// PasswordRangeSource returns the breached password hashes that share a SHA-1 prefix, in the
+// format of the Have I Been Pwned range API: uppercase hash suffixes mapped to their breach counts.
+// Only the prefix leaves the server, so the source never learns which password is being checked.
+type PasswordRangeSource interface {
+	Range(ctx context.Context, prefix string) (map[string]int, error)
+}
+
+// PasswordPolicy screens new passwords against the bundled common password list and,
+// when a range source is configured, against known data breaches
+type PasswordPolicy struct {
+	rangeSource PasswordRangeSource
+}
+
+// NewPasswordPolicy creates a policy; a nil rangeSource disables the breach check
+func NewPasswordPolicy(rangeSource PasswordRangeSource) *PasswordPolicy {
+	return &PasswordPolicy{rangeSource: rangeSource}
+}
+
+// IsCommon reports whether the password is one of the most frequently used passwords
+func (p *PasswordPolicy) IsCommon(password string) bool {
+	return util.IsCommonPassword(password)
+}
+
+// IsBreached reports whether the password appears in a known data breach
+func (p *PasswordPolicy) IsBreached(ctx context.Context, password string) (bool, error) {
+	if p == nil || p.rangeSource == nil {
+		return false, nil
+	}
+
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	suffixes, err := p.rangeSource.Range(ctx, hash[:passwordRangePrefixLen])
+	if err != nil {
+		return false, err
+	}
+	return suffixes[hash[passwordRangePrefixLen:]] > 0, nil
+}
@@ -0,0 +1,82 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// slidingWindowScript keeps one sorted-set member per accepted request, scored by its time in milliseconds.
+// Requests older than the window are dropped before counting, so the limit applies to any window-long span.
+// Returns {allowed, remaining, milliseconds until the oldest request leaves the window}.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+local count = redis.call('ZCARD', key)
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', key, now, ARGV[4])
+	redis.call('PEXPIRE', key, window)
+	count = count + 1
+	allowed = 1
+end
+
+local reset = window
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+return {allowed, limit - count, reset}
+`)
+
+// RateLimitResult describes the state of a rate limit window after a request was counted
+type RateLimitResult struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// ResetAfter is when the oldest counted request leaves the window and frees a slot
+	ResetAfter time.Duration
+}
+
+// RateLimiter counts requests per key in a sliding window stored in Redis
+type RateLimiter struct {
+	redisClient *redis.Client
+}
+
+func NewRateLimiter(redisClient *redis.Client) *RateLimiter {
+	return &RateLimiter{
+		redisClient: redisClient,
+	}
+}
+
+// Allow counts a request for key and reports whether it fits within limit requests per window
+func (r *RateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (*RateLimitResult, error) {
+	res, err := slidingWindowScript.Run(ctx, r.redisClient,
+		[]string{fmt.Sprintf("rate_limit:%s", key)},
+		time.Now().UnixMilli(), window.Milliseconds(), limit, uuid.New().String(),
+	).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != 3 {
+		return nil, fmt.Errorf("unexpected rate limit script result: %v", res)
+	}
+
+	remaining := int(res[1])
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &RateLimitResult{
+		Allowed:    res[0] == 1,
+		Limit:      limit,
+		Remaining:  remaining,
+		ResetAfter: time.Duration(res[2]) * time.Millisecond,
+	}, nil
+}
@@ -2,6 +2,7 @@ package helper
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
@@ -24,9 +25,22 @@ func GetValidationMessage(fe validator.FieldError) string {
 	case "min":
 		return fmt.Sprintf("%s文字以上で入力してください", fe.Param())
 	case "max":
+		if fe.Kind() == reflect.Slice {
+			return fmt.Sprintf("%s件以内で指定してください", fe.Param())
+		}
 		return fmt.Sprintf("%s文字以内で入力してください", fe.Param())
 	case "len":
 		return fmt.Sprintf("%s文字で入力してください", fe.Param())
+	case "http_url":
+		return "http://またはhttps://で始まるURLを入力してください"
+	case "oneof":
+		return fmt.Sprintf("%sのいずれかを指定してください", strings.ReplaceAll(fe.Param(), " ", "、"))
+	case "password":
+		return "英小文字・英大文字・数字・記号のうち3種類以上を組み合わせ、同じ文字の繰り返しを避けてください"
+	case "uncommon_password":
+		return "よく使われているパスワードのため使用できません"
+	case "unbreached_password":
+		return "過去のデータ漏洩で流出したパスワードのため使用できません"
 	default:
 		return "入力内容が正しくありません"
 	}
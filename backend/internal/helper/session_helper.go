@@ -2,23 +2,171 @@ package helper
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/keu-5/muzee/backend/internal/util"
 	"github.com/redis/go-redis/v9"
 )
 
+const sessionTTL = 30 * 24 * time.Hour
+
+// MFAChallengeTTL is how long a password-verified login waits for its second factor
+const MFAChallengeTTL = 5 * time.Minute
+
+// DeviceChallengeTTL is how long a login from an unrecognized device waits for the emailed code
+const DeviceChallengeTTL = 10 * time.Minute
+
+// EmailRevertTTL is how long the old address can undo an email change
+const EmailRevertTTL = 7 * 24 * time.Hour
+
+// OIDCStateTTL is how long an OIDC authorization request may take to come back through the callback
+const OIDCStateTTL = 10 * time.Minute
+
+// SignupSessionTTL is how long a signup verification code stays valid
+const SignupSessionTTL = 15 * time.Minute
+
+// PasswordResetSessionTTL is how long a password reset code stays valid
+const PasswordResetSessionTTL = 15 * time.Minute
+
+// EmailChangeSessionTTL is how long the code sent to a new email address stays valid
+const EmailChangeSessionTTL = 15 * time.Minute
+
+// rotateRefreshTokenScript swaps the session's refresh token only if it is still the one being rotated,
+// so that two requests presenting the same token cannot both succeed.
+// Returns 1 when rotated, 0 when the token was already rotated and -1 when the session is gone.
+var rotateRefreshTokenScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if not current then
+	return -1
+end
+if cjson.decode(current)['refresh_token_hash'] ~= ARGV[1] then
+	return 0
+end
+
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[5])
+redis.call('SET', KEYS[2], ARGV[3], 'PX', ARGV[5])
+redis.call('SET', KEYS[3], ARGV[4], 'KEEPTTL')
+return 1
+`)
+
+var (
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+)
+
+// SignupSessionData keeps only the SHA-256 hash of the verification code sent by email
 type SignupSessionData struct {
 	PasswordHash string `json:"password_hash"`
-	Code         string `json:"code"`
+	CodeHash     string `json:"code_hash"`
+	CreatedAt    int64  `json:"created_at"`
+}
+
+// MatchesCode compares the code with the stored hash in constant time
+func (d *SignupSessionData) MatchesCode(code string) bool {
+	return subtle.ConstantTimeCompare([]byte(d.CodeHash), []byte(util.HashToken(code))) == 1
+}
+
+// PasswordResetSessionData keeps only the SHA-256 hash of the reset code sent by email
+type PasswordResetSessionData struct {
+	UserID    int64  `json:"user_id"`
+	CodeHash  string `json:"code_hash"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// MatchesCode compares the code with the stored hash in constant time
+func (d *PasswordResetSessionData) MatchesCode(code string) bool {
+	return subtle.ConstantTimeCompare([]byte(d.CodeHash), []byte(util.HashToken(code))) == 1
+}
+
+// EmailChangeSessionData keeps only the SHA-256 hash of the code sent to the new address
+type EmailChangeSessionData struct {
+	NewEmail  string `json:"new_email"`
+	CodeHash  string `json:"code_hash"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// MatchesCode compares the code with the stored hash in constant time
+func (d *EmailChangeSessionData) MatchesCode(code string) bool {
+	return subtle.ConstantTimeCompare([]byte(d.CodeHash), []byte(util.HashToken(code))) == 1
+}
+
+// EmailRevertData is stored under the SHA-256 hash of the revert token sent to the old address
+type EmailRevertData struct {
+	UserID    int64  `json:"user_id"`
+	OldEmail  string `json:"old_email"`
+	NewEmail  string `json:"new_email"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// MFAChallengeData is stored under the SHA-256 hash of the challenge token issued by Login
+type MFAChallengeData struct {
+	UserID    int64  `json:"user_id"`
+	ClientID  string `json:"client_id"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// DeviceChallengeData is stored under the SHA-256 hash of the challenge token issued for a login from a new device
+type DeviceChallengeData struct {
+	UserID    int64  `json:"user_id"`
+	ClientID  string `json:"client_id"`
+	CodeHash  string `json:"code_hash"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// MatchesCode compares the emailed code with the stored hash in constant time
+func (d *DeviceChallengeData) MatchesCode(code string) bool {
+	return subtle.ConstantTimeCompare([]byte(d.CodeHash), []byte(util.HashToken(code))) == 1
+}
+
+// OIDCStateData is stored under the SHA-256 hash of the state parameter of an OIDC authorization request.
+// BindingHash is the SHA-256 hash of a secret held by the client that started the flow, so that a state
+// and code obtained by someone else cannot be completed in another browser.
+type OIDCStateData struct {
+	Provider     string `json:"provider"`
+	ClientID     string `json:"client_id"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+	BindingHash  string `json:"binding_hash"`
 	CreatedAt    int64  `json:"created_at"`
 }
 
+// MatchesBinding compares the binding secret with the stored hash in constant time
+func (d *OIDCStateData) MatchesBinding(binding string) bool {
+	return binding != "" && subtle.ConstantTimeCompare([]byte(d.BindingHash), []byte(util.HashToken(binding))) == 1
+}
+
+// RefreshTokenData is stored under the SHA-256 hash of the refresh token.
+// Rotated tokens are kept with RotatedAt set so that a replay can be detected.
 type RefreshTokenData struct {
 	UserID    int64  `json:"user_id"`
 	ClientID  string `json:"client_id"`
+	SessionID string `json:"session_id"`
 	CreatedAt int64  `json:"created_at"`
+	RotatedAt int64  `json:"rotated_at,omitempty"`
+}
+
+// IsRotated reports whether the token has already been exchanged for a new one
+func (d *RefreshTokenData) IsRotated() bool {
+	return d.RotatedAt != 0
+}
+
+// SessionData represents one login on one device. All refresh tokens rotated
+// from the same login share the session ID, which acts as the token family.
+type SessionData struct {
+	ID               string `json:"id"`
+	UserID           int64  `json:"user_id"`
+	ClientID         string `json:"client_id"`
+	RefreshTokenHash string `json:"refresh_token_hash"`
+	IPAddress        string `json:"ip_address"`
+	UserAgent        string `json:"user_agent"`
+	CreatedAt        int64  `json:"created_at"`
+	LastUsedAt       int64  `json:"last_used_at"`
 }
 
 type SessionHelper struct {
@@ -31,63 +179,380 @@ func NewSessionHelper(redisClient *redis.Client) *SessionHelper {
 	}
 }
 
-// CheckRateLimit checks if the email has exceeded the rate limit for sending codes
-func (s *SessionHelper) CheckRateLimit(ctx context.Context, email string) error {
-	rateLimitKey := fmt.Sprintf("rate_limit:send_code:%s", email)
-	count, err := s.redisClient.Incr(ctx, rateLimitKey).Result()
+// SaveSignupSession saves the signup session data to Redis and resets its failure counter
+func (s *SessionHelper) SaveSignupSession(ctx context.Context, email, passwordHash, code string) error {
+	sessionData := SignupSessionData{
+		PasswordHash: passwordHash,
+		CodeHash:     util.HashToken(code),
+		CreatedAt:    time.Now().Unix(),
+	}
+	dataJSON, err := json.Marshal(sessionData)
 	if err != nil {
 		return err
 	}
+
+	key := fmt.Sprintf("signup:%s", email)
+	if err := s.redisClient.Set(ctx, key, dataJSON, SignupSessionTTL).Err(); err != nil {
+		return err
+	}
+	return s.redisClient.Del(ctx, fmt.Sprintf("signup_attempts:%s", email)).Err()
+}
+
+// GetSignupSession retrieves the signup session data from Redis
+func (s *SessionHelper) GetSignupSession(ctx context.Context, email string) (*SignupSessionData, error) {
+	key := fmt.Sprintf("signup:%s", email)
+	data, err := s.redisClient.Get(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var sessionData SignupSessionData
+	if err := json.Unmarshal([]byte(data), &sessionData); err != nil {
+		return nil, err
+	}
+
+	return &sessionData, nil
+}
+
+// RecordSignupCodeFailure counts a wrong code for the signup session and returns the total so far
+func (s *SessionHelper) RecordSignupCodeFailure(ctx context.Context, email string) (int64, error) {
+	key := fmt.Sprintf("signup_attempts:%s", email)
+	count, err := s.redisClient.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
 	if count == 1 {
-		s.redisClient.Expire(ctx, rateLimitKey, 5*time.Minute)
+		s.redisClient.Expire(ctx, key, SignupSessionTTL)
+	}
+	return count, nil
+}
+
+// DeleteSignupSession deletes the signup session data and its failure counter from Redis
+func (s *SessionHelper) DeleteSignupSession(ctx context.Context, email string) error {
+	return s.redisClient.Del(ctx,
+		fmt.Sprintf("signup:%s", email),
+		fmt.Sprintf("signup_attempts:%s", email),
+	).Err()
+}
+
+// CreateSession starts a new login session and saves its first refresh token to Redis
+func (s *SessionHelper) CreateSession(ctx context.Context, token string, userID int64, clientID, ipAddress, userAgent string) (*SessionData, error) {
+	now := time.Now().Unix()
+	session := &SessionData{
+		ID:               uuid.New().String(),
+		UserID:           userID,
+		ClientID:         clientID,
+		RefreshTokenHash: util.HashToken(token),
+		IPAddress:        ipAddress,
+		UserAgent:        userAgent,
+		CreatedAt:        now,
+		LastUsedAt:       now,
+	}
+
+	if err := s.saveSession(ctx, session); err != nil {
+		return nil, err
+	}
+	if err := s.saveRefreshToken(ctx, session.RefreshTokenHash, session); err != nil {
+		return nil, err
+	}
+
+	// ユーザー単位でセッションを一覧・一括失効できるように索引しておく
+	userKey := fmt.Sprintf("user_sessions:%d", userID)
+	if err := s.redisClient.SAdd(ctx, userKey, session.ID).Err(); err != nil {
+		return nil, err
+	}
+	if err := s.redisClient.Expire(ctx, userKey, sessionTTL).Err(); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// RotateRefreshToken replaces the session's refresh token and records the latest access.
+// The old token is marked as rotated instead of deleted so that replaying it can be detected.
+func (s *SessionHelper) RotateRefreshToken(ctx context.Context, oldToken, newToken string, tokenData *RefreshTokenData, ipAddress, userAgent string) error {
+	session, err := s.GetSession(ctx, tokenData.SessionID)
+	if err != nil {
+		return err
+	}
+
+	// 同じトークンで既にローテーション済みの場合は再利用とみなす
+	oldHash := util.HashToken(oldToken)
+	if session.RefreshTokenHash != oldHash {
+		return ErrRefreshTokenReused
+	}
+
+	now := time.Now().Unix()
+	session.RefreshTokenHash = util.HashToken(newToken)
+	session.IPAddress = ipAddress
+	session.UserAgent = userAgent
+	session.LastUsedAt = now
+
+	sessionJSON, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	newTokenJSON, err := json.Marshal(RefreshTokenData{
+		UserID:    session.UserID,
+		ClientID:  session.ClientID,
+		SessionID: session.ID,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+	rotated := *tokenData
+	rotated.RotatedAt = now
+	rotatedJSON, err := json.Marshal(rotated)
+	if err != nil {
+		return err
 	}
-	if count > 3 {
-		return fmt.Errorf("rate limit exceeded")
+
+	// 照合と書き換えを一度に行い、並行したリクエストによる二重ローテーションを防ぐ
+	keys := []string{
+		fmt.Sprintf("session:%s", session.ID),
+		fmt.Sprintf("refresh_token:%s", session.RefreshTokenHash),
+		fmt.Sprintf("refresh_token:%s", oldHash),
+	}
+	result, err := rotateRefreshTokenScript.Run(ctx, s.redisClient, keys,
+		oldHash, sessionJSON, newTokenJSON, rotatedJSON, sessionTTL.Milliseconds(),
+	).Int()
+	if err != nil {
+		return err
+	}
+	switch result {
+	case -1:
+		return ErrSessionNotFound
+	case 0:
+		return ErrRefreshTokenReused
 	}
 	return nil
 }
 
-// CheckLoginRateLimit checks if the email has exceeded the rate limit for login attempts
-func (s *SessionHelper) CheckLoginRateLimit(ctx context.Context, email string) error {
-	rateLimitKey := fmt.Sprintf("rate_limit:login:%s", email)
-	count, err := s.redisClient.Incr(ctx, rateLimitKey).Result()
+// GetRefreshToken retrieves the refresh token data from Redis
+func (s *SessionHelper) GetRefreshToken(ctx context.Context, token string) (*RefreshTokenData, error) {
+	key := fmt.Sprintf("refresh_token:%s", util.HashToken(token))
+	data, err := s.redisClient.Get(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenData RefreshTokenData
+	if err := json.Unmarshal([]byte(data), &tokenData); err != nil {
+		return nil, err
+	}
+
+	return &tokenData, nil
+}
+
+// DeleteRefreshToken deletes the refresh token and ends the session it belongs to
+func (s *SessionHelper) DeleteRefreshToken(ctx context.Context, token string) error {
+	tokenData, err := s.GetRefreshToken(ctx, token)
+	if err == nil && tokenData.SessionID != "" {
+		return s.RevokeSession(ctx, tokenData.UserID, tokenData.SessionID)
+	}
+
+	key := fmt.Sprintf("refresh_token:%s", util.HashToken(token))
+	return s.redisClient.Del(ctx, key).Err()
+}
+
+// GetSession retrieves the session data from Redis
+func (s *SessionHelper) GetSession(ctx context.Context, sessionID string) (*SessionData, error) {
+	key := fmt.Sprintf("session:%s", sessionID)
+	data, err := s.redisClient.Get(ctx, key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	var session SessionData
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// ListSessions returns the user's active sessions, most recently used first
+func (s *SessionHelper) ListSessions(ctx context.Context, userID int64) ([]*SessionData, error) {
+	userKey := fmt.Sprintf("user_sessions:%d", userID)
+	sessionIDs, err := s.redisClient.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*SessionData, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		session, err := s.GetSession(ctx, sessionID)
+		if err == ErrSessionNotFound {
+			// 期限切れのセッションは索引から取り除く
+			s.redisClient.SRem(ctx, userKey, sessionID)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt > sessions[j].LastUsedAt
+	})
+
+	return sessions, nil
+}
+
+// RevokeSession deletes the session and its refresh token if it belongs to the user,
+// and rejects the access tokens issued to the session
+func (s *SessionHelper) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	session, err := s.GetSession(ctx, sessionID)
 	if err != nil {
 		return err
 	}
-	if count == 1 {
-		s.redisClient.Expire(ctx, rateLimitKey, 15*time.Minute)
+	if session.UserID != userID {
+		return ErrSessionNotFound
+	}
+
+	// セッションのアクセストークンは有効期限が過ぎれば自然に失効するため、その間だけ保持
+	revokedKey := fmt.Sprintf("revoked_session:%s", session.ID)
+	if err := s.redisClient.Set(ctx, revokedKey, 1, util.AccessTokenTTL).Err(); err != nil {
+		return err
+	}
+
+	keys := []string{
+		fmt.Sprintf("session:%s", session.ID),
+		fmt.Sprintf("refresh_token:%s", session.RefreshTokenHash),
+	}
+	if err := s.redisClient.Del(ctx, keys...).Err(); err != nil {
+		return err
+	}
+
+	userKey := fmt.Sprintf("user_sessions:%d", userID)
+	return s.redisClient.SRem(ctx, userKey, session.ID).Err()
+}
+
+// RevokeAllSessions deletes every session and refresh token held by the user
+// and invalidates the access tokens issued to them so far
+func (s *SessionHelper) RevokeAllSessions(ctx context.Context, userID int64) error {
+	userKey := fmt.Sprintf("user_sessions:%d", userID)
+	sessionIDs, err := s.redisClient.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return err
+	}
+
+	for _, sessionID := range sessionIDs {
+		if err := s.RevokeSession(ctx, userID, sessionID); err != nil && err != ErrSessionNotFound {
+			return err
+		}
 	}
-	if count > 5 {
-		return fmt.Errorf("rate limit exceeded")
+
+	if err := s.redisClient.Del(ctx, userKey).Err(); err != nil {
+		return err
+	}
+
+	return s.RevokeAccessTokensIssuedBefore(ctx, userID, time.Now())
+}
+
+// RevokeOtherSessions deletes the user's sessions and refresh tokens except the given session
+func (s *SessionHelper) RevokeOtherSessions(ctx context.Context, userID int64, keepSessionID string) error {
+	sessions, err := s.ListSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.ID == keepSessionID {
+			continue
+		}
+		if err := s.RevokeSession(ctx, userID, session.ID); err != nil && err != ErrSessionNotFound {
+			return err
+		}
 	}
 	return nil
 }
 
-// SaveSignupSession saves the signup session data to Redis
-func (s *SessionHelper) SaveSignupSession(ctx context.Context, email, passwordHash, code string) error {
-	sessionData := SignupSessionData{
-		PasswordHash: passwordHash,
-		Code:         code,
-		CreatedAt:    time.Now().Unix(),
+// DenyAccessToken rejects a single access token by its jti until it would have expired anyway
+func (s *SessionHelper) DenyAccessToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if tokenID == "" || ttl <= 0 {
+		return nil
+	}
+
+	key := fmt.Sprintf("access_token_denylist:%s", tokenID)
+	return s.redisClient.Set(ctx, key, 1, ttl).Err()
+}
+
+// RevokeAccessTokensIssuedBefore rejects every access token of the user issued before t.
+// The watermark is kept in nanoseconds so that a token issued just after it in the same second stays valid.
+func (s *SessionHelper) RevokeAccessTokensIssuedBefore(ctx context.Context, userID int64, t time.Time) error {
+	// 古いトークンはアクセストークンの有効期限が過ぎれば自然に失効するため、その間だけ保持
+	key := fmt.Sprintf("access_token_watermark:%d", userID)
+	return s.redisClient.Set(ctx, key, t.UnixNano(), util.AccessTokenTTL).Err()
+}
+
+// IsAccessTokenRevoked reports whether the access token was denylisted, belongs to a revoked session
+// or was issued before the user's watermark
+func (s *SessionHelper) IsAccessTokenRevoked(ctx context.Context, userID int64, tokenID, sessionID string, issuedAt time.Time) (bool, error) {
+	pipe := s.redisClient.Pipeline()
+	var denied, sessionRevoked *redis.IntCmd
+	if tokenID != "" {
+		denied = pipe.Exists(ctx, fmt.Sprintf("access_token_denylist:%s", tokenID))
+	}
+	if sessionID != "" {
+		sessionRevoked = pipe.Exists(ctx, fmt.Sprintf("revoked_session:%s", sessionID))
+	}
+	watermark := pipe.Get(ctx, fmt.Sprintf("access_token_watermark:%d", userID))
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return false, err
+	}
+
+	if denied != nil && denied.Val() > 0 {
+		return true, nil
+	}
+	if sessionRevoked != nil && sessionRevoked.Val() > 0 {
+		return true, nil
+	}
+
+	revokedBefore, err := watermark.Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, err
+	}
+	return issuedAt.IsZero() || issuedAt.UnixNano() < revokedBefore, nil
+}
+
+// SavePasswordResetSession saves the password reset session data to Redis and resets its failure counter
+func (s *SessionHelper) SavePasswordResetSession(ctx context.Context, email string, userID int64, code string) error {
+	sessionData := PasswordResetSessionData{
+		UserID:    userID,
+		CodeHash:  util.HashToken(code),
+		CreatedAt: time.Now().Unix(),
 	}
 	dataJSON, err := json.Marshal(sessionData)
 	if err != nil {
 		return err
 	}
 
-	key := fmt.Sprintf("signup:%s", email)
-	return s.redisClient.Set(ctx, key, dataJSON, 15*time.Minute).Err()
+	key := fmt.Sprintf("password_reset:%s", email)
+	if err := s.redisClient.Set(ctx, key, dataJSON, PasswordResetSessionTTL).Err(); err != nil {
+		return err
+	}
+	return s.redisClient.Del(ctx, fmt.Sprintf("password_reset_attempts:%s", email)).Err()
 }
 
-// GetSignupSession retrieves the signup session data from Redis
-func (s *SessionHelper) GetSignupSession(ctx context.Context, email string) (*SignupSessionData, error) {
-	key := fmt.Sprintf("signup:%s", email)
+// GetPasswordResetSession retrieves the password reset session data from Redis
+func (s *SessionHelper) GetPasswordResetSession(ctx context.Context, email string) (*PasswordResetSessionData, error) {
+	key := fmt.Sprintf("password_reset:%s", email)
 	data, err := s.redisClient.Get(ctx, key).Result()
 	if err != nil {
 		return nil, err
 	}
 
-	var sessionData SignupSessionData
+	var sessionData PasswordResetSessionData
 	if err := json.Unmarshal([]byte(data), &sessionData); err != nil {
 		return nil, err
 	}
@@ -95,46 +560,288 @@ func (s *SessionHelper) GetSignupSession(ctx context.Context, email string) (*Si
 	return &sessionData, nil
 }
 
-// DeleteSignupSession deletes the signup session data from Redis
-func (s *SessionHelper) DeleteSignupSession(ctx context.Context, email string) error {
-	key := fmt.Sprintf("signup:%s", email)
-	return s.redisClient.Del(ctx, key).Err()
+// RecordPasswordResetCodeFailure counts a wrong code for the password reset session and returns the total so far
+func (s *SessionHelper) RecordPasswordResetCodeFailure(ctx context.Context, email string) (int64, error) {
+	key := fmt.Sprintf("password_reset_attempts:%s", email)
+	count, err := s.redisClient.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if count == 1 {
+		s.redisClient.Expire(ctx, key, PasswordResetSessionTTL)
+	}
+	return count, nil
 }
 
-// SaveRefreshToken saves the refresh token data to Redis
-func (s *SessionHelper) SaveRefreshToken(ctx context.Context, token string, userID int64, clientID string) error {
-	tokenData := RefreshTokenData{
+// DeletePasswordResetSession deletes the password reset session data and its failure counter from Redis
+func (s *SessionHelper) DeletePasswordResetSession(ctx context.Context, email string) error {
+	return s.redisClient.Del(ctx,
+		fmt.Sprintf("password_reset:%s", email),
+		fmt.Sprintf("password_reset_attempts:%s", email),
+	).Err()
+}
+
+// SaveEmailChangeSession saves a pending email change to Redis; a new request replaces the previous one
+// and resets its failure counter
+func (s *SessionHelper) SaveEmailChangeSession(ctx context.Context, userID int64, newEmail, code string) error {
+	sessionData := EmailChangeSessionData{
+		NewEmail:  newEmail,
+		CodeHash:  util.HashToken(code),
+		CreatedAt: time.Now().Unix(),
+	}
+	dataJSON, err := json.Marshal(sessionData)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("email_change:%d", userID)
+	if err := s.redisClient.Set(ctx, key, dataJSON, EmailChangeSessionTTL).Err(); err != nil {
+		return err
+	}
+	return s.redisClient.Del(ctx, fmt.Sprintf("email_change_attempts:%d", userID)).Err()
+}
+
+// GetEmailChangeSession retrieves the pending email change from Redis
+func (s *SessionHelper) GetEmailChangeSession(ctx context.Context, userID int64) (*EmailChangeSessionData, error) {
+	key := fmt.Sprintf("email_change:%d", userID)
+	data, err := s.redisClient.Get(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var sessionData EmailChangeSessionData
+	if err := json.Unmarshal([]byte(data), &sessionData); err != nil {
+		return nil, err
+	}
+
+	return &sessionData, nil
+}
+
+// RecordEmailChangeCodeFailure counts a wrong code for the pending email change and returns the total so far
+func (s *SessionHelper) RecordEmailChangeCodeFailure(ctx context.Context, userID int64) (int64, error) {
+	key := fmt.Sprintf("email_change_attempts:%d", userID)
+	count, err := s.redisClient.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if count == 1 {
+		s.redisClient.Expire(ctx, key, EmailChangeSessionTTL)
+	}
+	return count, nil
+}
+
+// DeleteEmailChangeSession deletes the pending email change and its failure counter from Redis
+func (s *SessionHelper) DeleteEmailChangeSession(ctx context.Context, userID int64) error {
+	return s.redisClient.Del(ctx,
+		fmt.Sprintf("email_change:%d", userID),
+		fmt.Sprintf("email_change_attempts:%d", userID),
+	).Err()
+}
+
+// SaveEmailRevertToken saves the token that lets the old address undo an email change
+func (s *SessionHelper) SaveEmailRevertToken(ctx context.Context, token string, userID int64, oldEmail, newEmail string) error {
+	revertData := EmailRevertData{
+		UserID:    userID,
+		OldEmail:  oldEmail,
+		NewEmail:  newEmail,
+		CreatedAt: time.Now().Unix(),
+	}
+	dataJSON, err := json.Marshal(revertData)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("email_revert:%s", util.HashToken(token))
+	return s.redisClient.Set(ctx, key, dataJSON, EmailRevertTTL).Err()
+}
+
+// ConsumeEmailRevertToken retrieves and deletes a revert token so that it can be used only once
+func (s *SessionHelper) ConsumeEmailRevertToken(ctx context.Context, token string) (*EmailRevertData, error) {
+	key := fmt.Sprintf("email_revert:%s", util.HashToken(token))
+	data, err := s.redisClient.GetDel(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var revertData EmailRevertData
+	if err := json.Unmarshal([]byte(data), &revertData); err != nil {
+		return nil, err
+	}
+
+	return &revertData, nil
+}
+
+// SaveMagicLink registers the ID of an issued magic link token so that it can be consumed exactly once
+func (s *SessionHelper) SaveMagicLink(ctx context.Context, tokenID string, userID int64, ttl time.Duration) error {
+	key := fmt.Sprintf("magic_link:%s", tokenID)
+	return s.redisClient.Set(ctx, key, userID, ttl).Err()
+}
+
+// ConsumeMagicLink deletes the magic link registration and returns the user it was issued for
+func (s *SessionHelper) ConsumeMagicLink(ctx context.Context, tokenID string) (int64, error) {
+	key := fmt.Sprintf("magic_link:%s", tokenID)
+	return s.redisClient.GetDel(ctx, key).Int64()
+}
+
+// SaveMFAChallenge saves a pending second-factor login to Redis
+func (s *SessionHelper) SaveMFAChallenge(ctx context.Context, token string, userID int64, clientID string) error {
+	challenge := MFAChallengeData{
 		UserID:    userID,
 		ClientID:  clientID,
 		CreatedAt: time.Now().Unix(),
 	}
-	dataJSON, err := json.Marshal(tokenData)
+	dataJSON, err := json.Marshal(challenge)
 	if err != nil {
 		return err
 	}
 
-	key := fmt.Sprintf("refresh_token:%s", token)
-	return s.redisClient.Set(ctx, key, dataJSON, 30*24*time.Hour).Err()
+	key := fmt.Sprintf("mfa_challenge:%s", util.HashToken(token))
+	return s.redisClient.Set(ctx, key, dataJSON, MFAChallengeTTL).Err()
 }
 
-// GetRefreshToken retrieves the refresh token data from Redis
-func (s *SessionHelper) GetRefreshToken(ctx context.Context, token string) (*RefreshTokenData, error) {
-	key := fmt.Sprintf("refresh_token:%s", token)
+// GetMFAChallenge retrieves a pending second-factor login from Redis
+func (s *SessionHelper) GetMFAChallenge(ctx context.Context, token string) (*MFAChallengeData, error) {
+	key := fmt.Sprintf("mfa_challenge:%s", util.HashToken(token))
 	data, err := s.redisClient.Get(ctx, key).Result()
 	if err != nil {
 		return nil, err
 	}
 
-	var tokenData RefreshTokenData
-	if err := json.Unmarshal([]byte(data), &tokenData); err != nil {
+	var challenge MFAChallengeData
+	if err := json.Unmarshal([]byte(data), &challenge); err != nil {
 		return nil, err
 	}
 
-	return &tokenData, nil
+	return &challenge, nil
 }
 
-// DeleteRefreshToken deletes the refresh token data from Redis
-func (s *SessionHelper) DeleteRefreshToken(ctx context.Context, token string) error {
-	key := fmt.Sprintf("refresh_token:%s", token)
-	return s.redisClient.Del(ctx, key).Err()
+// RecordMFAChallengeFailure counts a wrong code for the challenge and returns the total so far
+func (s *SessionHelper) RecordMFAChallengeFailure(ctx context.Context, token string) (int64, error) {
+	key := fmt.Sprintf("mfa_challenge_attempts:%s", util.HashToken(token))
+	count, err := s.redisClient.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if count == 1 {
+		s.redisClient.Expire(ctx, key, MFAChallengeTTL)
+	}
+	return count, nil
+}
+
+// DeleteMFAChallenge deletes the challenge and its failure counter from Redis
+func (s *SessionHelper) DeleteMFAChallenge(ctx context.Context, token string) error {
+	tokenHash := util.HashToken(token)
+	return s.redisClient.Del(ctx,
+		fmt.Sprintf("mfa_challenge:%s", tokenHash),
+		fmt.Sprintf("mfa_challenge_attempts:%s", tokenHash),
+	).Err()
+}
+
+// SaveDeviceChallenge saves a login from an unrecognized device that waits for the emailed code
+func (s *SessionHelper) SaveDeviceChallenge(ctx context.Context, token string, userID int64, clientID, code string) error {
+	challenge := DeviceChallengeData{
+		UserID:    userID,
+		ClientID:  clientID,
+		CodeHash:  util.HashToken(code),
+		CreatedAt: time.Now().Unix(),
+	}
+	dataJSON, err := json.Marshal(challenge)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("device_challenge:%s", util.HashToken(token))
+	return s.redisClient.Set(ctx, key, dataJSON, DeviceChallengeTTL).Err()
+}
+
+// GetDeviceChallenge retrieves a pending new-device login from Redis
+func (s *SessionHelper) GetDeviceChallenge(ctx context.Context, token string) (*DeviceChallengeData, error) {
+	key := fmt.Sprintf("device_challenge:%s", util.HashToken(token))
+	data, err := s.redisClient.Get(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var challenge DeviceChallengeData
+	if err := json.Unmarshal([]byte(data), &challenge); err != nil {
+		return nil, err
+	}
+
+	return &challenge, nil
+}
+
+// RecordDeviceChallengeFailure counts a wrong code for the challenge and returns the total so far
+func (s *SessionHelper) RecordDeviceChallengeFailure(ctx context.Context, token string) (int64, error) {
+	key := fmt.Sprintf("device_challenge_attempts:%s", util.HashToken(token))
+	count, err := s.redisClient.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if count == 1 {
+		s.redisClient.Expire(ctx, key, DeviceChallengeTTL)
+	}
+	return count, nil
+}
+
+// DeleteDeviceChallenge deletes the challenge and its failure counter from Redis
+func (s *SessionHelper) DeleteDeviceChallenge(ctx context.Context, token string) error {
+	tokenHash := util.HashToken(token)
+	return s.redisClient.Del(ctx,
+		fmt.Sprintf("device_challenge:%s", tokenHash),
+		fmt.Sprintf("device_challenge_attempts:%s", tokenHash),
+	).Err()
+}
+
+// SaveOIDCState saves the PKCE verifier and nonce of an OIDC authorization request to Redis
+func (s *SessionHelper) SaveOIDCState(ctx context.Context, state string, data *OIDCStateData) error {
+	data.CreatedAt = time.Now().Unix()
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("oidc_state:%s", util.HashToken(state))
+	return s.redisClient.Set(ctx, key, dataJSON, OIDCStateTTL).Err()
+}
+
+// ConsumeOIDCState retrieves and deletes an OIDC authorization request so that the state can be used only once
+func (s *SessionHelper) ConsumeOIDCState(ctx context.Context, state string) (*OIDCStateData, error) {
+	key := fmt.Sprintf("oidc_state:%s", util.HashToken(state))
+	data, err := s.redisClient.GetDel(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var stateData OIDCStateData
+	if err := json.Unmarshal([]byte(data), &stateData); err != nil {
+		return nil, err
+	}
+
+	return &stateData, nil
+}
+
+func (s *SessionHelper) saveSession(ctx context.Context, session *SessionData) error {
+	dataJSON, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("session:%s", session.ID)
+	return s.redisClient.Set(ctx, key, dataJSON, sessionTTL).Err()
+}
+
+func (s *SessionHelper) saveRefreshToken(ctx context.Context, tokenHash string, session *SessionData) error {
+	tokenData := RefreshTokenData{
+		UserID:    session.UserID,
+		ClientID:  session.ClientID,
+		SessionID: session.ID,
+		CreatedAt: time.Now().Unix(),
+	}
+	dataJSON, err := json.Marshal(tokenData)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("refresh_token:%s", tokenHash)
+	return s.redisClient.Set(ctx, key, dataJSON, sessionTTL).Err()
 }
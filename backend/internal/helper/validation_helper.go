@@ -0,0 +1,35 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+// NewValidator returns a validator with the custom tags used by the request structs.
+// The breach check needs a request context, so structs using it must be validated with StructCtx.
+func NewValidator(passwordPolicy *PasswordPolicy) *validator.Validate {
+	v := validator.New()
+	// RegisterValidation only fails for an empty tag or a nil function
+	_ = v.RegisterValidation("password", validatePassword)
+	_ = v.RegisterValidation("uncommon_password", func(fl validator.FieldLevel) bool {
+		return !passwordPolicy.IsCommon(fl.Field().String())
+	})
+	_ = v.RegisterValidationCtx("unbreached_password", func(ctx context.Context, fl validator.FieldLevel) bool {
+		breached, err := passwordPolicy.IsBreached(ctx, fl.Field().String())
+		if err != nil {
+			// 漏洩チェックの障害でパスワード設定を止めない
+			fmt.Printf("パスワード漏洩チェックエラー: %v\n", err)
+			return true
+		}
+		return !breached
+	})
+	return v
+}
+
+// validatePassword implements the "password" tag; length is left to min/max
+func validatePassword(fl validator.FieldLevel) bool {
+	return util.IsStrongPassword(fl.Field().String())
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/keu-5/muzee/backend/ent"
+	"github.com/keu-5/muzee/backend/ent/userprofile"
+	"github.com/keu-5/muzee/backend/internal/domain"
 	"go.uber.org/fx"
 )
 
@@ -13,6 +15,7 @@ func AutoMigrate(lc fx.Lifecycle, client *ent.Client, logger *Logger) {
 			if err := client.Schema.Create(ctx); err != nil {
 				logger.Fatalf("failed creating schema resources: %v", err)
 			}
+			backfillUsernameKeys(ctx, client, logger)
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
@@ -20,3 +23,27 @@ func AutoMigrate(lc fx.Lifecycle, client *ent.Client, logger *Logger) {
 		},
 	})
 }
+
+// backfillUsernameKeys sets the canonical username and skeleton of profiles created before
+// those columns existed. Profiles whose usernames differ only in case keep working through
+// exact-match lookups; the conflict is logged for manual resolution.
+func backfillUsernameKeys(ctx context.Context, client *ent.Client, logger *Logger) {
+	profiles, err := client.UserProfile.
+		Query().
+		Where(userprofile.UsernameCanonicalIsNil()).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("failed listing profiles without canonical username: %v", err)
+		return
+	}
+
+	for _, profile := range profiles {
+		err := profile.Update().
+			SetUsernameCanonical(domain.CanonicalUsername(profile.Username)).
+			SetUsernameSkeleton(domain.UsernameSkeleton(profile.Username)).
+			Exec(ctx)
+		if err != nil {
+			logger.Warnf("failed backfilling canonical username of profile %d (%s): %v", profile.ID, profile.Username, err)
+		}
+	}
+}
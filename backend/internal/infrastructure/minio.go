@@ -1,6 +1,7 @@
 package infrastructure
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"mime/multipart"
@@ -132,6 +133,26 @@ func (s *StorageService) UploadFile(ctx context.Context, bucketName string, obje
 	return nil
 }
 
+// PutObject uploads in-memory content, such as a processed image, to the specified bucket
+func (s *StorageService) PutObject(ctx context.Context, bucketName string, objectName string, data []byte, contentType string) error {
+	_, err := s.client.PutObject(
+		ctx,
+		bucketName,
+		objectName,
+		bytes.NewReader(data),
+		int64(len(data)),
+		minio.PutObjectOptions{
+			ContentType: contentType,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upload to MinIO: %w", err)
+	}
+
+	s.logger.Info(fmt.Sprintf("Uploaded file: %s/%s", bucketName, objectName))
+	return nil
+}
+
 // GetPresignedURL returns a presigned URL for accessing an object
 func (s *StorageService) GetPresignedURL(ctx context.Context, bucketName string, objectName string, expiry time.Duration) (string, error) {
 	presignedURL, err := s.client.PresignedGetObject(
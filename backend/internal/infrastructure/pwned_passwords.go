@@ -0,0 +1,98 @@
+package infrastructure
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// pwnedRangeMaxBytes bounds a range response; real ones are around 40KB even with padding
+const pwnedRangeMaxBytes = 4 << 20
+
+// PwnedPasswordsAPI queries a Have I Been Pwned compatible range API (GET <baseURL><prefix>)
+type PwnedPasswordsAPI struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func NewPwnedPasswordsAPI(baseURL string, httpClient *http.Client) *PwnedPasswordsAPI {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 5 * time.Second}
+	}
+	return &PwnedPasswordsAPI{
+		baseURL:    baseURL,
+		httpClient: httpClient,
+	}
+}
+
+func (a *PwnedPasswordsAPI) Range(ctx context.Context, prefix string) (map[string]int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.baseURL+prefix, nil)
+	if err != nil {
+		return nil, err
+	}
+	// パディングでレスポンスサイズからプレフィックスを推測されないようにする
+	req.Header.Set("Add-Padding", "true")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("pwned passwords: range request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("pwned passwords: range endpoint returned %d", resp.StatusCode)
+	}
+	return parsePwnedRange(io.LimitReader(resp.Body, pwnedRangeMaxBytes))
+}
+
+// PwnedPasswordsCorpus reads an offline copy of the range data, one <PREFIX>.txt file per prefix
+// as written by the official downloader in per-prefix mode
+type PwnedPasswordsCorpus struct {
+	dir string
+}
+
+func NewPwnedPasswordsCorpus(dir string) *PwnedPasswordsCorpus {
+	return &PwnedPasswordsCorpus{dir: dir}
+}
+
+func (c *PwnedPasswordsCorpus) Range(ctx context.Context, prefix string) (map[string]int, error) {
+	f, err := os.Open(filepath.Join(c.dir, strings.ToUpper(prefix)+".txt"))
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]int{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("pwned passwords: %w", err)
+	}
+	defer f.Close()
+
+	return parsePwnedRange(f)
+}
+
+// parsePwnedRange reads "SUFFIX:COUNT" lines; padding entries with a zero count are skipped
+func parsePwnedRange(r io.Reader) (map[string]int, error) {
+	suffixes := make(map[string]int)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		suffix, countStr, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok {
+			continue
+		}
+		count, err := strconv.Atoi(countStr)
+		if err != nil || count <= 0 {
+			continue
+		}
+		suffixes[strings.ToUpper(suffix)] = count
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("pwned passwords: invalid range data: %w", err)
+	}
+	return suffixes, nil
+}
@@ -0,0 +1,695 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+// maxEmailChangeCodeAttempts is how many wrong codes a pending email change accepts before it is discarded
+const maxEmailChangeCodeAttempts = 5
+
+// AccountHandler handles changes to the credentials of the authenticated user
+type AccountHandler struct {
+	authUC          usecase.AuthUsecase
+	userUC          usecase.UserUsecase
+	emailUC         usecase.EmailUsecase
+	deletionUC      usecase.AccountDeletionUsecase
+	securityEventUC usecase.SecurityEventUsecase
+	sessionHelper   *helper.SessionHelper
+	validate        *validator.Validate
+	jwtKeys         *util.JWTKeySet
+	goEnv           string
+	appURL          string
+}
+
+func NewAccountHandler(authUC usecase.AuthUsecase, userUC usecase.UserUsecase, emailUC usecase.EmailUsecase, deletionUC usecase.AccountDeletionUsecase, securityEventUC usecase.SecurityEventUsecase, sessionHelper *helper.SessionHelper, passwordPolicy *helper.PasswordPolicy, jwtKeys *util.JWTKeySet, goEnv, appURL string) *AccountHandler {
+	return &AccountHandler{
+		authUC:          authUC,
+		userUC:          userUC,
+		emailUC:         emailUC,
+		deletionUC:      deletionUC,
+		securityEventUC: securityEventUC,
+		sessionHelper:   sessionHelper,
+		validate:        helper.NewValidator(passwordPolicy),
+		jwtKeys:         jwtKeys,
+		goEnv:           goEnv,
+		appURL:          strings.TrimSuffix(appURL, "/"),
+	}
+}
+
+type ChangeEmailRequest struct {
+	NewEmail string `json:"new_email" validate:"required,email,max=255"`
+}
+
+type ChangeEmailResponse struct {
+	Message   string `json:"message"`
+	NewEmail  string `json:"new_email"`
+	ExpiresIn int    `json:"expires_in"`
+}
+
+type ConfirmEmailChangeRequest struct {
+	Code string `json:"code" validate:"required,len=6"`
+}
+
+type ConfirmEmailChangeResponse struct {
+	Message string `json:"message"`
+	Email   string `json:"email"`
+}
+
+type RevertEmailChangeRequest struct {
+	Token string `json:"token" validate:"required,max=255"`
+}
+
+type AccountMessageResponse struct {
+	Message string `json:"message"`
+}
+
+type DeleteAccountResponse struct {
+	Message             string `json:"message"`
+	DeletionScheduledAt string `json:"deletion_scheduled_at"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required,max=128"`
+	NewPassword     string `json:"new_password" validate:"required,min=8,max=128,password,uncommon_password,unbreached_password"`
+}
+
+// ChangePasswordResponse carries a fresh access token, since every token issued before the change is revoked
+type ChangePasswordResponse struct {
+	Message     string `json:"message"`
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// RequestEmailChange sends a confirmation code to the new email address
+//
+//	@Summary		Request email change
+//	@Description	Sends a 6-digit confirmation code to the new address. The email is not changed until the code is confirmed. A new request replaces any pending one.
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			request	body		ChangeEmailRequest	true	"New email"
+//	@Success		200		{object}	ChangeEmailResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		409		{object}	helper.ErrorResponse
+//	@Failure		429		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/me/email [post]
+func (h *AccountHandler) RequestEmailChange(c *fiber.Ctx) error {
+	// 1. リクエストパース
+	var req ChangeEmailRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	// 3. ユーザー取得
+	user, ok := h.currentUser(c)
+	if !ok {
+		return nil
+	}
+
+	ctx := c.Context()
+	newEmail := util.NormalizeEmail(req.NewEmail)
+
+	if newEmail == user.Email {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "same_email",
+			Message: "現在のメールアドレスと同じです",
+		})
+	}
+
+	// 4. 既存ユーザーの確認
+	existing, err := h.userUC.GetUserByEmail(ctx, newEmail)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+	if existing != nil {
+		return c.Status(fiber.StatusConflict).JSON(helper.ErrorResponse{
+			Error:   "email_already_exists",
+			Message: "このメールアドレスは既に使用されています",
+		})
+	}
+
+	// 5. 6桁の確認コード生成
+	code, err := util.GenerateVerificationCode()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 6. Redisに保存（15分間）
+	if err := h.sessionHelper.SaveEmailChangeSession(ctx, user.ID, newEmail, code); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 7. 新しいメールアドレスに確認コードを送信
+	if err := h.emailUC.SendEmailChangeCode(newEmail, code); err != nil {
+		fmt.Printf("メール送信エラー: %v\n", err)
+	}
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &user.ID,
+		Type:      domain.SecurityEventCodeSent,
+		Succeeded: true,
+		Email:     newEmail,
+		Detail:    eventDetail("email_change"),
+	})
+
+	// 8. レスポンス返却
+	return c.JSON(ChangeEmailResponse{
+		Message:   "確認コードを新しいメールアドレスに送信しました",
+		NewEmail:  newEmail,
+		ExpiresIn: 900,
+	})
+}
+
+// ConfirmEmailChange verifies the code and changes the email address
+//
+//	@Summary		Confirm email change
+//	@Description	Verifies the code sent to the new address and changes the login email. The old address receives a notification with a link to undo the change. All sessions are revoked, so every device has to log in again with the new address.
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			request	body		ConfirmEmailChangeRequest	true	"Confirmation code"
+//	@Success		200		{object}	ConfirmEmailChangeResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		409		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/me/email/confirm [post]
+func (h *AccountHandler) ConfirmEmailChange(c *fiber.Ctx) error {
+	// 1. リクエストパース
+	var req ConfirmEmailChangeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	// 3. ユーザー取得
+	user, ok := h.currentUser(c)
+	if !ok {
+		return nil
+	}
+
+	ctx := c.Context()
+
+	// 4. Redisからメールアドレス変更セッションを取得
+	sessionData, err := h.sessionHelper.GetEmailChangeSession(ctx, user.ID)
+	if err != nil || sessionData == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "session_not_found",
+			Message: "確認コードが無効または期限切れです。最初からやり直してください",
+		})
+	}
+
+	// 5. コード照合（失敗が続いた場合はセッションを破棄）
+	if !sessionData.MatchesCode(req.Code) {
+		recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+			UserID: &user.ID,
+			Type:   domain.SecurityEventEmailChanged,
+			Email:  sessionData.NewEmail,
+			Detail: eventDetail("invalid_code"),
+		})
+
+		attempts, err := h.sessionHelper.RecordEmailChangeCodeFailure(ctx, user.ID)
+		if err != nil || attempts >= maxEmailChangeCodeAttempts {
+			if err := h.sessionHelper.DeleteEmailChangeSession(ctx, user.ID); err != nil {
+				fmt.Printf("メールアドレス変更セッション削除エラー: %v\n", err)
+			}
+			return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+				Error:   "too_many_attempts",
+				Message: "確認コードの入力回数が上限に達しました。最初からやり直してください",
+			})
+		}
+
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_code",
+			Message: "確認コードが一致しません",
+		})
+	}
+
+	// 6. メールアドレスを更新
+	oldEmail := user.Email
+	if err := h.userUC.UpdateEmail(ctx, user.ID, sessionData.NewEmail); err != nil {
+		if errors.Is(err, usecase.ErrEmailAlreadyExists) {
+			return c.Status(fiber.StatusConflict).JSON(helper.ErrorResponse{
+				Error:   "email_already_exists",
+				Message: "このメールアドレスは既に使用されています",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "メールアドレスの更新に失敗しました",
+		})
+	}
+
+	if err := h.sessionHelper.DeleteEmailChangeSession(ctx, user.ID); err != nil {
+		fmt.Printf("メールアドレス変更セッション削除エラー: %v\n", err)
+	}
+
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &user.ID,
+		Type:      domain.SecurityEventEmailChanged,
+		Succeeded: true,
+		Email:     sessionData.NewEmail,
+	})
+
+	// 7. 旧メールアドレスに取り消し用リンクを送信
+	h.sendEmailChangedNotice(c, user.ID, oldEmail, sessionData.NewEmail)
+
+	// 8. 全セッションを失効し、クッキーを削除
+	if err := h.revokeAllSessions(c, user.ID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 9. レスポンス返却
+	return c.JSON(ConfirmEmailChangeResponse{
+		Message: "メールアドレスを変更しました。新しいメールアドレスで再度ログインしてください",
+		Email:   sessionData.NewEmail,
+	})
+}
+
+// RevertEmailChange restores the previous email address using the link sent to it
+//
+//	@Summary		Revert email change
+//	@Description	Restores the email address that was replaced, using the single-use token from the notification sent to the old address. All sessions are revoked; resetting the password afterwards is recommended.
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		RevertEmailChangeRequest	true	"Revert token"
+//	@Success		200		{object}	AccountMessageResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		409		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/auth/email/revert [post]
+func (h *AccountHandler) RevertEmailChange(c *fiber.Ctx) error {
+	// 1. リクエストパース
+	var req RevertEmailChangeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	ctx := c.Context()
+
+	// 3. 取り消しトークンを取得（使い捨て）
+	revertData, err := h.sessionHelper.ConsumeEmailRevertToken(ctx, req.Token)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_token",
+			Message: "リンクが無効または期限切れです",
+		})
+	}
+
+	// 4. その後さらに変更されていないことを確認
+	user, err := h.userUC.GetUserByID(ctx, revertData.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+	if user == nil || user.Email != revertData.NewEmail {
+		return c.Status(fiber.StatusConflict).JSON(helper.ErrorResponse{
+			Error:   "email_revert_conflict",
+			Message: "メールアドレスが既に変更されているため、元に戻せません",
+		})
+	}
+
+	// 5. 元のメールアドレスに戻す
+	if err := h.userUC.UpdateEmail(ctx, user.ID, revertData.OldEmail); err != nil {
+		if errors.Is(err, usecase.ErrEmailAlreadyExists) {
+			return c.Status(fiber.StatusConflict).JSON(helper.ErrorResponse{
+				Error:   "email_already_exists",
+				Message: "元のメールアドレスは既に別のアカウントで使用されています",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "メールアドレスの更新に失敗しました",
+		})
+	}
+
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &user.ID,
+		Type:      domain.SecurityEventEmailChanged,
+		Succeeded: true,
+		Email:     revertData.OldEmail,
+		Detail:    eventDetail("reverted"),
+	})
+
+	// 6. 変更した側のセッションを全て失効
+	if err := h.sessionHelper.RevokeAllSessions(ctx, user.ID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+	if err := h.sessionHelper.DeleteEmailChangeSession(ctx, user.ID); err != nil {
+		fmt.Printf("メールアドレス変更セッション削除エラー: %v\n", err)
+	}
+
+	// 7. レスポンス返却
+	return c.JSON(AccountMessageResponse{
+		Message: "メールアドレスを元に戻しました。安全のため、パスワードを再設定してください",
+	})
+}
+
+// ChangePassword replaces the password of the authenticated user
+//
+//	@Summary		Change password
+//	@Description	Verifies the current password and sets a new one. The new password must mix at least three of lowercase letters, uppercase letters, digits and symbols, and must not be a commonly used password or one found in known data breaches. Every other session and every access token issued before the change are revoked, so other devices have to log in again; the session of this request stays signed in and receives a new access token. Responds with 401 session_required when the session of this request cannot be identified (an access token without a session and no refresh token cookie); log in again and retry. A notification is sent to the account email.
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			request	body		ChangePasswordRequest	true	"Current password and new password"
+//	@Success		200		{object}	ChangePasswordResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		429		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/me/password [put]
+func (h *AccountHandler) ChangePassword(c *fiber.Ctx) error {
+	// 1. リクエストパース
+	var req ChangePasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.StructCtx(c.Context(), req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	// 3. ユーザー取得
+	user, ok := h.currentUser(c)
+	if !ok {
+		return nil
+	}
+
+	ctx := c.Context()
+	// 4. このリクエストのセッションを特定する。特定できない場合は、全セッションの失効後に
+	// どのセッションにも属さないトークンを発行することになるため、再ログインを求める
+	session := h.currentSession(c, user.ID)
+	if session == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "session_required",
+			Message: "ログインセッションを確認できません。再度ログインしてください。",
+		})
+	}
+	sessionID, clientID := session.ID, session.ClientID
+
+	// 5. 現在のパスワードを照合
+	if err := h.authUC.VerifyPassword(req.CurrentPassword, user.PasswordHash); err != nil {
+		recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+			UserID:   &user.ID,
+			Type:     domain.SecurityEventPasswordChanged,
+			Email:    user.Email,
+			ClientID: clientID,
+			Detail:   eventDetail("invalid_current_password"),
+		})
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_current_password",
+			Message: "現在のパスワードが間違っています",
+		})
+	}
+	if req.NewPassword == req.CurrentPassword {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "same_password",
+			Message: "現在と異なるパスワードを入力してください",
+		})
+	}
+
+	// 6. 新しいパスワードをハッシュ化して保存
+	passwordHash, err := h.authUC.HashPassword(req.NewPassword)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+	if err := h.userUC.UpdatePassword(ctx, user.ID, passwordHash); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "パスワードの更新に失敗しました",
+		})
+	}
+
+	// 7. このリクエストのセッション以外を失効し、発行済みのアクセストークンも無効化
+	if err := h.sessionHelper.RevokeOtherSessions(ctx, user.ID, sessionID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバー内部でエラーが発生しました",
+		})
+	}
+	if err := h.sessionHelper.RevokeAccessTokensIssuedBefore(ctx, user.ID, time.Now()); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバー内部でエラーが発生しました",
+		})
+	}
+
+	// 8. このリクエストには新しいアクセストークンを発行
+	hasProfile, err := h.userUC.CheckUserProfileExists(ctx, user.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+	accessToken, err := util.GenerateAccessToken(user.ID, sessionID, user.Email, hasProfile, domain.RoleNames(user.Roles), domain.RolePermissions(user.Roles), h.jwtKeys)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "トークンの生成に失敗しました",
+		})
+	}
+	if viaCookie, _ := c.Locals("auth_via_cookie").(bool); viaCookie {
+		c.Cookie(&fiber.Cookie{
+			Name:     "access_token",
+			Value:    accessToken,
+			HTTPOnly: true,
+			Secure:   h.goEnv == "production",
+			SameSite: "Lax",
+			MaxAge:   15 * 60,
+			Path:     "/",
+		})
+	}
+
+	// 9. 変更を記録し、セキュリティ通知メールを送信
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &user.ID,
+		Type:      domain.SecurityEventPasswordChanged,
+		Succeeded: true,
+		Email:     user.Email,
+		ClientID:  clientID,
+	})
+	if err := h.emailUC.SendPasswordChangedNotice(user.Email, h.appURL+"/password/forgot"); err != nil {
+		fmt.Printf("メール送信エラー: %v\n", err)
+	}
+
+	// 10. レスポンス返却
+	return c.JSON(ChangePasswordResponse{
+		Message:     "パスワードを変更しました。他のデバイスでは再度ログインが必要です",
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   900,
+	})
+}
+
+// currentSession returns the login session of this request, identified by the access token or,
+// for tokens issued without one, by the refresh token cookie. It returns nil when neither is known.
+func (h *AccountHandler) currentSession(c *fiber.Ctx, userID int64) *helper.SessionData {
+	ctx := c.Context()
+	sessionID, _ := c.Locals("session_id").(string)
+	if sessionID == "" {
+		refreshToken := c.Cookies("refresh_token")
+		if refreshToken == "" {
+			return nil
+		}
+		tokenData, err := h.sessionHelper.GetRefreshToken(ctx, refreshToken)
+		if err != nil || tokenData.IsRotated() || tokenData.UserID != userID {
+			return nil
+		}
+		sessionID = tokenData.SessionID
+	}
+
+	session, err := h.sessionHelper.GetSession(ctx, sessionID)
+	if err != nil || session.UserID != userID {
+		return nil
+	}
+	return session
+}
+
+// DeleteMe schedules deletion of the authenticated user's account
+//
+//	@Summary		Delete my account
+//	@Description	Schedules the account for deletion after the grace period and signs the user out of every device. Logging in again before deletion_scheduled_at cancels the deletion. Once the grace period has passed, the user, the profile, the uploaded icon and all sessions are removed permanently.
+//	@Tags			account
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Success		202	{object}	DeleteAccountResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/me [delete]
+func (h *AccountHandler) DeleteMe(c *fiber.Ctx) error {
+	// 1. ユーザー取得
+	user, ok := h.currentUser(c)
+	if !ok {
+		return nil
+	}
+
+	// 2. 猶予期間後の削除を予約
+	scheduledAt, err := h.deletionUC.ScheduleDeletion(c.Context(), user.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "退会処理に失敗しました",
+		})
+	}
+
+	// 3. 全セッションを失効し、クッキーを削除
+	if err := h.revokeAllSessions(c, user.ID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバー内部でエラーが発生しました",
+		})
+	}
+
+	// 4. レスポンス返却
+	return c.Status(fiber.StatusAccepted).JSON(DeleteAccountResponse{
+		Message:             "退会を受け付けました。期限までに再度ログインすると退会を取り消せます",
+		DeletionScheduledAt: scheduledAt.UTC().Format(time.RFC3339),
+	})
+}
+
+// currentUser loads the authenticated user; when it reports false the error response has been written
+func (h *AccountHandler) currentUser(c *fiber.Ctx) (*domain.User, bool) {
+	// ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+		return nil, false
+	}
+
+	user, err := h.userUC.GetUserByID(c.Context(), userID)
+	if err != nil {
+		c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+		return nil, false
+	}
+	if user == nil {
+		c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "user_not_found",
+			Message: "ユーザーが見つかりません",
+		})
+		return nil, false
+	}
+	return user, true
+}
+
+// sendEmailChangedNotice mails the old address a single-use link to undo the change; failures are only logged
+func (h *AccountHandler) sendEmailChangedNotice(c *fiber.Ctx, userID int64, oldEmail, newEmail string) {
+	token, err := util.GenerateURLSafeToken(32)
+	if err != nil {
+		fmt.Printf("取り消しトークン生成エラー: %v\n", err)
+		return
+	}
+	if err := h.sessionHelper.SaveEmailRevertToken(c.Context(), token, userID, oldEmail, newEmail); err != nil {
+		fmt.Printf("取り消しトークン保存エラー: %v\n", err)
+		return
+	}
+
+	revertURL := h.appURL + "/email/revert?token=" + url.QueryEscape(token)
+	if err := h.emailUC.SendEmailChangedNotice(oldEmail, newEmail, revertURL); err != nil {
+		fmt.Printf("メール送信エラー: %v\n", err)
+	}
+}
+
+// revokeAllSessions signs the user out everywhere, including the access token of this request
+func (h *AccountHandler) revokeAllSessions(c *fiber.Ctx, userID int64) error {
+	if err := h.sessionHelper.RevokeAllSessions(c.Context(), userID); err != nil {
+		return err
+	}
+
+	isProduction := h.goEnv == "production"
+	c.Cookie(&fiber.Cookie{
+		Name:     "access_token",
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HTTPOnly: true,
+		Secure:   isProduction,
+		SameSite: "Lax",
+	})
+	c.Cookie(&fiber.Cookie{
+		Name:     "refresh_token",
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HTTPOnly: true,
+		Secure:   isProduction,
+		SameSite: "Lax",
+	})
+	helper.ClearCSRFCookie(c, isProduction)
+	return nil
+}
@@ -0,0 +1,687 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/interface/middleware"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+	"github.com/keu-5/muzee/backend/internal/util"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// Mock AccountDeletionUsecase
+type mockAccountDeletionUsecase struct {
+	scheduleDeletionFunc func(ctx context.Context, userID int64) (time.Time, error)
+	purgeDueAccountsFunc func(ctx context.Context) (int, error)
+}
+
+func (m *mockAccountDeletionUsecase) ScheduleDeletion(ctx context.Context, userID int64) (time.Time, error) {
+	if m.scheduleDeletionFunc != nil {
+		return m.scheduleDeletionFunc(ctx, userID)
+	}
+	return time.Now().Add(30 * 24 * time.Hour), nil
+}
+
+func (m *mockAccountDeletionUsecase) PurgeDueAccounts(ctx context.Context) (int, error) {
+	if m.purgeDueAccountsFunc != nil {
+		return m.purgeDueAccountsFunc(ctx)
+	}
+	return 0, nil
+}
+
+func setupAccountTestApp(handler *AccountHandler, jwtKeys *util.JWTKeySet) *fiber.App {
+	app := fiber.New()
+	app.Post("/api/v1/auth/email/revert", handler.RevertEmailChange)
+	me := app.Group("/api/v1/me", newTestAuthMiddleware(jwtKeys))
+	me.Get("/ping", func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	me.Post("/email", handler.RequestEmailChange)
+	me.Post("/email/confirm", newTestRateLimit()(middleware.EmailChangeConfirmRateLimit), handler.ConfirmEmailChange)
+	return app
+}
+
+// accountTestUserUsecase keeps one user in memory so that email changes are observable
+func accountTestUserUsecase(user *domain.User, others map[string]*domain.User) *mockUserUsecase {
+	return &mockUserUsecase{
+		getUserByIDFunc: func(ctx context.Context, id int64) (*domain.User, error) {
+			if id == user.ID {
+				return user, nil
+			}
+			return nil, nil
+		},
+		getUserByEmailFunc: func(ctx context.Context, email string) (*domain.User, error) {
+			if email == user.Email {
+				return user, nil
+			}
+			return others[email], nil
+		},
+		updateEmailFunc: func(ctx context.Context, userID int64, email string) error {
+			if others[email] != nil {
+				return usecase.ErrEmailAlreadyExists
+			}
+			user.Email = email
+			return nil
+		},
+	}
+}
+
+func doAccountRequest(t *testing.T, app *fiber.App, method, path, token string, body interface{}) (int, []byte) {
+	var reader io.Reader
+	if body != nil {
+		b, _ := json.Marshal(body)
+		reader = bytes.NewReader(b)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, bodyBytes
+}
+
+func TestRequestEmailChange_Errors(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	user := &domain.User{ID: 4001, Email: "current@example.com", CreatedAt: time.Now()}
+	others := map[string]*domain.User{
+		"taken@example.com": {ID: 4999, Email: "taken@example.com"},
+	}
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+	handler := NewAccountHandler(&mockAuthUsecase{}, accountTestUserUsecase(user, others), &mockEmailUsecase{}, &mockAccountDeletionUsecase{}, &mockSecurityEventUsecase{}, sessionHelper, newTestPasswordPolicy(), jwtKeys, "development", "http://localhost:3000")
+	app := setupAccountTestApp(handler, jwtKeys)
+
+	token, _ := util.GenerateAccessToken(user.ID, "", user.Email, true, nil, nil, jwtKeys)
+
+	tests := []struct {
+		name       string
+		token      string
+		newEmail   string
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "unauthorized",
+			token:      "",
+			newEmail:   "new@example.com",
+			wantStatus: 401,
+		},
+		{
+			name:       "invalid email",
+			token:      token,
+			newEmail:   "not-an-email",
+			wantStatus: 400,
+			wantError:  "validation_error",
+		},
+		{
+			name:       "same email",
+			token:      token,
+			newEmail:   "Current@Example.com",
+			wantStatus: 400,
+			wantError:  "same_email",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doAccountRequest(t, app, "POST", "/api/v1/me/email", tt.token, ChangeEmailRequest{NewEmail: tt.newEmail})
+			assert.Equal(t, tt.wantStatus, status)
+
+			if tt.wantError != "" {
+				var errResp helper.ErrorResponse
+				json.Unmarshal(body, &errResp)
+				assert.Equal(t, tt.wantError, errResp.Error)
+			}
+		})
+	}
+
+	t.Run("email already exists", func(t *testing.T) {
+		if err := mockRedis.Ping(context.Background()).Err(); err != nil {
+			t.Skipf("Redis not available: %v", err)
+		}
+		status, body := doAccountRequest(t, app, "POST", "/api/v1/me/email", token, ChangeEmailRequest{NewEmail: "taken@example.com"})
+		assert.Equal(t, 409, status)
+
+		var errResp helper.ErrorResponse
+		json.Unmarshal(body, &errResp)
+		assert.Equal(t, "email_already_exists", errResp.Error)
+	})
+}
+
+func TestEmailChange_ConfirmAndRevert(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	user := &domain.User{ID: 4002, Email: "before@example.com", CreatedAt: time.Now()}
+
+	var sentCode, sentCodeTo, noticeTo, revertURL string
+	mockEmail := &mockEmailUsecase{
+		sendEmailChangeCodeFunc: func(email, code string) error {
+			sentCodeTo = email
+			sentCode = code
+			return nil
+		},
+		sendEmailChangedNoticeFunc: func(oldEmail, newEmail, link string) error {
+			noticeTo = oldEmail
+			revertURL = link
+			return nil
+		},
+	}
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	if err := mockRedis.Ping(context.Background()).Err(); err != nil {
+		t.Skipf("Redis not available: %v", err)
+	}
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+	handler := NewAccountHandler(&mockAuthUsecase{}, accountTestUserUsecase(user, nil), mockEmail, &mockAccountDeletionUsecase{}, &mockSecurityEventUsecase{}, sessionHelper, newTestPasswordPolicy(), jwtKeys, "development", "http://localhost:3000/")
+	app := setupAccountTestApp(handler, jwtKeys)
+
+	token, _ := util.GenerateAccessToken(user.ID, "", user.Email, true, nil, nil, jwtKeys)
+
+	// 1. 変更リクエスト
+	status, body := doAccountRequest(t, app, "POST", "/api/v1/me/email", token, ChangeEmailRequest{NewEmail: "After@Example.com"})
+	assert.Equal(t, 200, status)
+
+	var changeResp ChangeEmailResponse
+	json.Unmarshal(body, &changeResp)
+	assert.Equal(t, "after@example.com", changeResp.NewEmail)
+	assert.Equal(t, "after@example.com", sentCodeTo)
+	assert.Len(t, sentCode, 6)
+	assert.Equal(t, "before@example.com", user.Email)
+
+	// 2. 誤ったコード
+	wrongCode := "000000"
+	if sentCode == wrongCode {
+		wrongCode = "111111"
+	}
+	status, body = doAccountRequest(t, app, "POST", "/api/v1/me/email/confirm", token, ConfirmEmailChangeRequest{Code: wrongCode})
+	assert.Equal(t, 400, status)
+
+	var errResp helper.ErrorResponse
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "invalid_code", errResp.Error)
+
+	// 3. 正しいコードで確定
+	status, body = doAccountRequest(t, app, "POST", "/api/v1/me/email/confirm", token, ConfirmEmailChangeRequest{Code: sentCode})
+	assert.Equal(t, 200, status)
+
+	var confirmResp ConfirmEmailChangeResponse
+	json.Unmarshal(body, &confirmResp)
+	assert.Equal(t, "after@example.com", confirmResp.Email)
+	assert.Equal(t, "after@example.com", user.Email)
+
+	// 旧アドレスに取り消しリンクが届く
+	assert.Equal(t, "before@example.com", noticeTo)
+	parsed, err := url.Parse(revertURL)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:3000/email/revert", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	revertToken := parsed.Query().Get("token")
+	assert.NotEmpty(t, revertToken)
+
+	// 全セッション失効のため、使用中のアクセストークンも無効
+	status, _ = doAccountRequest(t, app, "GET", "/api/v1/me/ping", token, nil)
+	assert.Equal(t, 401, status)
+
+	// 4. 取り消しリンクで元に戻す
+	status, _ = doAccountRequest(t, app, "POST", "/api/v1/auth/email/revert", "", RevertEmailChangeRequest{Token: revertToken})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "before@example.com", user.Email)
+
+	// 取り消しトークンは使い捨て
+	status, body = doAccountRequest(t, app, "POST", "/api/v1/auth/email/revert", "", RevertEmailChangeRequest{Token: revertToken})
+	assert.Equal(t, 400, status)
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "invalid_token", errResp.Error)
+}
+
+func TestConfirmEmailChange_NoPendingChange(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	user := &domain.User{ID: 4003, Email: "nopending@example.com", CreatedAt: time.Now()}
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+	handler := NewAccountHandler(&mockAuthUsecase{}, accountTestUserUsecase(user, nil), &mockEmailUsecase{}, &mockAccountDeletionUsecase{}, &mockSecurityEventUsecase{}, sessionHelper, newTestPasswordPolicy(), jwtKeys, "development", "http://localhost:3000")
+	app := setupAccountTestApp(handler, jwtKeys)
+
+	token, _ := util.GenerateAccessToken(user.ID, "", user.Email, true, nil, nil, jwtKeys)
+
+	status, body := doAccountRequest(t, app, "POST", "/api/v1/me/email/confirm", token, ConfirmEmailChangeRequest{Code: "123456"})
+	assert.Equal(t, 400, status)
+
+	var errResp helper.ErrorResponse
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "session_not_found", errResp.Error)
+}
+
+func TestConfirmEmailChange_TooManyAttempts(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	user := &domain.User{ID: 4005, Email: "attempts@example.com", CreatedAt: time.Now()}
+
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	if err := mockRedis.Ping(context.Background()).Err(); err != nil {
+		t.Skipf("Redis not available: %v", err)
+	}
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+	handler := NewAccountHandler(&mockAuthUsecase{}, accountTestUserUsecase(user, nil), &mockEmailUsecase{}, &mockAccountDeletionUsecase{}, &mockSecurityEventUsecase{}, sessionHelper, newTestPasswordPolicy(), jwtKeys, "development", "http://localhost:3000")
+	app := setupAccountTestApp(handler, jwtKeys)
+
+	token, _ := util.GenerateAccessToken(user.ID, "", user.Email, true, nil, nil, jwtKeys)
+
+	ctx := context.Background()
+	code := "123456"
+	err := sessionHelper.SaveEmailChangeSession(ctx, user.ID, "attempts-new@example.com", code)
+	assert.NoError(t, err)
+
+	// コードはハッシュのみ保存される
+	sessionData, err := sessionHelper.GetEmailChangeSession(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.NotContains(t, sessionData.CodeHash, code)
+
+	// 上限未満は invalid_code、上限に達するとセッション自体が破棄される
+	var errResp helper.ErrorResponse
+	for i := 1; i <= maxEmailChangeCodeAttempts; i++ {
+		status, body := doAccountRequest(t, app, "POST", "/api/v1/me/email/confirm", token, ConfirmEmailChangeRequest{Code: "999999"})
+		assert.Equal(t, 400, status)
+		json.Unmarshal(body, &errResp)
+		if i < maxEmailChangeCodeAttempts {
+			assert.Equal(t, "invalid_code", errResp.Error)
+		} else {
+			assert.Equal(t, "too_many_attempts", errResp.Error)
+		}
+	}
+
+	// 破棄後は正しいコードでも通らない
+	status, body := doAccountRequest(t, app, "POST", "/api/v1/me/email/confirm", token, ConfirmEmailChangeRequest{Code: code})
+	assert.Equal(t, 400, status)
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "session_not_found", errResp.Error)
+	assert.Equal(t, "attempts@example.com", user.Email)
+
+	// コードを再発行しても、同じユーザーの試行回数は累積する
+	lastStatus := 0
+	for i := 0; i < 10; i++ {
+		err := sessionHelper.SaveEmailChangeSession(ctx, user.ID, "attempts-new@example.com", code)
+		assert.NoError(t, err)
+		lastStatus, _ = doAccountRequest(t, app, "POST", "/api/v1/me/email/confirm", token, ConfirmEmailChangeRequest{Code: "999999"})
+	}
+	assert.Equal(t, 429, lastStatus)
+}
+
+func TestRevertEmailChange_Conflict(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	user := &domain.User{ID: 4004, Email: "third@example.com", CreatedAt: time.Now()}
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+	handler := NewAccountHandler(&mockAuthUsecase{}, accountTestUserUsecase(user, nil), &mockEmailUsecase{}, &mockAccountDeletionUsecase{}, &mockSecurityEventUsecase{}, sessionHelper, newTestPasswordPolicy(), jwtKeys, "development", "http://localhost:3000")
+	app := setupAccountTestApp(handler, jwtKeys)
+
+	// 取り消しリンク発行後に、さらに別のアドレスへ変更されている
+	if err := sessionHelper.SaveEmailRevertToken(context.Background(), "revert-conflict-token", user.ID, "first@example.com", "second@example.com"); err != nil {
+		t.Skipf("Redis not available: %v", err)
+		return
+	}
+
+	status, body := doAccountRequest(t, app, "POST", "/api/v1/auth/email/revert", "", RevertEmailChangeRequest{Token: "revert-conflict-token"})
+	assert.Equal(t, 409, status)
+
+	var errResp helper.ErrorResponse
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "email_revert_conflict", errResp.Error)
+	assert.Equal(t, "third@example.com", user.Email)
+}
+
+func setupPasswordTestApp(authUC *mockAuthUsecase, userUC *mockUserUsecase, emailUC *mockEmailUsecase, jwtKeys *util.JWTKeySet) (*fiber.App, *helper.SessionHelper) {
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+	handler := NewAccountHandler(authUC, userUC, emailUC, &mockAccountDeletionUsecase{}, &mockSecurityEventUsecase{}, sessionHelper, newTestPasswordPolicy(), jwtKeys, "development", "http://localhost:3000")
+
+	app := fiber.New()
+	me := app.Group("/api/v1/me", newTestAuthMiddleware(jwtKeys))
+	me.Get("/ping", func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	me.Put("/password", handler.ChangePassword)
+	return app, sessionHelper
+}
+
+func TestChangePassword_ValidationError(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	user := &domain.User{ID: 4101, Email: "weak@example.com", PasswordHash: "hash"}
+	app, _ := setupPasswordTestApp(&mockAuthUsecase{}, accountTestUserUsecase(user, nil), &mockEmailUsecase{}, jwtKeys)
+
+	token, _ := util.GenerateAccessToken(user.ID, "", user.Email, true, nil, nil, jwtKeys)
+
+	tests := []struct {
+		name        string
+		newPassword string
+	}{
+		{name: "too short", newPassword: "Ab1!"},
+		{name: "lowercase only", newPassword: "passwordpassword"},
+		{name: "two character classes", newPassword: "password1234"},
+		{name: "repeated characters", newPassword: "Aa1Aa1Aa1Aa1"},
+		{name: "common password", newPassword: "P@ssw0rd1"},
+		{name: "breached password", newPassword: testBreachedPassword},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doAccountRequest(t, app, "PUT", "/api/v1/me/password", token, ChangePasswordRequest{
+				CurrentPassword: "OldPassw0rd!",
+				NewPassword:     tt.newPassword,
+			})
+			assert.Equal(t, 400, status)
+
+			var errResp helper.ErrorResponse
+			json.Unmarshal(body, &errResp)
+			assert.Equal(t, "validation_error", errResp.Error)
+			assert.NotEmpty(t, errResp.Details)
+		})
+	}
+}
+
+func TestChangePassword_Success(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	user := &domain.User{ID: 4102, Email: "change@example.com", PasswordHash: "old-hash"}
+
+	var updatedHash, noticeTo, resetURL string
+	userUC := accountTestUserUsecase(user, nil)
+	userUC.updatePasswordFunc = func(ctx context.Context, userID int64, passwordHash string) error {
+		updatedHash = passwordHash
+		return nil
+	}
+	authUC := &mockAuthUsecase{
+		verifyPasswordFunc: func(password, hash string) error {
+			if password == "OldPassw0rd!" && hash == "old-hash" {
+				return nil
+			}
+			return errors.New("mismatch")
+		},
+		hashPasswordFunc: func(password string) (string, error) {
+			return "hashed:" + password, nil
+		},
+	}
+	emailUC := &mockEmailUsecase{
+		sendPasswordChangedNoticeFunc: func(email, link string) error {
+			noticeTo = email
+			resetURL = link
+			return nil
+		},
+	}
+	app, sessionHelper := setupPasswordTestApp(authUC, userUC, emailUC, jwtKeys)
+
+	ctx := context.Background()
+	webSession, err := sessionHelper.CreateSession(ctx, "password-web-token", user.ID, "web", "127.0.0.1", "test")
+	if err != nil {
+		t.Skipf("Redis not available: %v", err)
+		return
+	}
+	_, err = sessionHelper.CreateSession(ctx, "password-ios-token", user.ID, "ios", "127.0.0.1", "test")
+	assert.NoError(t, err)
+
+	token, _ := util.GenerateAccessToken(user.ID, webSession.ID, user.Email, true, nil, nil, jwtKeys)
+	iosToken, _ := util.GenerateAccessToken(user.ID, "", user.Email, true, nil, nil, jwtKeys)
+
+	status, body := doAccountRequest(t, app, "PUT", "/api/v1/me/password", token, ChangePasswordRequest{
+		CurrentPassword: "OldPassw0rd!",
+		NewPassword:     "N3w-Passphrase",
+	})
+	assert.Equal(t, 200, status)
+
+	var response ChangePasswordResponse
+	json.Unmarshal(body, &response)
+	assert.NotEmpty(t, response.Message)
+	assert.NotEmpty(t, response.AccessToken)
+	assert.Equal(t, "hashed:N3w-Passphrase", updatedHash)
+
+	// アクセストークンのセッションだけが残る
+	sessions, err := sessionHelper.ListSessions(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+	if len(sessions) == 1 {
+		assert.Equal(t, webSession.ID, sessions[0].ID)
+	}
+	_, err = sessionHelper.GetRefreshToken(ctx, "password-ios-token")
+	assert.Error(t, err)
+
+	// 変更前に発行されたアクセストークンは全て無効になり、新しいトークンだけが使える
+	status, _ = doAccountRequest(t, app, "GET", "/api/v1/me/ping", token, nil)
+	assert.Equal(t, 401, status)
+	status, _ = doAccountRequest(t, app, "GET", "/api/v1/me/ping", iosToken, nil)
+	assert.Equal(t, 401, status)
+	status, _ = doAccountRequest(t, app, "GET", "/api/v1/me/ping", response.AccessToken, nil)
+	assert.Equal(t, 200, status)
+
+	claims, err := util.ValidateAccessToken(response.AccessToken, jwtKeys)
+	assert.NoError(t, err)
+	assert.Equal(t, webSession.ID, claims.SessionID)
+
+	// アカウントのメールアドレスにセキュリティ通知が届く
+	assert.Equal(t, "change@example.com", noticeTo)
+	assert.Equal(t, "http://localhost:3000/password/forgot", resetURL)
+
+	sessionHelper.RevokeAllSessions(ctx, user.ID)
+}
+
+func TestChangePassword_RequiresSession(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	user := &domain.User{ID: 4105, Email: "unknown-session@example.com", PasswordHash: "old-hash"}
+	userUC := accountTestUserUsecase(user, nil)
+	var updatedHash string
+	userUC.updatePasswordFunc = func(ctx context.Context, userID int64, passwordHash string) error {
+		updatedHash = passwordHash
+		return nil
+	}
+	authUC := &mockAuthUsecase{
+		verifyPasswordFunc: func(password, hash string) error {
+			return nil
+		},
+		hashPasswordFunc: func(password string) (string, error) {
+			return "hashed:" + password, nil
+		},
+	}
+	app, sessionHelper := setupPasswordTestApp(authUC, userUC, &mockEmailUsecase{}, jwtKeys)
+
+	ctx := context.Background()
+	webSession, err := sessionHelper.CreateSession(ctx, "unknown-session-web-token", user.ID, "web", "127.0.0.1", "test")
+	if err != nil {
+		t.Skipf("Redis not available: %v", err)
+		return
+	}
+	defer sessionHelper.RevokeAllSessions(ctx, user.ID)
+
+	// セッションを特定できないトークンでは、本文で client_id を指定しても変更できず、既存のセッションも残る
+	token, _ := util.GenerateAccessToken(user.ID, "", user.Email, true, nil, nil, jwtKeys)
+	status, body := doAccountRequest(t, app, "PUT", "/api/v1/me/password", token, map[string]string{
+		"current_password": "OldPassw0rd!",
+		"new_password":     "N3w-Passphrase",
+		"client_id":        "web",
+	})
+	assert.Equal(t, 401, status)
+
+	var errResp helper.ErrorResponse
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "session_required", errResp.Error)
+	assert.Empty(t, updatedHash)
+
+	sessions, err := sessionHelper.ListSessions(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+
+	// リフレッシュトークンのCookieがあれば、そのセッションを引き継いで変更できる
+	b, _ := json.Marshal(ChangePasswordRequest{CurrentPassword: "OldPassw0rd!", NewPassword: "N3w-Passphrase"})
+	req := httptest.NewRequest("PUT", "/api/v1/me/password", bytes.NewReader(b))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "unknown-session-web-token"})
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "hashed:N3w-Passphrase", updatedHash)
+
+	var response ChangePasswordResponse
+	json.NewDecoder(resp.Body).Decode(&response)
+	claims, err := util.ValidateAccessToken(response.AccessToken, jwtKeys)
+	assert.NoError(t, err)
+	if claims != nil {
+		assert.Equal(t, webSession.ID, claims.SessionID)
+	}
+}
+
+func TestChangePassword_Rejected(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+
+	tests := []struct {
+		name            string
+		userID          int64
+		currentPassword string
+		newPassword     string
+		wantError       string
+	}{
+		{
+			name:            "wrong current password",
+			userID:          4103,
+			currentPassword: "Wrong-Passw0rd",
+			newPassword:     "N3w-Passphrase",
+			wantError:       "invalid_current_password",
+		},
+		{
+			name:            "same as current password",
+			userID:          4104,
+			currentPassword: "OldPassw0rd!",
+			newPassword:     "OldPassw0rd!",
+			wantError:       "same_password",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &domain.User{ID: tt.userID, Email: "rejected@example.com", PasswordHash: "old-hash"}
+			userUC := accountTestUserUsecase(user, nil)
+			userUC.updatePasswordFunc = func(ctx context.Context, userID int64, passwordHash string) error {
+				t.Error("Expected password not to be updated")
+				return nil
+			}
+			authUC := &mockAuthUsecase{
+				verifyPasswordFunc: func(password, hash string) error {
+					if password == "OldPassw0rd!" {
+						return nil
+					}
+					return errors.New("mismatch")
+				},
+			}
+			app, sessionHelper := setupPasswordTestApp(authUC, userUC, &mockEmailUsecase{}, jwtKeys)
+			ctx := context.Background()
+			session, err := sessionHelper.CreateSession(ctx, fmt.Sprintf("password-rejected-%d", tt.userID), user.ID, "web", "127.0.0.1", "test")
+			if err != nil {
+				t.Skipf("Redis not available: %v", err)
+			}
+			defer sessionHelper.RevokeAllSessions(ctx, user.ID)
+
+			token, _ := util.GenerateAccessToken(user.ID, session.ID, user.Email, true, nil, nil, jwtKeys)
+
+			status, body := doAccountRequest(t, app, "PUT", "/api/v1/me/password", token, ChangePasswordRequest{
+				CurrentPassword: tt.currentPassword,
+				NewPassword:     tt.newPassword,
+			})
+			assert.Equal(t, 400, status)
+
+			var errResp helper.ErrorResponse
+			json.Unmarshal(body, &errResp)
+			assert.Equal(t, tt.wantError, errResp.Error)
+		})
+	}
+}
+
+func TestDeleteMe_Success(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	user := &domain.User{ID: 4201, Email: "leaving@example.com"}
+	scheduledAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var scheduledUserID int64
+	deletionUC := &mockAccountDeletionUsecase{
+		scheduleDeletionFunc: func(ctx context.Context, userID int64) (time.Time, error) {
+			scheduledUserID = userID
+			return scheduledAt, nil
+		},
+	}
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	if err := mockRedis.Ping(context.Background()).Err(); err != nil {
+		t.Skipf("Redis not available: %v", err)
+	}
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+	handler := NewAccountHandler(&mockAuthUsecase{}, accountTestUserUsecase(user, nil), &mockEmailUsecase{}, deletionUC, &mockSecurityEventUsecase{}, sessionHelper, newTestPasswordPolicy(), jwtKeys, "development", "http://localhost:3000")
+
+	app := fiber.New()
+	me := app.Group("/api/v1/me", newTestAuthMiddleware(jwtKeys))
+	me.Delete("/", handler.DeleteMe)
+	me.Get("/ping", func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	_, err := sessionHelper.CreateSession(context.Background(), "delete-me-token", user.ID, "web", "127.0.0.1", "test")
+	assert.NoError(t, err)
+	token, _ := util.GenerateAccessToken(user.ID, "", user.Email, true, nil, nil, jwtKeys)
+
+	status, body := doAccountRequest(t, app, "DELETE", "/api/v1/me", token, nil)
+	assert.Equal(t, 202, status)
+
+	var response DeleteAccountResponse
+	json.Unmarshal(body, &response)
+	assert.Equal(t, "2030-01-02T03:04:05Z", response.DeletionScheduledAt)
+	assert.Equal(t, user.ID, scheduledUserID)
+
+	// 全デバイスからログアウトされる
+	_, err = sessionHelper.GetRefreshToken(context.Background(), "delete-me-token")
+	assert.Error(t, err)
+	status, _ = doAccountRequest(t, app, "GET", "/api/v1/me/ping", token, nil)
+	assert.Equal(t, 401, status)
+}
+
+func TestDeleteMe_Errors(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	user := &domain.User{ID: 4202, Email: "leaving-error@example.com"}
+	deletionUC := &mockAccountDeletionUsecase{
+		scheduleDeletionFunc: func(ctx context.Context, userID int64) (time.Time, error) {
+			return time.Time{}, errors.New("database error")
+		},
+	}
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+	handler := NewAccountHandler(&mockAuthUsecase{}, accountTestUserUsecase(user, nil), &mockEmailUsecase{}, deletionUC, &mockSecurityEventUsecase{}, sessionHelper, newTestPasswordPolicy(), jwtKeys, "development", "http://localhost:3000")
+
+	app := fiber.New()
+	me := app.Group("/api/v1/me", newTestAuthMiddleware(jwtKeys))
+	me.Delete("/", handler.DeleteMe)
+
+	t.Run("unauthorized", func(t *testing.T) {
+		status, _ := doAccountRequest(t, app, "DELETE", "/api/v1/me", "", nil)
+		assert.Equal(t, 401, status)
+	})
+
+	t.Run("schedule failure", func(t *testing.T) {
+		token, _ := util.GenerateAccessToken(user.ID, "", user.Email, true, nil, nil, jwtKeys)
+		status, body := doAccountRequest(t, app, "DELETE", "/api/v1/me", token, nil)
+		assert.Equal(t, 500, status)
+
+		var errResp helper.ErrorResponse
+		json.Unmarshal(body, &errResp)
+		assert.Equal(t, "internal_server_error", errResp.Error)
+	})
+}
@@ -1,19 +1,34 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
 	"github.com/keu-5/muzee/backend/internal/helper"
 	"github.com/keu-5/muzee/backend/internal/usecase"
 	"github.com/keu-5/muzee/backend/internal/util"
 )
 
+// maxMFAAttempts is how many wrong codes a single MFA challenge accepts before it is discarded
+const maxMFAAttempts = 5
+
+// maxDeviceCodeAttempts is how many wrong codes a single new-device challenge accepts before it is discarded
+const maxDeviceCodeAttempts = 5
+
+// maxSignupCodeAttempts is how many wrong codes a signup session accepts before it is discarded
+const maxSignupCodeAttempts = 5
+
+// maxPasswordResetCodeAttempts is how many wrong codes a password reset session accepts before it is discarded
+const maxPasswordResetCodeAttempts = 5
+
 type SendCodeRequest struct {
 	Email    string `json:"email" validate:"required,email,max=255"`
-	Password string `json:"password" validate:"required,min=8,max=72"`
+	Password string `json:"password" validate:"required,min=8,max=128,uncommon_password,unbreached_password"`
 }
 
 type SendCodeResponse struct {
@@ -23,31 +38,37 @@ type SendCodeResponse struct {
 }
 
 type AuthHandler struct {
-	authUC        usecase.AuthUsecase
-	userUC        usecase.UserUsecase
-	emailUC       usecase.EmailUsecase
-	sessionHelper *helper.SessionHelper
-	validate      *validator.Validate
-	jwtSecret     string
-	goEnv         string
+	authUC          usecase.AuthUsecase
+	userUC          usecase.UserUsecase
+	emailUC         usecase.EmailUsecase
+	mfaUC           usecase.MFAUsecase
+	deviceUC        usecase.DeviceUsecase
+	securityEventUC usecase.SecurityEventUsecase
+	sessionHelper   *helper.SessionHelper
+	validate        *validator.Validate
+	jwtKeys         *util.JWTKeySet
+	goEnv           string
 }
 
-func NewAuthHandler(authUC usecase.AuthUsecase, userUC usecase.UserUsecase, emailUC usecase.EmailUsecase, sessionHelper *helper.SessionHelper, jwtSecret string, goEnv string) *AuthHandler {
+func NewAuthHandler(authUC usecase.AuthUsecase, userUC usecase.UserUsecase, emailUC usecase.EmailUsecase, mfaUC usecase.MFAUsecase, deviceUC usecase.DeviceUsecase, securityEventUC usecase.SecurityEventUsecase, sessionHelper *helper.SessionHelper, passwordPolicy *helper.PasswordPolicy, jwtKeys *util.JWTKeySet, goEnv string) *AuthHandler {
 	return &AuthHandler{
-		authUC:        authUC,
-		userUC:        userUC,
-		emailUC:       emailUC,
-		sessionHelper: sessionHelper,
-		validate:      validator.New(),
-		jwtSecret:     jwtSecret,
-		goEnv:         goEnv,
+		authUC:          authUC,
+		userUC:          userUC,
+		emailUC:         emailUC,
+		mfaUC:           mfaUC,
+		deviceUC:        deviceUC,
+		securityEventUC: securityEventUC,
+		sessionHelper:   sessionHelper,
+		validate:        helper.NewValidator(passwordPolicy),
+		jwtKeys:         jwtKeys,
+		goEnv:           goEnv,
 	}
 }
 
 // SendCode sends verification code to email
 //
 //	@Summary		Send verification code
-//	@Description	Sends a 6-digit verification code to the email for signup
+//	@Description	Sends a 6-digit verification code to the email for signup. Passwords on the list of commonly used passwords or found in known data breaches are rejected.
 //	@Tags			auth
 //	@Accept			json
 //	@Produce		json
@@ -68,10 +89,11 @@ func (h *AuthHandler) SendCode(c *fiber.Ctx) error {
 	}
 
 	// 2. バリデーション
-	if err := h.validate.Struct(req); err != nil {
+	if err := h.validate.StructCtx(c.Context(), req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
 	}
 
+	req.Email = util.NormalizeEmail(req.Email)
 	ctx := c.Context()
 
 	// 3. メールアドレスの重複チェック
@@ -89,15 +111,7 @@ func (h *AuthHandler) SendCode(c *fiber.Ctx) error {
 		})
 	}
 
-	// 4. レート制限チェック
-	if err := h.sessionHelper.CheckRateLimit(ctx, req.Email); err != nil {
-		return c.Status(fiber.StatusTooManyRequests).JSON(helper.ErrorResponse{
-			Error:   "rate_limit_exceeded",
-			Message: "送信回数が多すぎます。しばらく待ってから再度お試しください",
-		})
-	}
-
-	// 5. パスワードをハッシュ化
+	// 4. パスワードをハッシュ化
 	passwordHash, err := h.authUC.HashPassword(req.Password)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
@@ -106,7 +120,7 @@ func (h *AuthHandler) SendCode(c *fiber.Ctx) error {
 		})
 	}
 
-	// 6. 6桁の確認コード生成
+	// 5. 6桁の確認コード生成
 	code, err := util.GenerateVerificationCode()
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
@@ -115,7 +129,7 @@ func (h *AuthHandler) SendCode(c *fiber.Ctx) error {
 		})
 	}
 
-	// 7. Redisに保存（15分間）
+	// 6. Redisに保存（15分間）
 	if err := h.sessionHelper.SaveSignupSession(ctx, req.Email, passwordHash, code); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
 			Error:   "internal_server_error",
@@ -123,12 +137,18 @@ func (h *AuthHandler) SendCode(c *fiber.Ctx) error {
 		})
 	}
 
-	// 8. メール送信
+	// 7. メール送信
 	if err := h.emailUC.SendVerificationCode(req.Email, code); err != nil {
 		fmt.Printf("メール送信エラー: %v\n", err)
 	}
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		Type:      domain.SecurityEventCodeSent,
+		Succeeded: true,
+		Email:     req.Email,
+		Detail:    eventDetail("signup"),
+	})
 
-	// 9. レスポンス返却
+	// 8. レスポンス返却
 	return c.JSON(SendCodeResponse{
 		Message:   "確認コードを送信しました。メールを確認してください。",
 		Email:     req.Email,
@@ -174,17 +194,10 @@ func (h *AuthHandler) ResendCode(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
 	}
 
+	req.Email = util.NormalizeEmail(req.Email)
 	ctx := c.Context()
 
-	// 3. レート制限チェック
-	if err := h.sessionHelper.CheckRateLimit(ctx, req.Email); err != nil {
-		return c.Status(fiber.StatusTooManyRequests).JSON(helper.ErrorResponse{
-			Error:   "rate_limit_exceeded",
-			Message: "送信回数が多すぎます。しばらく待ってから再度お試しください",
-		})
-	}
-
-	// 4. Redisから既存のサインアップセッションを取得
+	// 3. Redisから既存のサインアップセッションを取得
 	sessionData, err := h.sessionHelper.GetSignupSession(ctx, req.Email)
 	if err != nil || sessionData == nil {
 		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
@@ -193,7 +206,7 @@ func (h *AuthHandler) ResendCode(c *fiber.Ctx) error {
 		})
 	}
 
-	// 5. 新しい6桁の確認コード生成
+	// 4. 新しい6桁の確認コード生成
 	code, err := util.GenerateVerificationCode()
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
@@ -202,7 +215,7 @@ func (h *AuthHandler) ResendCode(c *fiber.Ctx) error {
 		})
 	}
 
-	// 6. Redisに保存（15分間）
+	// 5. Redisに保存（15分間）
 	if err := h.sessionHelper.SaveSignupSession(ctx, req.Email, sessionData.PasswordHash, code); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
 			Error:   "internal_server_error",
@@ -210,12 +223,18 @@ func (h *AuthHandler) ResendCode(c *fiber.Ctx) error {
 		})
 	}
 
-	// 7. メール送信
+	// 6. メール送信
 	if err := h.emailUC.SendVerificationCode(req.Email, code); err != nil {
 		fmt.Printf("メール送信エラー: %v\n", err)
 	}
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		Type:      domain.SecurityEventCodeSent,
+		Succeeded: true,
+		Email:     req.Email,
+		Detail:    eventDetail("signup"),
+	})
 
-	// 8. レスポンス返却
+	// 7. レスポンス返却
 	return c.JSON(ResendCodeResponse{
 		Message:   "確認コードを再送信しました。メールを確認してください。",
 		Email:     req.Email,
@@ -247,13 +266,14 @@ type UserResponse struct {
 // VerifyCode verifies the code and creates user account
 //
 //	@Summary		Verify code and create account
-//	@Description	Verifies the 6-digit code and creates a user account. Returns tokens in JSON for mobile clients, and sets HttpOnly cookies for web browsers. Requires client_id for session tracking.
+//	@Description	Verifies the 6-digit code and creates a user account. Returns tokens in JSON for mobile clients, and sets HttpOnly cookies for web browsers. Requires client_id for session tracking. The signup session is discarded after 5 wrong codes, and attempts are throttled per IP address and email.
 //	@Tags			auth
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		VerifyCodeRequest	true	"Email, verification code, and client ID"
 //	@Success		201		{object}	VerifyCodeResponse
 //	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		429		{object}	helper.ErrorResponse
 //	@Failure		500		{object}	helper.ErrorResponse
 //	@Router			/v1/auth/signup/verify-code [post]
 func (h *AuthHandler) VerifyCode(c *fiber.Ctx) error {
@@ -271,6 +291,7 @@ func (h *AuthHandler) VerifyCode(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
 	}
 
+	req.Email = util.NormalizeEmail(req.Email)
 	ctx := c.Context()
 
 	// 3. Redisからサインアップセッションを取得
@@ -282,8 +303,19 @@ func (h *AuthHandler) VerifyCode(c *fiber.Ctx) error {
 		})
 	}
 
-	// 4. コード照合
-	if sessionData.Code != req.Code {
+	// 4. コード照合（失敗が続いた場合はセッションを破棄）
+	if !sessionData.MatchesCode(req.Code) {
+		attempts, err := h.sessionHelper.RecordSignupCodeFailure(ctx, req.Email)
+		if err != nil || attempts >= maxSignupCodeAttempts {
+			if err := h.sessionHelper.DeleteSignupSession(ctx, req.Email); err != nil {
+				fmt.Printf("サインアップセッション削除エラー: %v\n", err)
+			}
+			return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+				Error:   "too_many_attempts",
+				Message: "確認コードの入力回数が上限に達しました。最初からやり直してください",
+			})
+		}
+
 		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
 			Error:   "invalid_code",
 			Message: "確認コードが一致しません",
@@ -299,8 +331,8 @@ func (h *AuthHandler) VerifyCode(c *fiber.Ctx) error {
 		})
 	}
 
-	// 6. JWT生成
-	accessToken, err := util.GenerateAccessToken(user.ID, user.Email, false, h.jwtSecret)
+	// 6. リフレッシュトークン生成
+	refreshToken, err := util.GenerateRefreshToken()
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
 			Error:   "internal_server_error",
@@ -308,29 +340,38 @@ func (h *AuthHandler) VerifyCode(c *fiber.Ctx) error {
 		})
 	}
 
-	// 7. リフレッシュトークン生成
-	refreshToken, err := util.GenerateRefreshToken()
+	// 7. セッションを作成し、Redisにリフレッシュトークンを保存（30日間）
+	session, err := h.sessionHelper.CreateSession(ctx, refreshToken, user.ID, req.ClientID, c.IP(), c.Get(fiber.HeaderUserAgent))
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
 			Error:   "internal_server_error",
-			Message: "トークンの生成に失敗しました",
+			Message: "トークンの保存に失敗しました",
 		})
 	}
 
-	// 8. Redisにリフレッシュトークンを保存（30日間）
-	if err := h.sessionHelper.SaveRefreshToken(ctx, refreshToken, user.ID, req.ClientID); err != nil {
+	// 8. JWT生成
+	accessToken, err := util.GenerateAccessToken(user.ID, session.ID, user.Email, false, domain.RoleNames(user.Roles), domain.RolePermissions(user.Roles), h.jwtKeys)
+	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
 			Error:   "internal_server_error",
-			Message: "トークンの保存に失敗しました",
+			Message: "トークンの生成に失敗しました",
 		})
 	}
 
-	// 9. サインアップセッションを削除
+	// 9. サインアップセッションを削除し、最初のログインとして記録
 	if err := h.sessionHelper.DeleteSignupSession(ctx, req.Email); err != nil {
 		fmt.Printf("サインアップセッション削除エラー: %v\n", err)
 	}
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &user.ID,
+		Type:      domain.SecurityEventLogin,
+		Succeeded: true,
+		Email:     user.Email,
+		ClientID:  req.ClientID,
+		Detail:    eventDetail("signup"),
+	})
 
-	// 10. cookieに設定（モバイルアプリはレスポンスボディのトークンを使用）
+	// 10. cookieとCSRFトークンを設定（モバイルアプリはレスポンスボディのトークンを使用）
 	isProduction := h.goEnv == "production"
 
 	c.Cookie(&fiber.Cookie{
@@ -353,6 +394,13 @@ func (h *AuthHandler) VerifyCode(c *fiber.Ctx) error {
 		Path:     "/",
 	})
 
+	if err := helper.IssueCSRFCookie(c, isProduction); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "トークンの生成に失敗しました",
+		})
+	}
+
 	// 11. レスポンス返却
 	return c.Status(fiber.StatusCreated).JSON(VerifyCodeResponse{
 		Message:      "アカウントが作成されました",
@@ -370,7 +418,7 @@ func (h *AuthHandler) VerifyCode(c *fiber.Ctx) error {
 
 type LoginRequest struct {
 	Email    string `json:"email" validate:"required,email,max=255"`
-	Password string `json:"password" validate:"required,min=8,max=72"`
+	Password string `json:"password" validate:"required,min=8,max=128"`
 	ClientID string `json:"client_id" validate:"required,min=1,max=255"`
 }
 
@@ -383,15 +431,29 @@ type LoginResponse struct {
 	User         UserResponse `json:"user"`
 }
 
+type MFAChallengeResponse struct {
+	Message   string `json:"message"`
+	MFAToken  string `json:"mfa_token"`
+	ExpiresIn int    `json:"expires_in"`
+}
+
+type DeviceChallengeResponse struct {
+	Message     string `json:"message"`
+	DeviceToken string `json:"device_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
 // Login authenticates a user with email and password
 //
 //	@Summary		User login
-//	@Description	Authenticates user with email and password. Returns tokens in JSON for mobile clients, and sets HttpOnly cookies for web browsers. Requires client_id for session tracking.
+//	@Description	Authenticates user with email and password. Returns tokens in JSON for mobile clients, and sets HttpOnly cookies for web browsers. Web browsers also receive a readable csrf_token cookie whose value must be sent in the X-CSRF-Token header on cookie-authenticated POST, PUT, PATCH and DELETE requests. Requires client_id for session tracking. When two-factor authentication is enabled, responds with 202 and a short-lived MFA token instead; exchange it at /v1/auth/login/mfa. When NEW_DEVICE_VERIFICATION is step_up and the client_id is not a trusted device, responds with 202 and a device token instead and emails a code; exchange them at /v1/auth/login/device. Otherwise a login from an unrecognized client_id sends a new-device alert email. Signing in during the grace period of a scheduled account deletion cancels the deletion.
 //	@Tags			auth
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		LoginRequest	true	"Email, password, and client ID"
 //	@Success		200		{object}	LoginResponse
+//	@Success		202		{object}	MFAChallengeResponse
+//	@Success		202		{object}	DeviceChallengeResponse
 //	@Failure		400		{object}	helper.ErrorResponse
 //	@Failure		401		{object}	helper.ErrorResponse
 //	@Failure		429		{object}	helper.ErrorResponse
@@ -412,17 +474,10 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
 	}
 
+	req.Email = util.NormalizeEmail(req.Email)
 	ctx := c.Context()
 
-	// 3. レート制限チェック（ログイン試行）
-	if err := h.sessionHelper.CheckLoginRateLimit(ctx, req.Email); err != nil {
-		return c.Status(fiber.StatusTooManyRequests).JSON(helper.ErrorResponse{
-			Error:   "rate_limit_exceeded",
-			Message: "短時間に複数回ログインが試行されました。しばらくお待ちください",
-		})
-	}
-
-	// 4. ユーザーを取得
+	// 3. ユーザーを取得
 	user, err := h.userUC.GetUserByEmail(ctx, req.Email)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
@@ -431,39 +486,94 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 		})
 	}
 	if user == nil {
+		recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+			Type:     domain.SecurityEventLogin,
+			Email:    req.Email,
+			ClientID: req.ClientID,
+			Detail:   eventDetail("unknown_email"),
+		})
 		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
 			Error:   "invalid_credentials",
 			Message: "メールアドレスまたはパスワードが間違っています",
 		})
 	}
 
-	// 5. パスワード照合
+	// 4. パスワード照合
 	if err := h.authUC.VerifyPassword(req.Password, user.PasswordHash); err != nil {
+		recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+			UserID:   &user.ID,
+			Type:     domain.SecurityEventLogin,
+			Email:    req.Email,
+			ClientID: req.ClientID,
+			Detail:   eventDetail("invalid_password"),
+		})
 		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
 			Error:   "invalid_credentials",
 			Message: "メールアドレスまたはパスワードが間違っています",
 		})
 	}
 
-	// 6. ユーザープロフィールの有無を確認
-	hasProfile, err := h.userUC.CheckUserProfileExists(ctx, user.ID)
+	// 5. 旧形式（bcrypt）や古いパラメータのハッシュを現在の設定で再計算して保存する
+	if err := h.authUC.RehashPasswordIfNeeded(ctx, user, req.Password); err != nil {
+		fmt.Printf("パスワード再ハッシュエラー: %v\n", err)
+	}
+
+	// 6. 二要素認証が有効な場合はトークンを発行せずチャレンジを返す
+	if user.TOTPEnabled {
+		return h.startMFAChallenge(c, user, req.ClientID)
+	}
+
+	// 7. 信頼済みでないデバイスからのログインはメールの確認コードを要求する（step_up設定時）
+	stepUp, err := h.deviceUC.RequiresStepUp(ctx, user.ID, req.ClientID)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
 			Error:   "internal_server_error",
 			Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
 		})
 	}
+	if stepUp {
+		return h.startDeviceChallenge(c, user, req.ClientID)
+	}
+
+	// 8. トークン発行
+	return h.completeLogin(c, user, req.ClientID, "password")
+}
+
+// completeLogin issues an access token and a new session for an authenticated user and sets the auth cookies.
+// method names how the user authenticated and is recorded in the security event log.
+func (h *AuthHandler) completeLogin(c *fiber.Ctx, user *domain.User, clientID, method string) error {
+	ctx := c.Context()
+
+	// 1. 退会の猶予期間中であれば、ログインをもって退会を取り消す
+	if user.DeletionScheduledAt != nil {
+		if err := h.userUC.CancelAccountDeletion(ctx, user.ID); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+				Error:   "internal_server_error",
+				Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
+			})
+		}
+	}
+
+	// 2. デバイスを記録し、初めてのデバイスであればユーザーに通知する
+	userAgent := c.Get(fiber.HeaderUserAgent)
+	if _, isNew, err := h.deviceUC.RecordLogin(ctx, user.ID, clientID, c.IP(), userAgent, false); err != nil {
+		fmt.Printf("デバイス記録エラー: %v\n", err)
+	} else if isNew {
+		if err := h.emailUC.SendNewDeviceAlert(user.Email, c.IP(), userAgent, time.Now()); err != nil {
+			fmt.Printf("メール送信エラー: %v\n", err)
+		}
+	}
 
-	// 7. JWT生成
-	accessToken, err := util.GenerateAccessToken(user.ID, user.Email, hasProfile, h.jwtSecret)
+	// 3. ユーザープロフィールの有無を確認
+	hasProfile, err := h.userUC.CheckUserProfileExists(ctx, user.ID)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
 			Error:   "internal_server_error",
-			Message: "トークンの生成に失敗しました",
+			Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
 		})
 	}
 
-	// 8. リフレッシュトークン生成
+	// 4. リフレッシュトークン生成
 	refreshToken, err := util.GenerateRefreshToken()
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
@@ -472,15 +582,25 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 		})
 	}
 
-	// 9. Redisにリフレッシュトークンを保存（30日間）
-	if err := h.sessionHelper.SaveRefreshToken(ctx, refreshToken, user.ID, req.ClientID); err != nil {
+	// 5. セッションを作成し、Redisにリフレッシュトークンを保存（30日間）
+	session, err := h.sessionHelper.CreateSession(ctx, refreshToken, user.ID, clientID, c.IP(), userAgent)
+	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
 			Error:   "internal_server_error",
 			Message: "トークンの保存に失敗しました",
 		})
 	}
 
-	// 10. cookieに設定（モバイルアプリはレスポンスボディのトークンを使用）
+	// 6. JWT生成
+	accessToken, err := util.GenerateAccessToken(user.ID, session.ID, user.Email, hasProfile, domain.RoleNames(user.Roles), domain.RolePermissions(user.Roles), h.jwtKeys)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "トークンの生成に失敗しました",
+		})
+	}
+
+	// 7. cookieとCSRFトークンを設定（モバイルアプリはレスポンスボディのトークンを使用）
 	isProduction := h.goEnv == "production"
 
 	c.Cookie(&fiber.Cookie{
@@ -503,7 +623,24 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 		Path:     "/",
 	})
 
-	// 11. レスポンス返却
+	if err := helper.IssueCSRFCookie(c, isProduction); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "トークンの生成に失敗しました",
+		})
+	}
+
+	// 8. ログインを記録
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &user.ID,
+		Type:      domain.SecurityEventLogin,
+		Succeeded: true,
+		Email:     user.Email,
+		ClientID:  clientID,
+		Detail:    eventDetail(method),
+	})
+
+	// 9. レスポンス返却
 	return c.JSON(LoginResponse{
 		Message:      "ログインに成功しました",
 		AccessToken:  accessToken,
@@ -518,6 +655,293 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 	})
 }
 
+// startMFAChallenge stores a pending login and asks the client for the second factor
+func (h *AuthHandler) startMFAChallenge(c *fiber.Ctx, user *domain.User, clientID string) error {
+	mfaToken, err := util.GenerateRefreshToken()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "トークンの生成に失敗しました",
+		})
+	}
+
+	if err := h.sessionHelper.SaveMFAChallenge(c.Context(), mfaToken, user.ID, clientID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(MFAChallengeResponse{
+		Message:   "二段階認証コードを入力してください",
+		MFAToken:  mfaToken,
+		ExpiresIn: int(helper.MFAChallengeTTL.Seconds()),
+	})
+}
+
+// startDeviceChallenge stores a login from an unrecognized device and emails a code to confirm it
+func (h *AuthHandler) startDeviceChallenge(c *fiber.Ctx, user *domain.User, clientID string) error {
+	deviceToken, err := util.GenerateRefreshToken()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "トークンの生成に失敗しました",
+		})
+	}
+
+	code, err := util.GenerateVerificationCode()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "確認コードの生成に失敗しました",
+		})
+	}
+
+	if err := h.sessionHelper.SaveDeviceChallenge(c.Context(), deviceToken, user.ID, clientID, code); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
+		})
+	}
+
+	if err := h.emailUC.SendDeviceVerificationCode(user.Email, code, c.IP(), c.Get(fiber.HeaderUserAgent)); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "email_send_failed",
+			Message: "メール送信に失敗しました。しばらく待ってから再度お試しください",
+		})
+	}
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &user.ID,
+		Type:      domain.SecurityEventCodeSent,
+		Succeeded: true,
+		Email:     user.Email,
+		ClientID:  clientID,
+		Detail:    eventDetail("new_device"),
+	})
+
+	return c.Status(fiber.StatusAccepted).JSON(DeviceChallengeResponse{
+		Message:     "新しいデバイスからのログインです。メールに送信された確認コードを入力してください",
+		DeviceToken: deviceToken,
+		ExpiresIn:   int(helper.DeviceChallengeTTL.Seconds()),
+	})
+}
+
+type LoginMFARequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required,min=6,max=32"`
+}
+
+// VerifyLoginMFA completes a login that is waiting for the second factor
+//
+//	@Summary		Complete login with two-factor code
+//	@Description	Exchanges the MFA token returned by login plus a TOTP code or an unused recovery code for access and refresh tokens. The MFA token expires after 5 minutes and is invalidated after 5 wrong codes.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		LoginMFARequest	true	"MFA token and code"
+//	@Success		200		{object}	LoginResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		429		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/auth/login/mfa [post]
+func (h *AuthHandler) VerifyLoginMFA(c *fiber.Ctx) error {
+	// 1. リクエストパース
+	var req LoginMFARequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	ctx := c.Context()
+
+	// 3. チャレンジを取得
+	challenge, err := h.sessionHelper.GetMFAChallenge(ctx, req.MFAToken)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "mfa_token_invalid",
+			Message: "認証の有効期限が切れました。最初からログインしてください",
+		})
+	}
+
+	// 4. ユーザーを取得
+	user, err := h.userUC.GetUserByID(ctx, challenge.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
+		})
+	}
+	if user == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "mfa_token_invalid",
+			Message: "認証の有効期限が切れました。最初からログインしてください",
+		})
+	}
+
+	// 5. コード照合（失敗が続いた場合はチャレンジを破棄）
+	if err := h.mfaUC.VerifyCode(ctx, user, req.Code); err != nil {
+		if !errors.Is(err, usecase.ErrInvalidMFACode) {
+			return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+				Error:   "internal_server_error",
+				Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
+			})
+		}
+
+		recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+			UserID:   &user.ID,
+			Type:     domain.SecurityEventLogin,
+			Email:    user.Email,
+			ClientID: challenge.ClientID,
+			Detail:   eventDetail("invalid_mfa_code"),
+		})
+
+		attempts, err := h.sessionHelper.RecordMFAChallengeFailure(ctx, req.MFAToken)
+		if err != nil || attempts >= maxMFAAttempts {
+			if err := h.sessionHelper.DeleteMFAChallenge(ctx, req.MFAToken); err != nil {
+				fmt.Printf("MFAチャレンジ削除エラー: %v\n", err)
+			}
+			return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+				Error:   "mfa_token_invalid",
+				Message: "認証コードの入力回数が上限に達しました。最初からログインしてください",
+			})
+		}
+
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "invalid_mfa_code",
+			Message: "認証コードが正しくありません",
+		})
+	}
+
+	// 6. チャレンジを削除（使い捨て）
+	if err := h.sessionHelper.DeleteMFAChallenge(ctx, req.MFAToken); err != nil {
+		fmt.Printf("MFAチャレンジ削除エラー: %v\n", err)
+	}
+
+	// 7. トークン発行
+	return h.completeLogin(c, user, challenge.ClientID, "mfa")
+}
+
+type LoginDeviceRequest struct {
+	DeviceToken string `json:"device_token" validate:"required"`
+	Code        string `json:"code" validate:"required,len=6"`
+	TrustDevice bool   `json:"trust_device"`
+}
+
+// VerifyLoginDevice completes a login from an unrecognized device with the emailed code
+//
+//	@Summary		Complete login from a new device
+//	@Description	Exchanges the device token returned by login plus the 6-digit code emailed to the user for access and refresh tokens. Set trust_device to skip this step on later logins from the same client_id. The device token expires after 10 minutes and is invalidated after 5 wrong codes.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		LoginDeviceRequest	true	"Device token, code and whether to trust the device"
+//	@Success		200		{object}	LoginResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		429		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/auth/login/device [post]
+func (h *AuthHandler) VerifyLoginDevice(c *fiber.Ctx) error {
+	// 1. リクエストパース
+	var req LoginDeviceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	ctx := c.Context()
+
+	// 3. チャレンジを取得
+	challenge, err := h.sessionHelper.GetDeviceChallenge(ctx, req.DeviceToken)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "device_token_invalid",
+			Message: "認証の有効期限が切れました。最初からログインしてください",
+		})
+	}
+
+	// 4. コード照合（失敗が続いた場合はチャレンジを破棄）
+	if !challenge.MatchesCode(req.Code) {
+		recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+			UserID:   &challenge.UserID,
+			Type:     domain.SecurityEventLogin,
+			ClientID: challenge.ClientID,
+			Detail:   eventDetail("invalid_device_code"),
+		})
+
+		attempts, err := h.sessionHelper.RecordDeviceChallengeFailure(ctx, req.DeviceToken)
+		if err != nil || attempts >= maxDeviceCodeAttempts {
+			if err := h.sessionHelper.DeleteDeviceChallenge(ctx, req.DeviceToken); err != nil {
+				fmt.Printf("デバイス確認チャレンジ削除エラー: %v\n", err)
+			}
+			return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+				Error:   "device_token_invalid",
+				Message: "確認コードの入力回数が上限に達しました。最初からログインしてください",
+			})
+		}
+
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "invalid_device_code",
+			Message: "確認コードが正しくありません",
+		})
+	}
+
+	// 5. チャレンジを削除（使い捨て）
+	if err := h.sessionHelper.DeleteDeviceChallenge(ctx, req.DeviceToken); err != nil {
+		fmt.Printf("デバイス確認チャレンジ削除エラー: %v\n", err)
+	}
+
+	// 6. ユーザーを取得
+	user, err := h.userUC.GetUserByID(ctx, challenge.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
+		})
+	}
+	if user == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "device_token_invalid",
+			Message: "認証の有効期限が切れました。最初からログインしてください",
+		})
+	}
+
+	// 7. 確認済みのデバイスとして記録（メールで確認済みのため通知は送らない）
+	if _, _, err := h.deviceUC.RecordLogin(ctx, user.ID, challenge.ClientID, c.IP(), c.Get(fiber.HeaderUserAgent), req.TrustDevice); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
+		})
+	}
+
+	// 8. トークン発行
+	return h.completeLogin(c, user, challenge.ClientID, "new_device")
+}
+
+// respondCSRFTokenInvalid rejects a cookie-authenticated request without a matching CSRF header,
+// using the same response as middleware.CSRFProtection
+func respondCSRFTokenInvalid(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusForbidden).JSON(helper.ErrorResponse{
+		Error:   "csrf_token_invalid",
+		Message: "CSRFトークンが無効です。ページを再読み込みしてください",
+	})
+}
+
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token"`
 	ClientID     string `json:"client_id" validate:"required,min=1,max=255"`
@@ -533,7 +957,7 @@ type RefreshTokenResponse struct {
 // RefreshToken refreshes the access token using a refresh token
 //
 //	@Summary		Refresh access token
-//	@Description	Generates new access and refresh tokens. Accepts refresh token from either HttpOnly cookie (web) or request body (mobile). The old refresh token is invalidated. Requires client_id for session validation.
+//	@Description	Generates new access and refresh tokens. Accepts refresh token from either HttpOnly cookie (web) or request body (mobile). When the cookie is used, the X-CSRF-Token header must match the csrf_token cookie. The old refresh token is invalidated. Replaying an already rotated refresh token revokes the whole session (token family), and the access tokens issued to that session stop working as well. Requires client_id for session validation.
 //	@Tags			auth
 //	@Accept			json
 //	@Produce		json
@@ -541,6 +965,7 @@ type RefreshTokenResponse struct {
 //	@Success		200		{object}	RefreshTokenResponse
 //	@Failure		400		{object}	helper.ErrorResponse
 //	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		403		{object}	helper.ErrorResponse
 //	@Failure		500		{object}	helper.ErrorResponse
 //	@Router			/v1/auth/refresh [post]
 func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
@@ -563,6 +988,10 @@ func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
 	if req.RefreshToken != "" {
 		refreshToken = req.RefreshToken
 	} else {
+		// Cookieはブラウザが自動送信するため、CSRFトークンを確認する
+		if c.Cookies("refresh_token") != "" && !helper.HasValidCSRFToken(c) {
+			return respondCSRFTokenInvalid(c)
+		}
 		refreshToken = c.Cookies("refresh_token")
 	}
 
@@ -584,17 +1013,28 @@ func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
 		})
 	}
 
-	// 5. ClientIDの検証
+	// 5. 再利用の検知（ローテーション済みのトークンが使われた場合はトークンファミリーごと失効）
+	if tokenData.IsRotated() {
+		return h.rejectReusedRefreshToken(c, tokenData)
+	}
+
+	// 6. ClientIDの検証
 	if tokenData.ClientID != req.ClientID {
 		// ClientIDが一致しない場合、トークンが盗まれた可能性があるため削除
 		h.sessionHelper.DeleteRefreshToken(ctx, refreshToken)
+		recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+			UserID:   &tokenData.UserID,
+			Type:     domain.SecurityEventTokenRefresh,
+			ClientID: req.ClientID,
+			Detail:   eventDetail("client_id_mismatch"),
+		})
 		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
 			Error:   "client_id_mismatch",
 			Message: "認証情報が一致しません。再度ログインしてください。",
 		})
 	}
 
-	// 6. ユーザー情報を取得
+	// 7. ユーザー情報を取得
 	user, err := h.userUC.GetUserByID(ctx, tokenData.UserID)
 	if err != nil || user == nil {
 		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
@@ -603,11 +1043,6 @@ func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
 		})
 	}
 
-	// 7. 古いリフレッシュトークンを削除
-	if err := h.sessionHelper.DeleteRefreshToken(ctx, refreshToken); err != nil {
-		fmt.Printf("リフレッシュトークン削除エラー: %v\n", err)
-	}
-
 	// 8. ユーザープロフィールの有無を確認
 	hasProfile, err := h.userUC.CheckUserProfileExists(ctx, user.ID)
 	if err != nil {
@@ -618,7 +1053,7 @@ func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
 	}
 
 	// 9. 新しいアクセストークンを生成
-	newAccessToken, err := util.GenerateAccessToken(user.ID, user.Email, hasProfile, h.jwtSecret)
+	newAccessToken, err := util.GenerateAccessToken(user.ID, tokenData.SessionID, user.Email, hasProfile, domain.RoleNames(user.Roles), domain.RolePermissions(user.Roles), h.jwtKeys)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
 			Error:   "internal_server_error",
@@ -635,15 +1070,24 @@ func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
 		})
 	}
 
-	// 11. Redisに新しいリフレッシュトークンを保存（30日間）
-	if err := h.sessionHelper.SaveRefreshToken(ctx, newRefreshToken, user.ID, req.ClientID); err != nil {
+	// 11. 古いリフレッシュトークンを新しいものに差し替え（30日間）
+	if err := h.sessionHelper.RotateRefreshToken(ctx, refreshToken, newRefreshToken, tokenData, c.IP(), c.Get(fiber.HeaderUserAgent)); err != nil {
+		if errors.Is(err, helper.ErrRefreshTokenReused) {
+			return h.rejectReusedRefreshToken(c, tokenData)
+		}
+		if errors.Is(err, helper.ErrSessionNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+				Error:   "refresh_token_invalid",
+				Message: "リフレッシュトークンが無効または期限切れです。再度ログインしてください。",
+			})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
 			Error:   "internal_server_error",
 			Message: "トークンの保存に失敗しました",
 		})
 	}
 
-	// 12. cookieに設定（モバイルアプリはレスポンスボディのトークンを使用）
+	// 12. cookieとCSRFトークンを設定（モバイルアプリはレスポンスボディのトークンを使用）
 	isProduction := h.goEnv == "production"
 
 	c.Cookie(&fiber.Cookie{
@@ -666,7 +1110,23 @@ func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
 		Path:     "/",
 	})
 
-	// 13. レスポンス返却
+	if err := helper.RenewCSRFCookie(c, isProduction); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "トークンの生成に失敗しました",
+		})
+	}
+
+	// 13. 更新を記録
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &user.ID,
+		Type:      domain.SecurityEventTokenRefresh,
+		Succeeded: true,
+		Email:     user.Email,
+		ClientID:  req.ClientID,
+	})
+
+	// 14. レスポンス返却
 	return c.JSON(RefreshTokenResponse{
 		AccessToken:  newAccessToken,
 		RefreshToken: newRefreshToken,
@@ -675,6 +1135,25 @@ func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
 	})
 }
 
+// rejectReusedRefreshToken revokes the whole token family, including the access tokens of the session,
+// after a rotated refresh token was replayed
+func (h *AuthHandler) rejectReusedRefreshToken(c *fiber.Ctx, tokenData *helper.RefreshTokenData) error {
+	if err := h.sessionHelper.RevokeSession(c.Context(), tokenData.UserID, tokenData.SessionID); err != nil && !errors.Is(err, helper.ErrSessionNotFound) {
+		fmt.Printf("トークンファミリー失効エラー: %v\n", err)
+	}
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:   &tokenData.UserID,
+		Type:     domain.SecurityEventTokenRefresh,
+		ClientID: tokenData.ClientID,
+		Detail:   eventDetail("refresh_token_reused"),
+	})
+
+	return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+		Error:   "refresh_token_reused",
+		Message: "無効なリフレッシュトークンが使用されたため、このセッションを終了しました。再度ログインしてください。",
+	})
+}
+
 type LogoutRequest struct {
 	RefreshToken string `json:"refresh_token"`
 }
@@ -686,13 +1165,14 @@ type LogoutResponse struct {
 // Logout invalidates a refresh token
 //
 //	@Summary		User logout
-//	@Description	Invalidates the refresh token and ends the user's session. Accepts refresh token from either HttpOnly cookie (web) or request body (mobile). Also clears cookies for web browsers.
+//	@Description	Invalidates the refresh token and ends the user's session. Accepts refresh token from either HttpOnly cookie (web) or request body (mobile). When the cookie is used, the X-CSRF-Token header must match the csrf_token cookie. The access token sent with the request (cookie or Authorization header) is revoked as well. Also clears cookies for web browsers.
 //	@Tags			auth
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		LogoutRequest	true	"Refresh token (optional if using cookies)"
 //	@Success		200		{object}	LogoutResponse
 //	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		403		{object}	helper.ErrorResponse
 //	@Failure		500		{object}	helper.ErrorResponse
 //	@Router			/v1/auth/logout [post]
 func (h *AuthHandler) Logout(c *fiber.Ctx) error {
@@ -710,6 +1190,10 @@ func (h *AuthHandler) Logout(c *fiber.Ctx) error {
 	if req.RefreshToken != "" {
 		refreshToken = req.RefreshToken
 	} else {
+		// Cookieはブラウザが自動送信するため、CSRFトークンを確認する
+		if c.Cookies("refresh_token") != "" && !helper.HasValidCSRFToken(c) {
+			return respondCSRFTokenInvalid(c)
+		}
 		refreshToken = c.Cookies("refresh_token")
 	}
 
@@ -722,9 +1206,9 @@ func (h *AuthHandler) Logout(c *fiber.Ctx) error {
 
 	ctx := c.Context()
 
-	// 3. トークンの存在確認
-	_, err := h.sessionHelper.GetRefreshToken(ctx, refreshToken)
-	if err != nil {
+	// 3. トークンの存在確認（ローテーション済みのトークンは存在しないものとして扱う）
+	tokenData, err := h.sessionHelper.GetRefreshToken(ctx, refreshToken)
+	if err != nil || tokenData.IsRotated() {
 		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
 			Error:   "token_not_found",
 			Message: "セッションが存在しません。既にログアウト済みです。",
@@ -739,7 +1223,17 @@ func (h *AuthHandler) Logout(c *fiber.Ctx) error {
 		})
 	}
 
-	// 5. クッキー削除処理
+	// 5. 提示されたアクセストークンを有効期限まで失効リストに登録
+	if claims := h.accessTokenClaims(c); claims != nil && claims.UserID == tokenData.UserID && claims.ExpiresAt != nil {
+		if err := h.sessionHelper.DenyAccessToken(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+				Error:   "internal_server_error",
+				Message: "サーバー内部でエラーが発生しました",
+			})
+		}
+	}
+
+	// 6. クッキー削除処理
 	isProduction := h.goEnv == "production"
 
 	c.Cookie(&fiber.Cookie{
@@ -760,9 +1254,247 @@ func (h *AuthHandler) Logout(c *fiber.Ctx) error {
 		Secure:   isProduction,
 		SameSite: "Lax",
 	})
+	helper.ClearCSRFCookie(c, isProduction)
+
+	// 7. ログアウトを記録
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &tokenData.UserID,
+		Type:      domain.SecurityEventLogout,
+		Succeeded: true,
+		ClientID:  tokenData.ClientID,
+	})
 
-	// 6. レスポンス返却
+	// 8. レスポンス返却
 	return c.JSON(LogoutResponse{
 		Message: "ログアウトしました",
 	})
 }
+
+// accessTokenClaims returns the verified claims of the access token sent with the request, if any
+func (h *AuthHandler) accessTokenClaims(c *fiber.Ctx) *util.JWTClaims {
+	tokenString := c.Cookies("access_token")
+	if tokenString == "" {
+		tokenString = strings.TrimPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+	}
+	if tokenString == "" {
+		return nil
+	}
+
+	claims, err := util.ValidateAccessToken(tokenString, h.jwtKeys)
+	if err != nil {
+		return nil
+	}
+	return claims
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email,max=255"`
+}
+
+type ForgotPasswordResponse struct {
+	Message   string `json:"message"`
+	Email     string `json:"email"`
+	ExpiresIn int    `json:"expires_in"`
+}
+
+// ForgotPassword sends a password reset code to email
+//
+//	@Summary		Request password reset
+//	@Description	Sends a 6-digit password reset code to the email if an account exists. The response is the same whether or not the email is registered.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		ForgotPasswordRequest	true	"Email"
+//	@Success		200		{object}	ForgotPasswordResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		429		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/auth/password/forgot [post]
+func (h *AuthHandler) ForgotPassword(c *fiber.Ctx) error {
+	// 1. リクエストパース
+	var req ForgotPasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	req.Email = util.NormalizeEmail(req.Email)
+	ctx := c.Context()
+
+	res := ForgotPasswordResponse{
+		Message:   "パスワード再設定コードを送信しました。メールを確認してください。",
+		Email:     req.Email,
+		ExpiresIn: 900,
+	}
+
+	// 3. ユーザーを取得
+	user, err := h.userUC.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
+		})
+	}
+	if user == nil {
+		// アカウントの有無を推測されないよう、存在しない場合も同じレスポンスを返す
+		return c.JSON(res)
+	}
+
+	// 4. 6桁の確認コード生成
+	code, err := util.GenerateVerificationCode()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 5. Redisに保存（15分間）
+	if err := h.sessionHelper.SavePasswordResetSession(ctx, req.Email, user.ID, code); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 6. メール送信
+	if err := h.emailUC.SendPasswordResetCode(req.Email, code); err != nil {
+		fmt.Printf("メール送信エラー: %v\n", err)
+	}
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &user.ID,
+		Type:      domain.SecurityEventCodeSent,
+		Succeeded: true,
+		Email:     req.Email,
+		Detail:    eventDetail("password_reset"),
+	})
+
+	// 7. レスポンス返却
+	return c.JSON(res)
+}
+
+type ResetPasswordRequest struct {
+	Email       string `json:"email" validate:"required,email,max=255"`
+	Code        string `json:"code" validate:"required,len=6"`
+	NewPassword string `json:"new_password" validate:"required,min=8,max=128,uncommon_password,unbreached_password"`
+}
+
+type ResetPasswordResponse struct {
+	Message string `json:"message"`
+}
+
+// ResetPassword verifies the reset code and sets a new password
+//
+//	@Summary		Reset password
+//	@Description	Verifies the 6-digit password reset code and sets a new password. Passwords on the list of commonly used passwords or found in known data breaches are rejected. All refresh tokens held by the user are revoked, so every device has to log in again.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		ResetPasswordRequest	true	"Email, reset code, and new password"
+//	@Success		200		{object}	ResetPasswordResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/auth/password/reset [post]
+func (h *AuthHandler) ResetPassword(c *fiber.Ctx) error {
+	// 1. リクエストパース
+	var req ResetPasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.StructCtx(c.Context(), req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	req.Email = util.NormalizeEmail(req.Email)
+	ctx := c.Context()
+
+	// 3. Redisからパスワード再設定セッションを取得
+	sessionData, err := h.sessionHelper.GetPasswordResetSession(ctx, req.Email)
+	if err != nil || sessionData == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "session_not_found",
+			Message: "確認コードが無効または期限切れです。最初からやり直してください",
+		})
+	}
+
+	// 4. コード照合（失敗が続いた場合はセッションを破棄）
+	if !sessionData.MatchesCode(req.Code) {
+		recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+			UserID: &sessionData.UserID,
+			Type:   domain.SecurityEventPasswordReset,
+			Email:  req.Email,
+			Detail: eventDetail("invalid_code"),
+		})
+
+		attempts, err := h.sessionHelper.RecordPasswordResetCodeFailure(ctx, req.Email)
+		if err != nil || attempts >= maxPasswordResetCodeAttempts {
+			if err := h.sessionHelper.DeletePasswordResetSession(ctx, req.Email); err != nil {
+				fmt.Printf("パスワード再設定セッション削除エラー: %v\n", err)
+			}
+			return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+				Error:   "too_many_attempts",
+				Message: "確認コードの入力回数が上限に達しました。最初からやり直してください",
+			})
+		}
+
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_code",
+			Message: "確認コードが一致しません",
+		})
+	}
+
+	// 5. 新しいパスワードをハッシュ化
+	passwordHash, err := h.authUC.HashPassword(req.NewPassword)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 6. パスワードを更新
+	if err := h.userUC.UpdatePassword(ctx, sessionData.UserID, passwordHash); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "パスワードの更新に失敗しました",
+		})
+	}
+
+	// 7. 全てのリフレッシュトークンを失効
+	if err := h.sessionHelper.RevokeAllSessions(ctx, sessionData.UserID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバー内部でエラーが発生しました",
+		})
+	}
+
+	// 8. パスワード再設定セッションを削除
+	if err := h.sessionHelper.DeletePasswordResetSession(ctx, req.Email); err != nil {
+		fmt.Printf("パスワード再設定セッション削除エラー: %v\n", err)
+	}
+
+	// 9. 再設定を記録
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &sessionData.UserID,
+		Type:      domain.SecurityEventPasswordReset,
+		Succeeded: true,
+		Email:     req.Email,
+	})
+
+	// 10. レスポンス返却
+	return c.JSON(ResetPasswordResponse{
+		Message: "パスワードを再設定しました。新しいパスワードでログインしてください。",
+	})
+}
@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+)
+
+type DeviceHandler struct {
+	deviceUC usecase.DeviceUsecase
+	validate *validator.Validate
+}
+
+func NewDeviceHandler(deviceUC usecase.DeviceUsecase) *DeviceHandler {
+	return &DeviceHandler{
+		deviceUC: deviceUC,
+		validate: validator.New(),
+	}
+}
+
+type DeviceResponse struct {
+	ID         int64  `json:"id"`
+	ClientID   string `json:"client_id"`
+	Trusted    bool   `json:"trusted"`
+	IPAddress  string `json:"ip_address"`
+	UserAgent  string `json:"user_agent"`
+	CreatedAt  string `json:"created_at"`
+	LastSeenAt string `json:"last_seen_at"`
+}
+
+type ListMyDevicesResponse struct {
+	Devices []DeviceResponse `json:"devices"`
+}
+
+// ListMyDevices returns the devices the authenticated user has logged in from
+//
+//	@Summary		List my devices
+//	@Description	Returns every device (client ID) the currently authenticated user has logged in from, most recently seen first, with the IP address and user agent of the latest login.
+//	@Tags			devices
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Success		200	{object}	ListMyDevicesResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/me/devices [get]
+func (h *DeviceHandler) ListMyDevices(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. デバイス一覧を取得
+	devices, err := h.deviceUC.ListDevices(ctx, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 3. レスポンス返却
+	res := ListMyDevicesResponse{
+		Devices: make([]DeviceResponse, 0, len(devices)),
+	}
+	for _, device := range devices {
+		res.Devices = append(res.Devices, DeviceResponse{
+			ID:         device.ID,
+			ClientID:   device.ClientID,
+			Trusted:    device.Trusted,
+			IPAddress:  device.IPAddress,
+			UserAgent:  device.UserAgent,
+			CreatedAt:  device.CreatedAt.UTC().Format(time.RFC3339),
+			LastSeenAt: device.LastSeenAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+type UpdateDeviceRequest struct {
+	Trusted *bool `json:"trusted" validate:"required"`
+}
+
+type DeviceMessageResponse struct {
+	Message string `json:"message"`
+}
+
+// UpdateMyDevice marks one of the authenticated user's devices as trusted or untrusted
+//
+//	@Summary		Trust or distrust a device
+//	@Description	Sets whether the specified device is trusted. When NEW_DEVICE_VERIFICATION is step_up, logins from a trusted device skip the emailed code.
+//	@Tags			devices
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			id		path		int					true	"Device ID"
+//	@Param			request	body		UpdateDeviceRequest	true	"Trust flag"
+//	@Success		200		{object}	DeviceMessageResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		404		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/me/devices/{id} [patch]
+func (h *DeviceHandler) UpdateMyDevice(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. リクエストパース
+	deviceID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return deviceNotFound(c)
+	}
+	var req UpdateDeviceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 3. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	// 4. 信頼済みフラグを更新
+	if err := h.deviceUC.SetTrusted(ctx, userID, deviceID, *req.Trusted); err != nil {
+		if errors.Is(err, usecase.ErrDeviceNotFound) {
+			return deviceNotFound(c)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 5. レスポンス返却
+	message := "デバイスの信頼を解除しました"
+	if *req.Trusted {
+		message = "デバイスを信頼済みにしました"
+	}
+	return c.Status(fiber.StatusOK).JSON(DeviceMessageResponse{
+		Message: message,
+	})
+}
+
+// DeleteMyDevice forgets one of the authenticated user's devices
+//
+//	@Summary		Remove a device
+//	@Description	Removes the specified device from the list. The next login from it is treated as a new device again. Active sessions are not affected; revoke them at /v1/me/sessions.
+//	@Tags			devices
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			id	path		int	true	"Device ID"
+//	@Success		200	{object}	DeviceMessageResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		404	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/me/devices/{id} [delete]
+func (h *DeviceHandler) DeleteMyDevice(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. デバイスを削除
+	deviceID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return deviceNotFound(c)
+	}
+	if err := h.deviceUC.DeleteDevice(ctx, userID, deviceID); err != nil {
+		if errors.Is(err, usecase.ErrDeviceNotFound) {
+			return deviceNotFound(c)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 3. レスポンス返却
+	return c.Status(fiber.StatusOK).JSON(DeviceMessageResponse{
+		Message: "デバイスを削除しました",
+	})
+}
+
+func deviceNotFound(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusNotFound).JSON(helper.ErrorResponse{
+		Error:   "device_not_found",
+		Message: "デバイスが見つかりません",
+	})
+}
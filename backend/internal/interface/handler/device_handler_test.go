@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+	"github.com/keu-5/muzee/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTestDeviceApp(handler *DeviceHandler, jwtSecret string) *fiber.App {
+	app := fiber.New()
+	app.Get("/api/v1/me/devices", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.ListMyDevices)
+	app.Patch("/api/v1/me/devices/:id", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.UpdateMyDevice)
+	app.Delete("/api/v1/me/devices/:id", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.DeleteMyDevice)
+	return app
+}
+
+func newDeviceTestToken(t *testing.T, jwtSecret string, userID int64) string {
+	token, err := util.GenerateAccessToken(userID, "", "test@example.com", true, nil, nil, util.NewHMACKeySet(jwtSecret))
+	assert.NoError(t, err)
+	return token
+}
+
+func TestListMyDevices_Unauthorized(t *testing.T) {
+	handler := NewDeviceHandler(&mockDeviceUsecase{})
+	app := setupTestDeviceApp(handler, "test-secret-key")
+
+	req := httptest.NewRequest("GET", "/api/v1/me/devices", nil)
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 401, resp.StatusCode)
+}
+
+func TestListMyDevices_Success(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	lastSeen := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	var gotUserID int64
+	mockDevice := &mockDeviceUsecase{
+		listDevicesFunc: func(ctx context.Context, userID int64) ([]*domain.UserDevice, error) {
+			gotUserID = userID
+			return []*domain.UserDevice{
+				{ID: 7, UserID: userID, ClientID: "client-a", Trusted: true, IPAddress: "192.0.2.1", UserAgent: "agent-a", CreatedAt: lastSeen, LastSeenAt: lastSeen},
+			}, nil
+		},
+	}
+	handler := NewDeviceHandler(mockDevice)
+	app := setupTestDeviceApp(handler, jwtSecret)
+
+	req := httptest.NewRequest("GET", "/api/v1/me/devices", nil)
+	req.Header.Set("Authorization", "Bearer "+newDeviceTestToken(t, jwtSecret, 3001))
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int64(3001), gotUserID)
+
+	var response ListMyDevicesResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.NoError(t, json.Unmarshal(bodyBytes, &response))
+	assert.Len(t, response.Devices, 1)
+	assert.Equal(t, int64(7), response.Devices[0].ID)
+	assert.Equal(t, "client-a", response.Devices[0].ClientID)
+	assert.True(t, response.Devices[0].Trusted)
+	assert.Equal(t, "2030-01-02T03:04:05Z", response.Devices[0].LastSeenAt)
+}
+
+func TestUpdateMyDevice(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		setErr       error
+		expectedCode int
+		expectedErr  string
+		wantTrusted  *bool
+	}{
+		{name: "trust", path: "/api/v1/me/devices/7", body: `{"trusted":true}`, expectedCode: 200, wantTrusted: boolPtr(true)},
+		{name: "distrust", path: "/api/v1/me/devices/7", body: `{"trusted":false}`, expectedCode: 200, wantTrusted: boolPtr(false)},
+		{name: "missing trusted", path: "/api/v1/me/devices/7", body: `{}`, expectedCode: 400, expectedErr: "validation_error"},
+		{name: "invalid JSON", path: "/api/v1/me/devices/7", body: `{`, expectedCode: 400, expectedErr: "invalid_request"},
+		{name: "non-numeric id", path: "/api/v1/me/devices/abc", body: `{"trusted":true}`, expectedCode: 404, expectedErr: "device_not_found"},
+		{name: "not found", path: "/api/v1/me/devices/8", body: `{"trusted":true}`, setErr: usecase.ErrDeviceNotFound, expectedCode: 404, expectedErr: "device_not_found"},
+		{name: "usecase error", path: "/api/v1/me/devices/7", body: `{"trusted":true}`, setErr: errors.New("db error"), expectedCode: 500, expectedErr: "internal_server_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotTrusted *bool
+			mockDevice := &mockDeviceUsecase{
+				setTrustedFunc: func(ctx context.Context, userID, deviceID int64, trusted bool) error {
+					gotTrusted = &trusted
+					return tt.setErr
+				},
+			}
+			handler := NewDeviceHandler(mockDevice)
+			app := setupTestDeviceApp(handler, jwtSecret)
+
+			req := httptest.NewRequest("PATCH", tt.path, bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+newDeviceTestToken(t, jwtSecret, 3002))
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			if tt.expectedErr != "" {
+				var errResp helper.ErrorResponse
+				bodyBytes, _ := io.ReadAll(resp.Body)
+				assert.NoError(t, json.Unmarshal(bodyBytes, &errResp))
+				assert.Equal(t, tt.expectedErr, errResp.Error)
+			}
+			if tt.wantTrusted != nil {
+				assert.Equal(t, tt.wantTrusted, gotTrusted)
+			}
+		})
+	}
+}
+
+func TestDeleteMyDevice(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	tests := []struct {
+		name         string
+		deleteErr    error
+		expectedCode int
+	}{
+		{name: "success", expectedCode: 200},
+		{name: "not found", deleteErr: usecase.ErrDeviceNotFound, expectedCode: 404},
+		{name: "usecase error", deleteErr: errors.New("db error"), expectedCode: 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUserID, gotDeviceID int64
+			mockDevice := &mockDeviceUsecase{
+				deleteDeviceFunc: func(ctx context.Context, userID, deviceID int64) error {
+					gotUserID = userID
+					gotDeviceID = deviceID
+					return tt.deleteErr
+				},
+			}
+			handler := NewDeviceHandler(mockDevice)
+			app := setupTestDeviceApp(handler, jwtSecret)
+
+			req := httptest.NewRequest("DELETE", "/api/v1/me/devices/9", nil)
+			req.Header.Set("Authorization", "Bearer "+newDeviceTestToken(t, jwtSecret, 3003))
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			assert.Equal(t, int64(3003), gotUserID)
+			assert.Equal(t, int64(9), gotDeviceID)
+		})
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
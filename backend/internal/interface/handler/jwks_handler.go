@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+type JWKSHandler struct {
+	jwtKeys *util.JWTKeySet
+}
+
+func NewJWKSHandler(jwtKeys *util.JWTKeySet) *JWKSHandler {
+	return &JWKSHandler{jwtKeys: jwtKeys}
+}
+
+// GetJWKS publishes the public keys used to verify access tokens
+//
+//	@Summary		JSON Web Key Set
+//	@Description	Returns the public keys that verify access tokens, identified by the kid header of each token. Retired keys stay listed until the tokens they signed have expired. Shared HS256 secrets are never published, so the set is empty when asymmetric signing is not configured.
+//	@Tags			auth
+//	@Produce		json
+//	@Success		200	{object}	util.JWKS
+//	@Router			/.well-known/jwks.json [get]
+func (h *JWKSHandler) GetJWKS(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.Status(fiber.StatusOK).JSON(h.jwtKeys.JWKS())
+}
@@ -0,0 +1,351 @@
+package handler
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/util"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func writePrivateKeyPEM(t *testing.T, dir, kid string, key interface{}) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal private key: %v", err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+}
+
+func writePublicKeyPEM(t *testing.T, dir, kid string, key interface{}) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+}
+
+// setupTestKeyDir writes an active Ed25519 key and a retired RSA public key
+func setupTestKeyDir(t *testing.T) (string, *rsa.PrivateKey) {
+	dir := t.TempDir()
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ed25519 key: %v", err)
+	}
+	writePrivateKeyPEM(t, dir, "2025-02", edKey)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+	writePublicKeyPEM(t, dir, "2025-01", &rsaKey.PublicKey)
+
+	return dir, rsaKey
+}
+
+func setupTestJWTApp(jwtKeys *util.JWTKeySet) *fiber.App {
+	app := fiber.New()
+	app.Get("/.well-known/jwks.json", NewJWKSHandler(jwtKeys).GetJWKS)
+	app.Get("/protected", newTestAuthMiddleware(jwtKeys), func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"user_id": c.Locals("user_id")})
+	})
+	return app
+}
+
+func newTestClaims(userID int64) *util.JWTClaims {
+	return &util.JWTClaims{
+		UserID: userID,
+		Email:  "test@example.com",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(15 * time.Minute)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+}
+
+func requestProtected(t *testing.T, app *fiber.App, token string) int {
+	req := httptest.NewRequest("GET", "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req, -1)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestGetJWKS_PublishesPublicKeys(t *testing.T) {
+	dir, _ := setupTestKeyDir(t)
+	jwtKeys, err := util.LoadJWTKeySet(dir, "2025-02", "")
+	assert.NoError(t, err)
+
+	app := setupTestJWTApp(jwtKeys)
+
+	req := httptest.NewRequest("GET", "/.well-known/jwks.json", nil)
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Cache-Control"), "max-age")
+
+	var response util.JWKS
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	err = json.Unmarshal(bodyBytes, &response)
+	assert.NoError(t, err)
+	if !assert.Len(t, response.Keys, 2) {
+		return
+	}
+
+	assert.Equal(t, "2025-01", response.Keys[0].Kid)
+	assert.Equal(t, "RSA", response.Keys[0].Kty)
+	assert.Equal(t, "RS256", response.Keys[0].Alg)
+	assert.NotEmpty(t, response.Keys[0].N)
+	assert.Equal(t, "AQAB", response.Keys[0].E)
+
+	assert.Equal(t, "2025-02", response.Keys[1].Kid)
+	assert.Equal(t, "OKP", response.Keys[1].Kty)
+	assert.Equal(t, "Ed25519", response.Keys[1].Crv)
+	assert.Equal(t, "EdDSA", response.Keys[1].Alg)
+	assert.NotEmpty(t, response.Keys[1].X)
+	assert.NotContains(t, string(bodyBytes), `"d"`)
+}
+
+func TestGetJWKS_HMACNotPublished(t *testing.T) {
+	app := setupTestJWTApp(util.NewHMACKeySet("test-secret-key"))
+
+	req := httptest.NewRequest("GET", "/.well-known/jwks.json", nil)
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var response util.JWKS
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	err = json.Unmarshal(bodyBytes, &response)
+	assert.NoError(t, err)
+	assert.Empty(t, response.Keys)
+}
+
+func TestAuthMiddleware_ActiveKeySetsKid(t *testing.T) {
+	dir, _ := setupTestKeyDir(t)
+	jwtKeys, err := util.LoadJWTKeySet(dir, "2025-02", "")
+	assert.NoError(t, err)
+
+	token, err := util.GenerateAccessToken(1, "", "test@example.com", false, nil, nil, jwtKeys)
+	assert.NoError(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &util.JWTClaims{})
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-02", parsed.Header["kid"])
+	assert.Equal(t, "EdDSA", parsed.Header["alg"])
+
+	app := setupTestJWTApp(jwtKeys)
+	assert.Equal(t, 200, requestProtected(t, app, token))
+}
+
+func TestAuthMiddleware_RetiredKeyStillVerifies(t *testing.T) {
+	dir, rsaKey := setupTestKeyDir(t)
+	jwtKeys, err := util.LoadJWTKeySet(dir, "2025-02", "")
+	assert.NoError(t, err)
+
+	// ローテーション前の鍵で署名されたトークン
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, newTestClaims(1))
+	token.Header["kid"] = "2025-01"
+	tokenString, err := token.SignedString(rsaKey)
+	assert.NoError(t, err)
+
+	app := setupTestJWTApp(jwtKeys)
+	assert.Equal(t, 200, requestProtected(t, app, tokenString))
+}
+
+func TestAuthMiddleware_UnknownKid(t *testing.T) {
+	dir, rsaKey := setupTestKeyDir(t)
+	jwtKeys, err := util.LoadJWTKeySet(dir, "2025-02", "")
+	assert.NoError(t, err)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, newTestClaims(1))
+	token.Header["kid"] = "unknown"
+	tokenString, err := token.SignedString(rsaKey)
+	assert.NoError(t, err)
+
+	app := setupTestJWTApp(jwtKeys)
+	assert.Equal(t, 401, requestProtected(t, app, tokenString))
+}
+
+func TestAuthMiddleware_AlgorithmMismatchRejected(t *testing.T) {
+	dir, rsaKey := setupTestKeyDir(t)
+	jwtKeys, err := util.LoadJWTKeySet(dir, "2025-02", "")
+	assert.NoError(t, err)
+
+	// 公開鍵をHMACの秘密として使う典型的な取り違え攻撃
+	pubDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	assert.NoError(t, err)
+	pubPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, newTestClaims(1))
+	token.Header["kid"] = "2025-01"
+	tokenString, err := token.SignedString(pubPEM)
+	assert.NoError(t, err)
+
+	app := setupTestJWTApp(jwtKeys)
+	assert.Equal(t, 401, requestProtected(t, app, tokenString))
+}
+
+func TestAuthMiddleware_LegacyHS256Fallback(t *testing.T) {
+	dir, _ := setupTestKeyDir(t)
+	legacyToken, err := util.GenerateAccessToken(1, "", "test@example.com", false, nil, nil, util.NewHMACKeySet("test-secret-key"))
+	assert.NoError(t, err)
+
+	// 移行期間中は旧シークレットで署名されたトークンも受け付ける
+	withLegacy, err := util.LoadJWTKeySet(dir, "2025-02", "test-secret-key")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, requestProtected(t, setupTestJWTApp(withLegacy), legacyToken))
+
+	// シークレットを外すと受け付けない
+	withoutLegacy, err := util.LoadJWTKeySet(dir, "2025-02", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 401, requestProtected(t, setupTestJWTApp(withoutLegacy), legacyToken))
+}
+
+func TestAuthMiddleware_WatermarkRevokesOlderTokens(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	userID := int64(3001)
+
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+
+	ctx := context.Background()
+	if err := sessionHelper.RevokeAccessTokensIssuedBefore(ctx, userID, time.Now()); err != nil {
+		t.Skipf("Redis not available: %v", err)
+		return
+	}
+
+	// 失効時刻より前に発行されたトークン
+	claims := newTestClaims(userID)
+	claims.IssuedAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	oldToken, err := jwtKeys.Sign(claims)
+	assert.NoError(t, err)
+
+	app := setupTestJWTApp(jwtKeys)
+	assert.Equal(t, 401, requestProtected(t, app, oldToken))
+
+	// 失効時刻以降に発行されたトークンは有効
+	claims = newTestClaims(userID)
+	claims.IssuedAt = jwt.NewNumericDate(time.Now().Add(time.Second))
+	newToken, err := jwtKeys.Sign(claims)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, requestProtected(t, app, newToken))
+}
+
+func TestAuthMiddleware_WatermarkKeepsTokensIssuedLaterInSameSecond(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	userID := int64(3003)
+
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+	if err := mockRedis.Ping(context.Background()).Err(); err != nil {
+		t.Skipf("Redis not available: %v", err)
+		return
+	}
+
+	// 失効の直前と直後に発行されたトークンを秒未満の精度で区別する
+	oldToken, err := util.GenerateAccessToken(userID, "", "test@example.com", true, nil, nil, jwtKeys)
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	err = sessionHelper.RevokeAccessTokensIssuedBefore(context.Background(), userID, time.Now())
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	newToken, err := util.GenerateAccessToken(userID, "", "test@example.com", true, nil, nil, jwtKeys)
+	assert.NoError(t, err)
+
+	app := setupTestJWTApp(jwtKeys)
+	assert.Equal(t, 401, requestProtected(t, app, oldToken))
+	assert.Equal(t, 200, requestProtected(t, app, newToken))
+}
+
+func TestAuthMiddleware_RevokedSessionRejectsItsTokens(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	userID := int64(3004)
+
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+
+	ctx := context.Background()
+	revoked, err := sessionHelper.CreateSession(ctx, "test-revoked-session-refresh-token", userID, "client-a", "192.0.2.1", "test-agent")
+	if err != nil {
+		t.Skipf("Redis not available: %v", err)
+		return
+	}
+	kept, err := sessionHelper.CreateSession(ctx, "test-kept-session-refresh-token", userID, "client-b", "192.0.2.1", "test-agent")
+	assert.NoError(t, err)
+
+	revokedToken, err := util.GenerateAccessToken(userID, revoked.ID, "test@example.com", true, nil, nil, jwtKeys)
+	assert.NoError(t, err)
+	keptToken, err := util.GenerateAccessToken(userID, kept.ID, "test@example.com", true, nil, nil, jwtKeys)
+	assert.NoError(t, err)
+
+	app := setupTestJWTApp(jwtKeys)
+	assert.Equal(t, 200, requestProtected(t, app, revokedToken))
+
+	// セッションを失効させると、そのセッションのアクセストークンだけが使えなくなる
+	assert.NoError(t, sessionHelper.RevokeSession(ctx, userID, revoked.ID))
+	assert.Equal(t, 401, requestProtected(t, app, revokedToken))
+	assert.Equal(t, 200, requestProtected(t, app, keptToken))
+}
+
+func TestAuthMiddleware_DeniedTokenID(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+
+	claims := newTestClaims(3002)
+	claims.ID = "test-denied-jti-1"
+	token, err := jwtKeys.Sign(claims)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	if err := sessionHelper.DenyAccessToken(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		t.Skipf("Redis not available: %v", err)
+		return
+	}
+
+	app := setupTestJWTApp(jwtKeys)
+	assert.Equal(t, 401, requestProtected(t, app, token))
+}
+
+func TestLoadJWTKeySet_ActiveKeyMustBePrivate(t *testing.T) {
+	dir, _ := setupTestKeyDir(t)
+
+	_, err := util.LoadJWTKeySet(dir, "2025-01", "")
+	assert.Error(t, err)
+
+	_, err = util.LoadJWTKeySet(dir, "missing", "")
+	assert.Error(t, err)
+
+	_, err = util.LoadJWTKeySet("", "", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+// magicLinkNonceCookie holds the secret that ties a magic link to the browser that requested it
+const magicLinkNonceCookie = "magic_link_nonce"
+
+// MagicLinkHandler signs users in with a single-use link sent to their email address.
+// Tokens are issued through AuthHandler so the result is identical to a password login.
+type MagicLinkHandler struct {
+	authHandler   *AuthHandler
+	userUC        usecase.UserUsecase
+	emailUC       usecase.EmailUsecase
+	sessionHelper *helper.SessionHelper
+	jwtKeys       *util.JWTKeySet
+	validate      *validator.Validate
+	appURL        string
+}
+
+func NewMagicLinkHandler(authHandler *AuthHandler, userUC usecase.UserUsecase, emailUC usecase.EmailUsecase, sessionHelper *helper.SessionHelper, jwtKeys *util.JWTKeySet, appURL string) *MagicLinkHandler {
+	return &MagicLinkHandler{
+		authHandler:   authHandler,
+		userUC:        userUC,
+		emailUC:       emailUC,
+		sessionHelper: sessionHelper,
+		jwtKeys:       jwtKeys,
+		validate:      validator.New(),
+		appURL:        strings.TrimSuffix(appURL, "/"),
+	}
+}
+
+type MagicLinkRequest struct {
+	Email    string `json:"email" validate:"required,email,max=255"`
+	ClientID string `json:"client_id" validate:"required,min=1,max=255"`
+}
+
+type MagicLinkResponse struct {
+	Message string `json:"message"`
+	Email   string `json:"email"`
+	// Nonce is also set as a cookie; clients without cookies send it back when consuming the link
+	Nonce     string `json:"nonce"`
+	ExpiresIn int    `json:"expires_in"`
+}
+
+type ConsumeMagicLinkRequest struct {
+	Token    string `json:"token" validate:"required,max=2048"`
+	ClientID string `json:"client_id" validate:"required,min=1,max=255"`
+	Nonce    string `json:"nonce" validate:"omitempty,max=255"`
+}
+
+// RequestMagicLink emails a single-use login link
+//
+//	@Summary		Request magic link
+//	@Description	Emails a signed login link that is valid for 15 minutes, can be used once, and only from the client that requested it. The link is bound to a nonce that is set as the magic_link_nonce cookie and returned in the response; clients without cookies send it back when consuming the link. The same response is returned whether or not the email is registered.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		MagicLinkRequest	true	"Email and client ID"
+//	@Success		200		{object}	MagicLinkResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		429		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/auth/magic-link [post]
+func (h *MagicLinkHandler) RequestMagicLink(c *fiber.Ctx) error {
+	// 1. リクエストパース
+	var req MagicLinkRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	req.Email = util.NormalizeEmail(req.Email)
+	ctx := c.Context()
+
+	// 3. リンクをこのクライアントに結び付けるnonceを生成（登録の有無に関わらず設定する）
+	nonce, err := util.GenerateURLSafeToken(32)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+	c.Cookie(&fiber.Cookie{
+		Name:     magicLinkNonceCookie,
+		Value:    nonce,
+		HTTPOnly: true,
+		Secure:   h.authHandler.goEnv == "production",
+		SameSite: "Lax",
+		MaxAge:   int(util.MagicLinkTTL.Seconds()),
+		Path:     "/",
+	})
+
+	res := MagicLinkResponse{
+		Message:   "ログイン用のリンクを送信しました。メールを確認してください。",
+		Email:     req.Email,
+		Nonce:     nonce,
+		ExpiresIn: int(util.MagicLinkTTL.Seconds()),
+	}
+
+	// 4. ユーザーを取得
+	user, err := h.userUC.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
+		})
+	}
+	if user == nil {
+		// アカウントの有無を推測されないよう、存在しない場合も同じレスポンスを返す
+		return c.JSON(res)
+	}
+
+	// 5. client_idとnonceのハッシュを埋め込んだ署名付きトークンを生成
+	token, claims, err := util.GenerateMagicLinkToken(user.ID, req.ClientID, nonce, h.jwtKeys)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 6. 使い捨てにするため、トークンIDをRedisに保存（15分間）
+	if err := h.sessionHelper.SaveMagicLink(ctx, claims.ID, user.ID, util.MagicLinkTTL); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 7. メール送信
+	loginURL := h.appURL + "/login/magic?token=" + url.QueryEscape(token)
+	if err := h.emailUC.SendMagicLink(user.Email, loginURL); err != nil {
+		fmt.Printf("メール送信エラー: %v\n", err)
+	}
+	recordSecurityEvent(c, h.authHandler.securityEventUC, domain.SecurityEvent{
+		UserID:    &user.ID,
+		Type:      domain.SecurityEventCodeSent,
+		Succeeded: true,
+		Email:     user.Email,
+		ClientID:  req.ClientID,
+		Detail:    eventDetail("magic_link"),
+	})
+
+	// 8. レスポンス返却
+	return c.JSON(res)
+}
+
+// ConsumeMagicLink logs the user in with a token from a magic link
+//
+//	@Summary		Log in with magic link
+//	@Description	Exchanges the token from a magic link for the same tokens and cookies as a password login. The request must come from the client that requested the link, proven by the client_id and the nonce from the magic_link_nonce cookie or the request body, and the link cannot be used twice. Accounts without a password can sign in this way. When two-factor authentication is enabled, responds with 202 and a short-lived MFA token instead.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		ConsumeMagicLinkRequest	true	"Magic link token and client ID"
+//	@Success		200		{object}	LoginResponse
+//	@Success		202		{object}	MFAChallengeResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		429		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/auth/magic-link/consume [post]
+func (h *MagicLinkHandler) ConsumeMagicLink(c *fiber.Ctx) error {
+	// 1. リクエストパース
+	var req ConsumeMagicLinkRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	ctx := c.Context()
+
+	// 3. 署名と有効期限を検証
+	claims, err := util.ValidateMagicLinkToken(req.Token, h.jwtKeys)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "invalid_magic_link",
+			Message: "ログインリンクが無効または期限切れです。もう一度リンクをリクエストしてください",
+		})
+	}
+
+	// 4. リンクをリクエストしたクライアントか確認（他のデバイスで開いても消費しない）
+	nonce := req.Nonce
+	if nonce == "" {
+		nonce = c.Cookies(magicLinkNonceCookie)
+	}
+	if claims.ClientID != req.ClientID || !claims.MatchesNonce(nonce) {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "magic_link_client_mismatch",
+			Message: "このリンクはリクエストしたデバイスでのみ使用できます",
+		})
+	}
+
+	// 5. トークンを消費（使い捨て）
+	userID, err := h.sessionHelper.ConsumeMagicLink(ctx, claims.ID)
+	if err != nil || userID != claims.UserID {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "invalid_magic_link",
+			Message: "ログインリンクが無効または期限切れです。もう一度リンクをリクエストしてください",
+		})
+	}
+
+	// 6. ユーザーを取得
+	user, err := h.userUC.GetUserByID(ctx, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
+		})
+	}
+	if user == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "invalid_magic_link",
+			Message: "ログインリンクが無効または期限切れです。もう一度リンクをリクエストしてください",
+		})
+	}
+
+	c.Cookie(&fiber.Cookie{
+		Name:     magicLinkNonceCookie,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HTTPOnly: true,
+		Secure:   h.authHandler.goEnv == "production",
+		SameSite: "Lax",
+	})
+
+	// 7. 二要素認証が有効な場合はトークンを発行せずチャレンジを返す
+	if user.TOTPEnabled {
+		return h.authHandler.startMFAChallenge(c, user, req.ClientID)
+	}
+
+	// 8. トークン発行
+	return h.authHandler.completeLogin(c, user, req.ClientID, "magic_link")
+}
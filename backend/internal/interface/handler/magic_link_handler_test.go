@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/util"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupMagicLinkTestApp(t *testing.T, user *domain.User, emailUC *mockEmailUsecase) (*fiber.App, *util.JWTKeySet) {
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	if err := mockRedis.Ping(context.Background()).Err(); err != nil {
+		t.Skipf("Redis not available: %v", err)
+	}
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	userUC := accountTestUserUsecase(user, nil)
+
+	authHandler := NewAuthHandler(&mockAuthUsecase{}, userUC, emailUC, &mockMFAUsecase{}, &mockDeviceUsecase{}, &mockSecurityEventUsecase{}, sessionHelper, newTestPasswordPolicy(), jwtKeys, "development")
+	handler := NewMagicLinkHandler(authHandler, userUC, emailUC, sessionHelper, jwtKeys, "http://localhost:3000/")
+
+	app := fiber.New()
+	app.Post("/api/v1/auth/magic-link", handler.RequestMagicLink)
+	app.Post("/api/v1/auth/magic-link/consume", handler.ConsumeMagicLink)
+	return app, jwtKeys
+}
+
+// requestMagicLink asks for a link and returns the token embedded in the emailed URL and the nonce it is bound to
+func requestMagicLink(t *testing.T, app *fiber.App, sentURL *string, email, clientID string) (string, string) {
+	status, body := doAccountRequest(t, app, "POST", "/api/v1/auth/magic-link", "", MagicLinkRequest{Email: email, ClientID: clientID})
+	assert.Equal(t, 200, status)
+
+	var response MagicLinkResponse
+	json.Unmarshal(body, &response)
+	assert.NotEmpty(t, response.Nonce)
+
+	assert.True(t, strings.HasPrefix(*sentURL, "http://localhost:3000/login/magic?token="))
+	parsed, err := url.Parse(*sentURL)
+	assert.NoError(t, err)
+	return parsed.Query().Get("token"), response.Nonce
+}
+
+func TestRequestMagicLink_ValidationError(t *testing.T) {
+	app, _ := setupMagicLinkTestApp(t, &domain.User{ID: 1}, &mockEmailUsecase{})
+
+	status, body := doAccountRequest(t, app, "POST", "/api/v1/auth/magic-link", "", MagicLinkRequest{Email: "not-an-email"})
+	assert.Equal(t, 400, status)
+
+	var errResp helper.ErrorResponse
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "validation_error", errResp.Error)
+}
+
+func TestRequestMagicLink_UnknownEmail(t *testing.T) {
+	sent := false
+	emailUC := &mockEmailUsecase{
+		sendMagicLinkFunc: func(email, loginURL string) error {
+			sent = true
+			return nil
+		},
+	}
+	app, _ := setupMagicLinkTestApp(t, &domain.User{ID: 501, Email: "magic-known@example.com"}, emailUC)
+
+	email := "magic-unknown@example.com"
+	status, body := doAccountRequest(t, app, "POST", "/api/v1/auth/magic-link", "", MagicLinkRequest{Email: email, ClientID: "test-client-id"})
+	assert.Equal(t, 200, status)
+
+	var response MagicLinkResponse
+	json.Unmarshal(body, &response)
+	assert.Equal(t, email, response.Email)
+	assert.Equal(t, 900, response.ExpiresIn)
+	assert.False(t, sent)
+}
+
+func TestMagicLink_ConsumeLogsInOnce(t *testing.T) {
+	// パスワード未設定（ソーシャルログインのみ）のアカウント
+	user := &domain.User{ID: 502, Email: "magic-login@example.com", CreatedAt: time.Now()}
+	var sentTo, sentURL string
+	emailUC := &mockEmailUsecase{
+		sendMagicLinkFunc: func(email, loginURL string) error {
+			sentTo = email
+			sentURL = loginURL
+			return nil
+		},
+	}
+	app, jwtKeys := setupMagicLinkTestApp(t, user, emailUC)
+
+	token, nonce := requestMagicLink(t, app, &sentURL, user.Email, "magic-client")
+	assert.Equal(t, user.Email, sentTo)
+
+	// マジックリンクのトークンはアクセストークンとして使えない
+	_, err := util.ValidateAccessToken(token, jwtKeys)
+	assert.Error(t, err)
+
+	status, body := doAccountRequest(t, app, "POST", "/api/v1/auth/magic-link/consume", "", ConsumeMagicLinkRequest{Token: token, ClientID: "magic-client", Nonce: nonce})
+	assert.Equal(t, 200, status)
+
+	var response LoginResponse
+	json.Unmarshal(body, &response)
+	assert.NotEmpty(t, response.AccessToken)
+	assert.NotEmpty(t, response.RefreshToken)
+	assert.Equal(t, user.ID, response.User.ID)
+
+	// 2回目は使えない
+	status, body = doAccountRequest(t, app, "POST", "/api/v1/auth/magic-link/consume", "", ConsumeMagicLinkRequest{Token: token, ClientID: "magic-client", Nonce: nonce})
+	assert.Equal(t, 401, status)
+
+	var errResp helper.ErrorResponse
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "invalid_magic_link", errResp.Error)
+}
+
+func TestConsumeMagicLink_ClientMismatch(t *testing.T) {
+	user := &domain.User{ID: 503, Email: "magic-client@example.com", PasswordHash: "hashed_password", CreatedAt: time.Now()}
+	var sentURL string
+	emailUC := &mockEmailUsecase{
+		sendMagicLinkFunc: func(email, loginURL string) error {
+			sentURL = loginURL
+			return nil
+		},
+	}
+	app, _ := setupMagicLinkTestApp(t, user, emailUC)
+
+	token, nonce := requestMagicLink(t, app, &sentURL, user.Email, "requesting-client")
+
+	status, body := doAccountRequest(t, app, "POST", "/api/v1/auth/magic-link/consume", "", ConsumeMagicLinkRequest{Token: token, ClientID: "other-client", Nonce: nonce})
+	assert.Equal(t, 401, status)
+
+	var errResp helper.ErrorResponse
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "magic_link_client_mismatch", errResp.Error)
+
+	// 別のデバイスで開かれても、リクエストしたデバイスではまだ使える
+	status, _ = doAccountRequest(t, app, "POST", "/api/v1/auth/magic-link/consume", "", ConsumeMagicLinkRequest{Token: token, ClientID: "requesting-client", Nonce: nonce})
+	assert.Equal(t, 200, status)
+}
+
+func TestConsumeMagicLink_InvalidToken(t *testing.T) {
+	user := &domain.User{ID: 504, Email: "magic-invalid@example.com", CreatedAt: time.Now()}
+	app, jwtKeys := setupMagicLinkTestApp(t, user, &mockEmailUsecase{})
+
+	accessToken, _ := util.GenerateAccessToken(user.ID, "", user.Email, false, nil, nil, jwtKeys)
+	otherKeyToken, _, _ := util.GenerateMagicLinkToken(user.ID, "magic-client", "magic-nonce", util.NewHMACKeySet("other-secret"))
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "malformed", token: "not-a-token"},
+		{name: "access token", token: accessToken},
+		{name: "signed with another key", token: otherKeyToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doAccountRequest(t, app, "POST", "/api/v1/auth/magic-link/consume", "", ConsumeMagicLinkRequest{Token: tt.token, ClientID: "magic-client", Nonce: "magic-nonce"})
+			assert.Equal(t, 401, status)
+
+			var errResp helper.ErrorResponse
+			json.Unmarshal(body, &errResp)
+			assert.Equal(t, "invalid_magic_link", errResp.Error)
+		})
+	}
+}
+
+func TestConsumeMagicLink_RequiresNonce(t *testing.T) {
+	user := &domain.User{ID: 505, Email: "magic-nonce@example.com", CreatedAt: time.Now()}
+	var sentURL string
+	emailUC := &mockEmailUsecase{
+		sendMagicLinkFunc: func(email, loginURL string) error {
+			sentURL = loginURL
+			return nil
+		},
+	}
+	app, _ := setupMagicLinkTestApp(t, user, emailUC)
+
+	// リクエスト時にnonceをcookieに設定する
+	body, _ := json.Marshal(MagicLinkRequest{Email: user.Email, ClientID: "nonce-client"})
+	req := httptest.NewRequest("POST", "/api/v1/auth/magic-link", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var nonceCookie *http.Cookie
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == magicLinkNonceCookie {
+			nonceCookie = cookie
+		}
+	}
+	if !assert.NotNil(t, nonceCookie) {
+		return
+	}
+	assert.True(t, nonceCookie.HttpOnly)
+
+	parsed, err := url.Parse(sentURL)
+	assert.NoError(t, err)
+	token := parsed.Query().Get("token")
+
+	// トークンから読み取れる client_id だけでは使えない
+	claims, err := util.ValidateMagicLinkToken(token, util.NewHMACKeySet("test-secret-key"))
+	assert.NoError(t, err)
+	status, respBody := doAccountRequest(t, app, "POST", "/api/v1/auth/magic-link/consume", "", ConsumeMagicLinkRequest{Token: token, ClientID: claims.ClientID})
+	assert.Equal(t, 401, status)
+
+	var errResp helper.ErrorResponse
+	json.Unmarshal(respBody, &errResp)
+	assert.Equal(t, "magic_link_client_mismatch", errResp.Error)
+
+	// リクエストしたブラウザのcookieがあれば使える
+	body, _ = json.Marshal(ConsumeMagicLinkRequest{Token: token, ClientID: "nonce-client"})
+	req = httptest.NewRequest("POST", "/api/v1/auth/magic-link/consume", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.AddCookie(&http.Cookie{Name: magicLinkNonceCookie, Value: nonceCookie.Value})
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+}
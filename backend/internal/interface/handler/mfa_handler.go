@@ -0,0 +1,328 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+)
+
+type MFAHandler struct {
+	userUC   usecase.UserUsecase
+	mfaUC    usecase.MFAUsecase
+	validate *validator.Validate
+}
+
+func NewMFAHandler(userUC usecase.UserUsecase, mfaUC usecase.MFAUsecase) *MFAHandler {
+	return &MFAHandler{
+		userUC:   userUC,
+		mfaUC:    mfaUC,
+		validate: validator.New(),
+	}
+}
+
+type MFAStatusResponse struct {
+	TOTPEnabled            bool `json:"totp_enabled"`
+	RecoveryCodesRemaining int  `json:"recovery_codes_remaining"`
+}
+
+type TOTPEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required,min=6,max=32"`
+}
+
+type RecoveryCodesResponse struct {
+	Message       string   `json:"message"`
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type MFAMessageResponse struct {
+	Message string `json:"message"`
+}
+
+// GetMyMFAStatus returns the two-factor authentication state of the authenticated user
+//
+//	@Summary		Get two-factor status
+//	@Description	Returns whether TOTP two-factor authentication is enabled and how many unused recovery codes remain.
+//	@Tags			mfa
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Success		200	{object}	MFAStatusResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/me/mfa [get]
+func (h *MFAHandler) GetMyMFAStatus(c *fiber.Ctx) error {
+	// 1. ユーザー取得
+	user, ok := h.currentUser(c)
+	if !ok {
+		return nil
+	}
+
+	// 2. レスポンス返却
+	remaining := 0
+	if user.TOTPEnabled {
+		remaining = len(user.TOTPRecoveryCodeHashes)
+	}
+	return c.Status(fiber.StatusOK).JSON(MFAStatusResponse{
+		TOTPEnabled:            user.TOTPEnabled,
+		RecoveryCodesRemaining: remaining,
+	})
+}
+
+// BeginTOTPEnrollment starts TOTP enrollment for the authenticated user
+//
+//	@Summary		Start TOTP enrollment
+//	@Description	Generates a new TOTP secret and returns it with an otpauth:// URI to render as a QR code. Two-factor authentication stays off until the secret is confirmed with a code. Calling it again replaces a pending secret.
+//	@Tags			mfa
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Success		200	{object}	TOTPEnrollmentResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		409	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/me/mfa/totp [post]
+func (h *MFAHandler) BeginTOTPEnrollment(c *fiber.Ctx) error {
+	// 1. ユーザー取得
+	user, ok := h.currentUser(c)
+	if !ok {
+		return nil
+	}
+
+	// 2. シークレット生成
+	enrollment, err := h.mfaUC.BeginTOTPEnrollment(c.Context(), user)
+	if err != nil {
+		if errors.Is(err, usecase.ErrTOTPAlreadyEnabled) {
+			return c.Status(fiber.StatusConflict).JSON(helper.ErrorResponse{
+				Error:   "totp_already_enabled",
+				Message: "二段階認証は既に有効です",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 3. レスポンス返却
+	return c.Status(fiber.StatusOK).JSON(TOTPEnrollmentResponse{
+		Secret:     enrollment.Secret,
+		OTPAuthURI: enrollment.URI,
+	})
+}
+
+// ConfirmTOTPEnrollment enables TOTP after the user proves the authenticator works
+//
+//	@Summary		Confirm TOTP enrollment
+//	@Description	Verifies a code from the authenticator app and enables two-factor authentication. Returns single-use recovery codes, which are shown only once.
+//	@Tags			mfa
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			request	body		MFACodeRequest	true	"TOTP code"
+//	@Success		200		{object}	RecoveryCodesResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		409		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/me/mfa/totp/confirm [post]
+func (h *MFAHandler) ConfirmTOTPEnrollment(c *fiber.Ctx) error {
+	// 1. リクエストパース・バリデーション
+	req, ok := h.parseCodeRequest(c)
+	if !ok {
+		return nil
+	}
+
+	// 2. ユーザー取得
+	user, ok := h.currentUser(c)
+	if !ok {
+		return nil
+	}
+
+	// 3. コード照合と有効化
+	codes, err := h.mfaUC.ConfirmTOTPEnrollment(c.Context(), user, req.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrTOTPAlreadyEnabled):
+			return c.Status(fiber.StatusConflict).JSON(helper.ErrorResponse{
+				Error:   "totp_already_enabled",
+				Message: "二段階認証は既に有効です",
+			})
+		case errors.Is(err, usecase.ErrTOTPNotEnrolled):
+			return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+				Error:   "totp_not_enrolled",
+				Message: "先に二段階認証の設定を開始してください",
+			})
+		case errors.Is(err, usecase.ErrInvalidMFACode):
+			return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+				Error:   "invalid_mfa_code",
+				Message: "認証コードが正しくありません",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 4. レスポンス返却
+	return c.Status(fiber.StatusOK).JSON(RecoveryCodesResponse{
+		Message:       "二段階認証を有効にしました。リカバリーコードを安全な場所に保管してください",
+		RecoveryCodes: codes,
+	})
+}
+
+// DisableTOTP turns off two-factor authentication
+//
+//	@Summary		Disable TOTP
+//	@Description	Disables two-factor authentication after verifying a current TOTP code or an unused recovery code. The secret and all recovery codes are deleted.
+//	@Tags			mfa
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			request	body		MFACodeRequest	true	"TOTP or recovery code"
+//	@Success		200		{object}	MFAMessageResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/me/mfa/totp/disable [post]
+func (h *MFAHandler) DisableTOTP(c *fiber.Ctx) error {
+	// 1. リクエストパース・バリデーション
+	req, ok := h.parseCodeRequest(c)
+	if !ok {
+		return nil
+	}
+
+	// 2. ユーザー取得
+	user, ok := h.currentUser(c)
+	if !ok {
+		return nil
+	}
+
+	// 3. コード照合と無効化
+	if err := h.mfaUC.DisableTOTP(c.Context(), user, req.Code); err != nil {
+		return h.verifyErrorResponse(c, err)
+	}
+
+	// 4. レスポンス返却
+	return c.Status(fiber.StatusOK).JSON(MFAMessageResponse{
+		Message: "二段階認証を無効にしました",
+	})
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes of the authenticated user
+//
+//	@Summary		Regenerate recovery codes
+//	@Description	Verifies a current TOTP code or an unused recovery code, invalidates every existing recovery code and returns a new set.
+//	@Tags			mfa
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			request	body		MFACodeRequest	true	"TOTP or recovery code"
+//	@Success		200		{object}	RecoveryCodesResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/me/mfa/recovery-codes [post]
+func (h *MFAHandler) RegenerateRecoveryCodes(c *fiber.Ctx) error {
+	// 1. リクエストパース・バリデーション
+	req, ok := h.parseCodeRequest(c)
+	if !ok {
+		return nil
+	}
+
+	// 2. ユーザー取得
+	user, ok := h.currentUser(c)
+	if !ok {
+		return nil
+	}
+
+	// 3. コード照合と再発行
+	codes, err := h.mfaUC.RegenerateRecoveryCodes(c.Context(), user, req.Code)
+	if err != nil {
+		return h.verifyErrorResponse(c, err)
+	}
+
+	// 4. レスポンス返却
+	return c.Status(fiber.StatusOK).JSON(RecoveryCodesResponse{
+		Message:       "リカバリーコードを再発行しました。以前のコードは使用できません",
+		RecoveryCodes: codes,
+	})
+}
+
+// parseCodeRequest parses and validates the body; when it reports false the error response has been written
+func (h *MFAHandler) parseCodeRequest(c *fiber.Ctx) (*MFACodeRequest, bool) {
+	var req MFACodeRequest
+	if err := c.BodyParser(&req); err != nil {
+		c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+		return nil, false
+	}
+	if err := h.validate.Struct(req); err != nil {
+		c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+		return nil, false
+	}
+	return &req, true
+}
+
+// currentUser loads the authenticated user; when it reports false the error response has been written
+func (h *MFAHandler) currentUser(c *fiber.Ctx) (*domain.User, bool) {
+	// ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+		return nil, false
+	}
+
+	user, err := h.userUC.GetUserByID(c.Context(), userID)
+	if err != nil {
+		c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+		return nil, false
+	}
+	if user == nil {
+		c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "user_not_found",
+			Message: "ユーザーが見つかりません",
+		})
+		return nil, false
+	}
+	return user, true
+}
+
+func (h *MFAHandler) verifyErrorResponse(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrTOTPNotEnabled):
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "totp_not_enabled",
+			Message: "二段階認証は有効になっていません",
+		})
+	case errors.Is(err, usecase.ErrInvalidMFACode):
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_mfa_code",
+			Message: "認証コードが正しくありません",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+		Error:   "internal_server_error",
+		Message: "サーバーエラーが発生しました",
+	})
+}
@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+	"github.com/keu-5/muzee/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupMFATestApp(handler *MFAHandler, jwtKeys *util.JWTKeySet) *fiber.App {
+	app := fiber.New()
+	mfa := app.Group("/api/v1/me/mfa", newTestAuthMiddleware(jwtKeys))
+	mfa.Get("/", handler.GetMyMFAStatus)
+	mfa.Post("/totp", handler.BeginTOTPEnrollment)
+	mfa.Post("/totp/confirm", handler.ConfirmTOTPEnrollment)
+	mfa.Post("/totp/disable", handler.DisableTOTP)
+	mfa.Post("/recovery-codes", handler.RegenerateRecoveryCodes)
+	return app
+}
+
+func newMFATestUserUsecase(user *domain.User) *mockUserUsecase {
+	return &mockUserUsecase{
+		getUserByIDFunc: func(ctx context.Context, id int64) (*domain.User, error) {
+			return user, nil
+		},
+	}
+}
+
+func doMFARequest(t *testing.T, app *fiber.App, method, path, token string, body interface{}) (int, []byte) {
+	var reader io.Reader
+	if body != nil {
+		b, _ := json.Marshal(body)
+		reader = bytes.NewReader(b)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, bodyBytes
+}
+
+func TestMFAHandler_Unauthorized(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	handler := NewMFAHandler(&mockUserUsecase{}, &mockMFAUsecase{})
+	app := setupMFATestApp(handler, jwtKeys)
+
+	status, _ := doMFARequest(t, app, "GET", "/api/v1/me/mfa", "", nil)
+	assert.Equal(t, 401, status)
+}
+
+func TestGetMyMFAStatus(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	user := &domain.User{
+		ID:                     1,
+		Email:                  "test@example.com",
+		TOTPEnabled:            true,
+		TOTPRecoveryCodeHashes: []string{"a", "b", "c"},
+	}
+	handler := NewMFAHandler(newMFATestUserUsecase(user), &mockMFAUsecase{})
+	app := setupMFATestApp(handler, jwtKeys)
+
+	token, _ := util.GenerateAccessToken(1, "", "test@example.com", true, nil, nil, jwtKeys)
+	status, body := doMFARequest(t, app, "GET", "/api/v1/me/mfa", token, nil)
+	assert.Equal(t, 200, status)
+
+	var response MFAStatusResponse
+	err := json.Unmarshal(body, &response)
+	assert.NoError(t, err)
+	assert.True(t, response.TOTPEnabled)
+	assert.Equal(t, 3, response.RecoveryCodesRemaining)
+}
+
+func TestBeginTOTPEnrollment(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	token, _ := util.GenerateAccessToken(1, "", "test@example.com", true, nil, nil, jwtKeys)
+
+	t.Run("success", func(t *testing.T) {
+		mockMFA := &mockMFAUsecase{
+			beginTOTPEnrollmentFunc: func(ctx context.Context, user *domain.User) (*usecase.TOTPEnrollment, error) {
+				return &usecase.TOTPEnrollment{
+					Secret: "JBSWY3DPEHPK3PXP",
+					URI:    "otpauth://totp/Muzee:test@example.com?secret=JBSWY3DPEHPK3PXP",
+				}, nil
+			},
+		}
+		handler := NewMFAHandler(newMFATestUserUsecase(&domain.User{ID: 1}), mockMFA)
+		app := setupMFATestApp(handler, jwtKeys)
+
+		status, body := doMFARequest(t, app, "POST", "/api/v1/me/mfa/totp", token, nil)
+		assert.Equal(t, 200, status)
+
+		var response TOTPEnrollmentResponse
+		err := json.Unmarshal(body, &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "JBSWY3DPEHPK3PXP", response.Secret)
+		assert.Contains(t, response.OTPAuthURI, "otpauth://totp/")
+	})
+
+	t.Run("already enabled", func(t *testing.T) {
+		mockMFA := &mockMFAUsecase{
+			beginTOTPEnrollmentFunc: func(ctx context.Context, user *domain.User) (*usecase.TOTPEnrollment, error) {
+				return nil, usecase.ErrTOTPAlreadyEnabled
+			},
+		}
+		handler := NewMFAHandler(newMFATestUserUsecase(&domain.User{ID: 1, TOTPEnabled: true}), mockMFA)
+		app := setupMFATestApp(handler, jwtKeys)
+
+		status, body := doMFARequest(t, app, "POST", "/api/v1/me/mfa/totp", token, nil)
+		assert.Equal(t, 409, status)
+
+		var errResp helper.ErrorResponse
+		json.Unmarshal(body, &errResp)
+		assert.Equal(t, "totp_already_enabled", errResp.Error)
+	})
+}
+
+func TestConfirmTOTPEnrollment(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	token, _ := util.GenerateAccessToken(1, "", "test@example.com", true, nil, nil, jwtKeys)
+
+	tests := []struct {
+		name       string
+		code       string
+		confirmErr error
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "success",
+			code:       "123456",
+			wantStatus: 200,
+		},
+		{
+			name:       "invalid code",
+			code:       "000000",
+			confirmErr: usecase.ErrInvalidMFACode,
+			wantStatus: 400,
+			wantError:  "invalid_mfa_code",
+		},
+		{
+			name:       "not enrolled",
+			code:       "123456",
+			confirmErr: usecase.ErrTOTPNotEnrolled,
+			wantStatus: 400,
+			wantError:  "totp_not_enrolled",
+		},
+		{
+			name:       "validation error",
+			code:       "12",
+			wantStatus: 400,
+			wantError:  "validation_error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMFA := &mockMFAUsecase{
+				confirmTOTPEnrollmentFunc: func(ctx context.Context, user *domain.User, code string) ([]string, error) {
+					if tt.confirmErr != nil {
+						return nil, tt.confirmErr
+					}
+					return []string{"abcde-fghjk", "mnpqr-stuvw"}, nil
+				},
+			}
+			handler := NewMFAHandler(newMFATestUserUsecase(&domain.User{ID: 1}), mockMFA)
+			app := setupMFATestApp(handler, jwtKeys)
+
+			status, body := doMFARequest(t, app, "POST", "/api/v1/me/mfa/totp/confirm", token, MFACodeRequest{Code: tt.code})
+			assert.Equal(t, tt.wantStatus, status)
+
+			if tt.wantError != "" {
+				var errResp helper.ErrorResponse
+				json.Unmarshal(body, &errResp)
+				assert.Equal(t, tt.wantError, errResp.Error)
+				return
+			}
+
+			var response RecoveryCodesResponse
+			err := json.Unmarshal(body, &response)
+			assert.NoError(t, err)
+			assert.Len(t, response.RecoveryCodes, 2)
+		})
+	}
+}
+
+func TestDisableTOTP(t *testing.T) {
+	jwtKeys := util.NewHMACKeySet("test-secret-key")
+	token, _ := util.GenerateAccessToken(1, "", "test@example.com", true, nil, nil, jwtKeys)
+
+	mockMFA := &mockMFAUsecase{
+		disableTOTPFunc: func(ctx context.Context, user *domain.User, code string) error {
+			if code != "123456" {
+				return usecase.ErrInvalidMFACode
+			}
+			return nil
+		},
+	}
+	handler := NewMFAHandler(newMFATestUserUsecase(&domain.User{ID: 1, TOTPEnabled: true}), mockMFA)
+	app := setupMFATestApp(handler, jwtKeys)
+
+	status, body := doMFARequest(t, app, "POST", "/api/v1/me/mfa/totp/disable", token, MFACodeRequest{Code: "000000"})
+	assert.Equal(t, 400, status)
+
+	var errResp helper.ErrorResponse
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "invalid_mfa_code", errResp.Error)
+
+	status, _ = doMFARequest(t, app, "POST", "/api/v1/me/mfa/totp/disable", token, MFACodeRequest{Code: "123456"})
+	assert.Equal(t, 200, status)
+}
@@ -0,0 +1,255 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+// oidcBindingCookie holds the secret that ties an OIDC state to the browser that started the flow
+const oidcBindingCookie = "oidc_binding"
+
+// OIDCHandler signs users in with external OpenID Connect providers.
+// Tokens are issued through AuthHandler so the result is identical to a password login.
+type OIDCHandler struct {
+	authHandler   *AuthHandler
+	oidcUC        usecase.OIDCUsecase
+	sessionHelper *helper.SessionHelper
+	validate      *validator.Validate
+}
+
+func NewOIDCHandler(authHandler *AuthHandler, oidcUC usecase.OIDCUsecase, sessionHelper *helper.SessionHelper) *OIDCHandler {
+	return &OIDCHandler{
+		authHandler:   authHandler,
+		oidcUC:        oidcUC,
+		sessionHelper: sessionHelper,
+		validate:      validator.New(),
+	}
+}
+
+type OIDCAuthorizeRequest struct {
+	ClientID string `json:"client_id" validate:"required,min=1,max=255"`
+}
+
+type OIDCAuthorizeResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+	// Binding is also set as a cookie; clients without cookies send it back to the callback
+	Binding   string `json:"binding"`
+	ExpiresIn int    `json:"expires_in"`
+}
+
+type OIDCCallbackRequest struct {
+	Code    string `json:"code" validate:"required,max=2048"`
+	State   string `json:"state" validate:"required,max=255"`
+	Binding string `json:"binding" validate:"omitempty,max=255"`
+}
+
+// StartOIDCLogin begins an authorization code + PKCE flow with an OIDC provider
+//
+//	@Summary		Start social login
+//	@Description	Creates a state, nonce and PKCE verifier for the provider and returns the authorization URL to redirect the browser to. The provider redirects back to the configured redirect URL with code and state, which are then posted to the callback endpoint. The state is bound to a secret that is set as the oidc_binding cookie and returned in the response; clients without cookies send it back to the callback.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			provider	path		string					true	"Provider name (e.g. google)"
+//	@Param			request		body		OIDCAuthorizeRequest	true	"Client ID"
+//	@Success		200			{object}	OIDCAuthorizeResponse
+//	@Failure		400			{object}	helper.ErrorResponse
+//	@Failure		404			{object}	helper.ErrorResponse
+//	@Failure		500			{object}	helper.ErrorResponse
+//	@Router			/v1/auth/oidc/{provider}/authorize [post]
+func (h *OIDCHandler) StartOIDCLogin(c *fiber.Ctx) error {
+	// 1. リクエストパース
+	var req OIDCAuthorizeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	provider := c.Params("provider")
+	ctx := c.Context()
+
+	// 3. state・nonce・PKCEコード検証子と、stateをこのクライアントに結び付ける秘密値を生成
+	state, err := util.GenerateURLSafeToken(32)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+	binding, err := util.GenerateURLSafeToken(32)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+	nonce, err := util.GenerateURLSafeToken(32)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+	codeVerifier, err := util.GeneratePKCEVerifier()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 4. 認可URLを生成
+	authorizationURL, err := h.oidcUC.AuthorizationURL(ctx, provider, state, nonce, util.PKCEChallengeS256(codeVerifier))
+	if err != nil {
+		if errors.Is(err, usecase.ErrOIDCProviderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(helper.ErrorResponse{
+				Error:   "oidc_provider_not_found",
+				Message: "指定されたログインプロバイダは利用できません",
+			})
+		}
+		fmt.Printf("OIDC認可URL生成エラー: %v\n", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "ログインプロバイダとの通信に失敗しました。しばらく待ってから再度お試しください",
+		})
+	}
+
+	// 5. Redisにstateを保存（10分間）
+	if err := h.sessionHelper.SaveOIDCState(ctx, state, &helper.OIDCStateData{
+		Provider:     provider,
+		ClientID:     req.ClientID,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		BindingHash:  util.HashToken(binding),
+	}); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
+		})
+	}
+
+	// 6. 秘密値をCookieに設定し、レスポンス返却
+	c.Cookie(&fiber.Cookie{
+		Name:     oidcBindingCookie,
+		Value:    binding,
+		HTTPOnly: true,
+		Secure:   h.authHandler.goEnv == "production",
+		SameSite: "Lax",
+		MaxAge:   int(helper.OIDCStateTTL.Seconds()),
+		Path:     "/",
+	})
+
+	return c.Status(fiber.StatusOK).JSON(OIDCAuthorizeResponse{
+		AuthorizationURL: authorizationURL,
+		State:            state,
+		Binding:          binding,
+		ExpiresIn:        int(helper.OIDCStateTTL.Seconds()),
+	})
+}
+
+// OIDCCallback completes social login with the code returned by the provider
+//
+//	@Summary		Complete social login
+//	@Description	Exchanges the authorization code using the stored PKCE verifier, verifies the ID token and signs the user in. The request must come from the client that started the flow, proven by the secret from the oidc_binding cookie or the binding field of the request body. A new identity is linked to the existing account with the same verified email, or a new account is created. Returns the same tokens and cookies as login, or 202 with an MFA token when two-factor authentication is enabled.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			provider	path		string				true	"Provider name (e.g. google)"
+//	@Param			request		body		OIDCCallbackRequest	true	"Authorization code and state"
+//	@Success		200			{object}	LoginResponse
+//	@Success		202			{object}	MFAChallengeResponse
+//	@Failure		400			{object}	helper.ErrorResponse
+//	@Failure		401			{object}	helper.ErrorResponse
+//	@Failure		403			{object}	helper.ErrorResponse
+//	@Failure		404			{object}	helper.ErrorResponse
+//	@Failure		500			{object}	helper.ErrorResponse
+//	@Router			/v1/auth/oidc/{provider}/callback [post]
+func (h *OIDCHandler) OIDCCallback(c *fiber.Ctx) error {
+	// 1. リクエストパース
+	var req OIDCCallbackRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	provider := c.Params("provider")
+	ctx := c.Context()
+
+	// 3. stateを取得（使い捨て）し、フローを開始したクライアントか確認（他人のstateとコードでのログインを防ぐ）
+	binding := req.Binding
+	if binding == "" {
+		binding = c.Cookies(oidcBindingCookie)
+	}
+	stateData, err := h.sessionHelper.ConsumeOIDCState(ctx, req.State)
+	if err != nil || stateData.Provider != provider || !stateData.MatchesBinding(binding) {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_state",
+			Message: "ログインの有効期限が切れたか、不正なリクエストです。最初からやり直してください",
+		})
+	}
+	c.Cookie(&fiber.Cookie{
+		Name:     oidcBindingCookie,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HTTPOnly: true,
+		Secure:   h.authHandler.goEnv == "production",
+		SameSite: "Lax",
+	})
+
+	// 4. 認可コードを交換し、ユーザーを特定
+	user, err := h.oidcUC.Authenticate(ctx, provider, req.Code, stateData.CodeVerifier, stateData.Nonce)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrOIDCProviderNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(helper.ErrorResponse{
+				Error:   "oidc_provider_not_found",
+				Message: "指定されたログインプロバイダは利用できません",
+			})
+		case errors.Is(err, usecase.ErrOIDCEmailNotVerified):
+			return c.Status(fiber.StatusForbidden).JSON(helper.ErrorResponse{
+				Error:   "oidc_email_not_verified",
+				Message: "ログインプロバイダでメールアドレスが確認されていません",
+			})
+		case errors.Is(err, usecase.ErrOIDCAuthentication):
+			fmt.Printf("OIDC認証エラー: %v\n", err)
+			return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+				Error:   "oidc_authentication_failed",
+				Message: "ログインプロバイダでの認証に失敗しました",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました。しばらく待ってから再度お試しください",
+		})
+	}
+
+	// 5. 二要素認証が有効な場合はトークンを発行せずチャレンジを返す
+	if user.TOTPEnabled {
+		return h.authHandler.startMFAChallenge(c, user, stateData.ClientID)
+	}
+
+	// 6. トークン発行
+	return h.authHandler.completeLogin(c, user, stateData.ClientID, "oidc:"+stateData.Provider)
+}
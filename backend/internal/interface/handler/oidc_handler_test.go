@@ -0,0 +1,313 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+	"github.com/keu-5/muzee/backend/internal/util"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// Mock OIDCUsecase
+type mockOIDCUsecase struct {
+	authorizationURLFunc func(ctx context.Context, provider, state, nonce, codeChallenge string) (string, error)
+	authenticateFunc     func(ctx context.Context, provider, code, codeVerifier, nonce string) (*domain.User, error)
+}
+
+func (m *mockOIDCUsecase) AuthorizationURL(ctx context.Context, provider, state, nonce, codeChallenge string) (string, error) {
+	if m.authorizationURLFunc != nil {
+		return m.authorizationURLFunc(ctx, provider, state, nonce, codeChallenge)
+	}
+	if provider != "mock" {
+		return "", usecase.ErrOIDCProviderNotFound
+	}
+	params := url.Values{}
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", codeChallenge)
+	return "https://idp.example.com/authorize?" + params.Encode(), nil
+}
+
+func (m *mockOIDCUsecase) Authenticate(ctx context.Context, provider, code, codeVerifier, nonce string) (*domain.User, error) {
+	if m.authenticateFunc != nil {
+		return m.authenticateFunc(ctx, provider, code, codeVerifier, nonce)
+	}
+	return &domain.User{ID: 1, Email: "oidc@example.com", CreatedAt: time.Now()}, nil
+}
+
+func setupOIDCTestApp(oidcUC usecase.OIDCUsecase, userUC usecase.UserUsecase) (*fiber.App, *helper.SessionHelper) {
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+
+	authHandler := NewAuthHandler(&mockAuthUsecase{}, userUC, &mockEmailUsecase{}, &mockMFAUsecase{}, &mockDeviceUsecase{}, &mockSecurityEventUsecase{}, sessionHelper, newTestPasswordPolicy(), util.NewHMACKeySet("test-secret-key"), "development")
+	handler := NewOIDCHandler(authHandler, oidcUC, sessionHelper)
+
+	app := fiber.New()
+	app.Post("/api/v1/auth/oidc/:provider/authorize", handler.StartOIDCLogin)
+	app.Post("/api/v1/auth/oidc/:provider/callback", handler.OIDCCallback)
+	return app, sessionHelper
+}
+
+func postOIDC(t *testing.T, app *fiber.App, path string, body interface{}) (int, []byte) {
+	b, _ := json.Marshal(body)
+	req := httptest.NewRequest("POST", path, bytes.NewReader(b))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, bodyBytes
+}
+
+// startOIDC starts the flow and returns the state and binding, skipping when Redis is not available
+func startOIDC(t *testing.T, app *fiber.App, provider string) (string, string) {
+	status, body := postOIDC(t, app, "/api/v1/auth/oidc/"+provider+"/authorize", OIDCAuthorizeRequest{ClientID: "test-client-id"})
+	if status == 500 {
+		t.Skipf("Redis not available: status %d", status)
+	}
+	assert.Equal(t, 200, status)
+
+	var response OIDCAuthorizeResponse
+	err := json.Unmarshal(body, &response)
+	assert.NoError(t, err)
+	return response.State, response.Binding
+}
+
+func TestStartOIDCLogin_Success(t *testing.T) {
+	app, _ := setupOIDCTestApp(&mockOIDCUsecase{}, &mockUserUsecase{})
+
+	status, body := postOIDC(t, app, "/api/v1/auth/oidc/mock/authorize", OIDCAuthorizeRequest{ClientID: "test-client-id"})
+	if status == 500 {
+		t.Skipf("Redis not available: status %d", status)
+	}
+	assert.Equal(t, 200, status)
+
+	var response OIDCAuthorizeResponse
+	err := json.Unmarshal(body, &response)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, response.State)
+	assert.NotEmpty(t, response.Binding)
+	assert.Equal(t, 600, response.ExpiresIn)
+
+	authURL, err := url.Parse(response.AuthorizationURL)
+	assert.NoError(t, err)
+	assert.Equal(t, response.State, authURL.Query().Get("state"))
+	assert.NotEmpty(t, authURL.Query().Get("nonce"))
+	assert.NotEmpty(t, authURL.Query().Get("code_challenge"))
+}
+
+func TestStartOIDCLogin_UnknownProvider(t *testing.T) {
+	app, _ := setupOIDCTestApp(&mockOIDCUsecase{}, &mockUserUsecase{})
+
+	status, body := postOIDC(t, app, "/api/v1/auth/oidc/unknown/authorize", OIDCAuthorizeRequest{ClientID: "test-client-id"})
+	assert.Equal(t, 404, status)
+
+	var errResp helper.ErrorResponse
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "oidc_provider_not_found", errResp.Error)
+}
+
+func TestStartOIDCLogin_ValidationError(t *testing.T) {
+	app, _ := setupOIDCTestApp(&mockOIDCUsecase{}, &mockUserUsecase{})
+
+	status, _ := postOIDC(t, app, "/api/v1/auth/oidc/mock/authorize", OIDCAuthorizeRequest{ClientID: ""})
+	assert.Equal(t, 400, status)
+}
+
+func TestOIDCCallback_Success(t *testing.T) {
+	var gotVerifier, gotNonce, gotChallenge, gotAuthNonce string
+	oidcUC := &mockOIDCUsecase{
+		authorizationURLFunc: func(ctx context.Context, provider, state, nonce, codeChallenge string) (string, error) {
+			gotChallenge = codeChallenge
+			gotAuthNonce = nonce
+			return "https://idp.example.com/authorize", nil
+		},
+		authenticateFunc: func(ctx context.Context, provider, code, codeVerifier, nonce string) (*domain.User, error) {
+			gotVerifier = codeVerifier
+			gotNonce = nonce
+			return &domain.User{ID: 42, Email: "oidc@example.com", CreatedAt: time.Now()}, nil
+		},
+	}
+	app, sessionHelper := setupOIDCTestApp(oidcUC, &mockUserUsecase{})
+
+	state, binding := startOIDC(t, app, "mock")
+
+	status, body := postOIDC(t, app, "/api/v1/auth/oidc/mock/callback", OIDCCallbackRequest{Code: "auth-code", State: state, Binding: binding})
+	assert.Equal(t, 200, status)
+
+	var response LoginResponse
+	err := json.Unmarshal(body, &response)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, response.AccessToken)
+	assert.NotEmpty(t, response.RefreshToken)
+	assert.Equal(t, int64(42), response.User.ID)
+
+	// 認可リクエスト時のPKCEとnonceがコード交換に引き継がれること
+	assert.Equal(t, gotChallenge, util.PKCEChallengeS256(gotVerifier))
+	assert.Equal(t, gotAuthNonce, gotNonce)
+
+	tokenData, err := sessionHelper.GetRefreshToken(context.Background(), response.RefreshToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "test-client-id", tokenData.ClientID)
+
+	// stateは使い捨て
+	status, body = postOIDC(t, app, "/api/v1/auth/oidc/mock/callback", OIDCCallbackRequest{Code: "auth-code", State: state, Binding: binding})
+	assert.Equal(t, 400, status)
+
+	var errResp helper.ErrorResponse
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "invalid_state", errResp.Error)
+}
+
+func TestOIDCCallback_ProviderMismatch(t *testing.T) {
+	oidcUC := &mockOIDCUsecase{
+		authenticateFunc: func(ctx context.Context, provider, code, codeVerifier, nonce string) (*domain.User, error) {
+			t.Error("Expected code not to be exchanged")
+			return nil, nil
+		},
+	}
+	app, _ := setupOIDCTestApp(oidcUC, &mockUserUsecase{})
+
+	state, binding := startOIDC(t, app, "mock")
+
+	status, _ := postOIDC(t, app, "/api/v1/auth/oidc/other/callback", OIDCCallbackRequest{Code: "auth-code", State: state, Binding: binding})
+	assert.Equal(t, 400, status)
+}
+
+func TestOIDCCallback_RequiresBinding(t *testing.T) {
+	oidcUC := &mockOIDCUsecase{
+		authenticateFunc: func(ctx context.Context, provider, code, codeVerifier, nonce string) (*domain.User, error) {
+			t.Error("Expected code not to be exchanged")
+			return nil, nil
+		},
+	}
+	app, _ := setupOIDCTestApp(oidcUC, &mockUserUsecase{})
+
+	// 攻撃者が開始したフローのstateを、被害者のブラウザから送らせてもログインさせない
+	attackerState, _ := startOIDC(t, app, "mock")
+	_, victimBinding := startOIDC(t, app, "mock")
+	status, body := postOIDC(t, app, "/api/v1/auth/oidc/mock/callback", OIDCCallbackRequest{Code: "auth-code", State: attackerState, Binding: victimBinding})
+	assert.Equal(t, 400, status)
+
+	var errResp helper.ErrorResponse
+	json.Unmarshal(body, &errResp)
+	assert.Equal(t, "invalid_state", errResp.Error)
+
+	state, _ := startOIDC(t, app, "mock")
+	status, _ = postOIDC(t, app, "/api/v1/auth/oidc/mock/callback", OIDCCallbackRequest{Code: "auth-code", State: state})
+	assert.Equal(t, 400, status)
+}
+
+func TestOIDCCallback_BindingFromCookie(t *testing.T) {
+	app, _ := setupOIDCTestApp(&mockOIDCUsecase{}, &mockUserUsecase{})
+
+	b, _ := json.Marshal(OIDCAuthorizeRequest{ClientID: "test-client-id"})
+	req := httptest.NewRequest("POST", "/api/v1/auth/oidc/mock/authorize", bytes.NewReader(b))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	if resp.StatusCode == 500 {
+		t.Skipf("Redis not available: status %d", resp.StatusCode)
+	}
+
+	var response OIDCAuthorizeResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.NoError(t, json.Unmarshal(bodyBytes, &response))
+
+	var bindingCookie *http.Cookie
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == oidcBindingCookie {
+			bindingCookie = cookie
+		}
+	}
+	if !assert.NotNil(t, bindingCookie) {
+		return
+	}
+	assert.True(t, bindingCookie.HttpOnly)
+	assert.Equal(t, response.Binding, bindingCookie.Value)
+
+	b, _ = json.Marshal(OIDCCallbackRequest{Code: "auth-code", State: response.State})
+	req = httptest.NewRequest("POST", "/api/v1/auth/oidc/mock/callback", bytes.NewReader(b))
+	req.Header.Set("Content-Type", "application/json")
+	req.AddCookie(&http.Cookie{Name: oidcBindingCookie, Value: bindingCookie.Value})
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+}
+
+func TestOIDCCallback_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "email not verified",
+			err:        usecase.ErrOIDCEmailNotVerified,
+			wantStatus: 403,
+			wantError:  "oidc_email_not_verified",
+		},
+		{
+			name:       "authentication failed",
+			err:        usecase.ErrOIDCAuthentication,
+			wantStatus: 401,
+			wantError:  "oidc_authentication_failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oidcUC := &mockOIDCUsecase{
+				authenticateFunc: func(ctx context.Context, provider, code, codeVerifier, nonce string) (*domain.User, error) {
+					return nil, tt.err
+				},
+			}
+			app, _ := setupOIDCTestApp(oidcUC, &mockUserUsecase{})
+
+			state, binding := startOIDC(t, app, "mock")
+
+			status, body := postOIDC(t, app, "/api/v1/auth/oidc/mock/callback", OIDCCallbackRequest{Code: "auth-code", State: state, Binding: binding})
+			assert.Equal(t, tt.wantStatus, status)
+
+			var errResp helper.ErrorResponse
+			json.Unmarshal(body, &errResp)
+			assert.Equal(t, tt.wantError, errResp.Error)
+		})
+	}
+}
+
+func TestOIDCCallback_MFARequired(t *testing.T) {
+	oidcUC := &mockOIDCUsecase{
+		authenticateFunc: func(ctx context.Context, provider, code, codeVerifier, nonce string) (*domain.User, error) {
+			return &domain.User{ID: 43, Email: "oidc-mfa@example.com", TOTPEnabled: true}, nil
+		},
+	}
+	app, _ := setupOIDCTestApp(oidcUC, &mockUserUsecase{})
+
+	state, binding := startOIDC(t, app, "mock")
+
+	status, body := postOIDC(t, app, "/api/v1/auth/oidc/mock/callback", OIDCCallbackRequest{Code: "auth-code", State: state, Binding: binding})
+	assert.Equal(t, 202, status)
+
+	var response MFAChallengeResponse
+	err := json.Unmarshal(body, &response)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, response.MFAToken)
+}
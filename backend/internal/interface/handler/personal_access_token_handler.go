@@ -0,0 +1,255 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+)
+
+type PersonalAccessTokenHandler struct {
+	patUC           usecase.PersonalAccessTokenUsecase
+	securityEventUC usecase.SecurityEventUsecase
+	validate        *validator.Validate
+}
+
+func NewPersonalAccessTokenHandler(patUC usecase.PersonalAccessTokenUsecase, securityEventUC usecase.SecurityEventUsecase) *PersonalAccessTokenHandler {
+	return &PersonalAccessTokenHandler{
+		patUC:           patUC,
+		securityEventUC: securityEventUC,
+		validate:        validator.New(),
+	}
+}
+
+type PersonalAccessTokenResponse struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	TokenPrefix string   `json:"token_prefix"`
+	Scopes      []string `json:"scopes"`
+	ExpiresAt   string   `json:"expires_at"`
+	LastUsedAt  *string  `json:"last_used_at"`
+	LastUsedIP  string   `json:"last_used_ip"`
+	CreatedAt   string   `json:"created_at"`
+}
+
+type ListMyPersonalAccessTokensResponse struct {
+	Tokens []PersonalAccessTokenResponse `json:"tokens"`
+}
+
+type CreatePersonalAccessTokenRequest struct {
+	Name          string   `json:"name" validate:"required,max=100"`
+	Scopes        []string `json:"scopes" validate:"required,min=1,dive,oneof=users:read me:read me:write admin:read admin:write"`
+	ExpiresInDays int      `json:"expires_in_days" validate:"omitempty,min=1,max=365"`
+}
+
+type CreatePersonalAccessTokenResponse struct {
+	PersonalAccessTokenResponse
+	// Token is only returned once; store it securely
+	Token string `json:"token"`
+}
+
+type PersonalAccessTokenMessageResponse struct {
+	Message string `json:"message"`
+}
+
+// ListMyPersonalAccessTokens returns the personal access tokens of the authenticated user
+//
+//	@Summary		List my personal access tokens
+//	@Description	Returns the personal access tokens of the currently authenticated user, newest first, with their scopes, expiry and last use. The token values themselves are never returned again. Not available to personal access tokens.
+//	@Tags			tokens
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Success		200	{object}	ListMyPersonalAccessTokensResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		403	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/me/tokens [get]
+func (h *PersonalAccessTokenHandler) ListMyPersonalAccessTokens(c *fiber.Ctx) error {
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. トークン一覧を取得
+	tokens, err := h.patUC.ListTokens(c.Context(), userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 3. レスポンス返却
+	res := ListMyPersonalAccessTokensResponse{
+		Tokens: make([]PersonalAccessTokenResponse, 0, len(tokens)),
+	}
+	for _, token := range tokens {
+		res.Tokens = append(res.Tokens, buildPersonalAccessTokenResponse(token))
+	}
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// CreateMyPersonalAccessToken issues a personal access token for scripts and integrations
+//
+//	@Summary		Create a personal access token
+//	@Description	Issues a personal access token for scripts and integrations. Send it as "Authorization: Bearer <token>". Scopes limit the route groups the token can call: users:read for /v1/users, me:read and me:write for GET and other requests under /v1/me, and admin:read and admin:write for /v1/admin (which still requires the matching permissions). The token expires after expires_in_days (default 30, max 365). The token value is only shown in this response. Not available to personal access tokens.
+//	@Tags			tokens
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			request	body		CreatePersonalAccessTokenRequest	true	"Token name, scopes and lifetime"
+//	@Success		201		{object}	CreatePersonalAccessTokenResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		403		{object}	helper.ErrorResponse
+//	@Failure		409		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/me/tokens [post]
+func (h *PersonalAccessTokenHandler) CreateMyPersonalAccessToken(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. リクエストパース
+	var req CreatePersonalAccessTokenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 3. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	// 4. トークンを発行
+	ttl := time.Duration(req.ExpiresInDays) * 24 * time.Hour
+	token, rawToken, err := h.patUC.CreateToken(ctx, userID, req.Name, req.Scopes, ttl)
+	if err != nil {
+		if errors.Is(err, usecase.ErrTooManyPersonalAccessTokens) {
+			return c.Status(fiber.StatusConflict).JSON(helper.ErrorResponse{
+				Error:   "too_many_tokens",
+				Message: "パーソナルアクセストークンの上限に達しました。不要なトークンを削除してください",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &userID,
+		Type:      domain.SecurityEventPersonalAccessTokenCreated,
+		Succeeded: true,
+		Detail:    eventDetail(token.TokenPrefix),
+	})
+
+	// 5. レスポンス返却（トークンはこの時だけ返す）
+	return c.Status(fiber.StatusCreated).JSON(CreatePersonalAccessTokenResponse{
+		PersonalAccessTokenResponse: buildPersonalAccessTokenResponse(token),
+		Token:                       rawToken,
+	})
+}
+
+// RevokeMyPersonalAccessToken deletes one of the authenticated user's personal access tokens
+//
+//	@Summary		Revoke a personal access token
+//	@Description	Deletes the specified personal access token. It is rejected immediately afterwards. Not available to personal access tokens.
+//	@Tags			tokens
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			id	path		int	true	"Token ID"
+//	@Success		200	{object}	PersonalAccessTokenMessageResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		403	{object}	helper.ErrorResponse
+//	@Failure		404	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/me/tokens/{id} [delete]
+func (h *PersonalAccessTokenHandler) RevokeMyPersonalAccessToken(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. トークンを削除
+	tokenID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return personalAccessTokenNotFound(c)
+	}
+	if err := h.patUC.RevokeToken(ctx, userID, tokenID); err != nil {
+		if errors.Is(err, usecase.ErrPersonalAccessTokenNotFound) {
+			return personalAccessTokenNotFound(c)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &userID,
+		Type:      domain.SecurityEventPersonalAccessTokenRevoked,
+		Succeeded: true,
+		Detail:    eventDetail(strconv.FormatInt(tokenID, 10)),
+	})
+
+	// 3. レスポンス返却
+	return c.Status(fiber.StatusOK).JSON(PersonalAccessTokenMessageResponse{
+		Message: "パーソナルアクセストークンを削除しました",
+	})
+}
+
+func buildPersonalAccessTokenResponse(token *domain.PersonalAccessToken) PersonalAccessTokenResponse {
+	res := PersonalAccessTokenResponse{
+		ID:          token.ID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      token.Scopes,
+		ExpiresAt:   token.ExpiresAt.UTC().Format(time.RFC3339),
+		LastUsedIP:  token.LastUsedIP,
+		CreatedAt:   token.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if res.Scopes == nil {
+		res.Scopes = []string{}
+	}
+	if token.LastUsedAt != nil {
+		lastUsedAt := token.LastUsedAt.UTC().Format(time.RFC3339)
+		res.LastUsedAt = &lastUsedAt
+	}
+	return res
+}
+
+func personalAccessTokenNotFound(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusNotFound).JSON(helper.ErrorResponse{
+		Error:   "token_not_found",
+		Message: "パーソナルアクセストークンが見つかりません",
+	})
+}
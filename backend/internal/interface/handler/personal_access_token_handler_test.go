@@ -0,0 +1,338 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/interface/middleware"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+	"github.com/keu-5/muzee/backend/internal/util"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// Mock PersonalAccessTokenUsecase
+type mockPersonalAccessTokenUsecase struct {
+	createTokenFunc  func(ctx context.Context, userID int64, name string, scopes []string, ttl time.Duration) (*domain.PersonalAccessToken, string, error)
+	listTokensFunc   func(ctx context.Context, userID int64) ([]*domain.PersonalAccessToken, error)
+	revokeTokenFunc  func(ctx context.Context, userID, tokenID int64) error
+	authenticateFunc func(ctx context.Context, rawToken, ipAddress string) (*domain.PersonalAccessToken, *domain.User, error)
+}
+
+func (m *mockPersonalAccessTokenUsecase) CreateToken(ctx context.Context, userID int64, name string, scopes []string, ttl time.Duration) (*domain.PersonalAccessToken, string, error) {
+	if m.createTokenFunc != nil {
+		return m.createTokenFunc(ctx, userID, name, scopes, ttl)
+	}
+	return &domain.PersonalAccessToken{}, "", nil
+}
+
+func (m *mockPersonalAccessTokenUsecase) ListTokens(ctx context.Context, userID int64) ([]*domain.PersonalAccessToken, error) {
+	if m.listTokensFunc != nil {
+		return m.listTokensFunc(ctx, userID)
+	}
+	return []*domain.PersonalAccessToken{}, nil
+}
+
+func (m *mockPersonalAccessTokenUsecase) RevokeToken(ctx context.Context, userID, tokenID int64) error {
+	if m.revokeTokenFunc != nil {
+		return m.revokeTokenFunc(ctx, userID, tokenID)
+	}
+	return nil
+}
+
+func (m *mockPersonalAccessTokenUsecase) Authenticate(ctx context.Context, rawToken, ipAddress string) (*domain.PersonalAccessToken, *domain.User, error) {
+	if m.authenticateFunc != nil {
+		return m.authenticateFunc(ctx, rawToken, ipAddress)
+	}
+	return nil, nil, usecase.ErrInvalidPersonalAccessToken
+}
+
+func setupTestPersonalAccessTokenApp(handler *PersonalAccessTokenHandler, jwtSecret string, patUC usecase.PersonalAccessTokenUsecase) *fiber.App {
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	authMiddleware := middleware.AuthMiddleware(util.NewHMACKeySet(jwtSecret), helper.NewSessionHelper(mockRedis), patUC)
+
+	app := fiber.New()
+	me := app.Group("/api/v1/me", authMiddleware, middleware.RequireScope("me"))
+	me.Get("/security-events", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"user_id": c.Locals("user_id"), "permissions": c.Locals("permissions")})
+	})
+	me.Delete("/devices/:id", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	tokens := me.Group("/tokens", middleware.DenyPersonalAccessToken())
+	tokens.Get("/", handler.ListMyPersonalAccessTokens)
+	tokens.Post("/", handler.CreateMyPersonalAccessToken)
+	tokens.Delete("/:id", handler.RevokeMyPersonalAccessToken)
+	return app
+}
+
+func TestCreateMyPersonalAccessToken(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	createdAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		body         string
+		createErr    error
+		expectedCode int
+		expectedErr  string
+		wantTTL      time.Duration
+	}{
+		{name: "success", body: `{"name":"ci","scopes":["me:read","users:read"],"expires_in_days":7}`, expectedCode: 201, wantTTL: 7 * 24 * time.Hour},
+		{name: "default expiry", body: `{"name":"ci","scopes":["me:read"]}`, expectedCode: 201, wantTTL: 0},
+		{name: "missing name", body: `{"scopes":["me:read"]}`, expectedCode: 400, expectedErr: "validation_error"},
+		{name: "no scopes", body: `{"name":"ci","scopes":[]}`, expectedCode: 400, expectedErr: "validation_error"},
+		{name: "unknown scope", body: `{"name":"ci","scopes":["me:admin"]}`, expectedCode: 400, expectedErr: "validation_error"},
+		{name: "expiry too long", body: `{"name":"ci","scopes":["me:read"],"expires_in_days":366}`, expectedCode: 400, expectedErr: "validation_error"},
+		{name: "invalid JSON", body: `{`, expectedCode: 400, expectedErr: "invalid_request"},
+		{name: "too many tokens", body: `{"name":"ci","scopes":["me:read"]}`, createErr: usecase.ErrTooManyPersonalAccessTokens, expectedCode: 409, expectedErr: "too_many_tokens"},
+		{name: "usecase error", body: `{"name":"ci","scopes":["me:read"]}`, createErr: errors.New("db error"), expectedCode: 500, expectedErr: "internal_server_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotTTL time.Duration
+			var gotScopes []string
+			mockPAT := &mockPersonalAccessTokenUsecase{
+				createTokenFunc: func(ctx context.Context, userID int64, name string, scopes []string, ttl time.Duration) (*domain.PersonalAccessToken, string, error) {
+					gotTTL = ttl
+					gotScopes = scopes
+					if tt.createErr != nil {
+						return nil, "", tt.createErr
+					}
+					return &domain.PersonalAccessToken{
+						ID:          11,
+						UserID:      userID,
+						Name:        name,
+						TokenPrefix: "mzp_abcdefgh",
+						Scopes:      scopes,
+						ExpiresAt:   createdAt.Add(ttl),
+						CreatedAt:   createdAt,
+					}, "mzp_abcdefghsecret", nil
+				},
+			}
+			mockSecurityEvent := &mockSecurityEventUsecase{}
+			handler := NewPersonalAccessTokenHandler(mockPAT, mockSecurityEvent)
+			app := setupTestPersonalAccessTokenApp(handler, jwtSecret, mockPAT)
+
+			req := httptest.NewRequest("POST", "/api/v1/me/tokens", bytes.NewReader([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+newDeviceTestToken(t, jwtSecret, 6001))
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			bodyBytes, _ := io.ReadAll(resp.Body)
+			if tt.expectedErr != "" {
+				var errResp helper.ErrorResponse
+				assert.NoError(t, json.Unmarshal(bodyBytes, &errResp))
+				assert.Equal(t, tt.expectedErr, errResp.Error)
+				assert.Empty(t, mockSecurityEvent.recorded)
+				return
+			}
+
+			var response CreatePersonalAccessTokenResponse
+			assert.NoError(t, json.Unmarshal(bodyBytes, &response))
+			assert.Equal(t, "mzp_abcdefghsecret", response.Token)
+			assert.Equal(t, int64(11), response.ID)
+			assert.Equal(t, "mzp_abcdefgh", response.TokenPrefix)
+			assert.Nil(t, response.LastUsedAt)
+			assert.Equal(t, tt.wantTTL, gotTTL)
+			assert.Equal(t, gotScopes, response.Scopes)
+			if assert.Len(t, mockSecurityEvent.recorded, 1) {
+				assert.Equal(t, domain.SecurityEventPersonalAccessTokenCreated, mockSecurityEvent.recorded[0].Type)
+				assert.Equal(t, int64(6001), *mockSecurityEvent.recorded[0].UserID)
+			}
+		})
+	}
+}
+
+func TestListMyPersonalAccessTokens_Success(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	lastUsed := time.Date(2030, 1, 3, 0, 0, 0, 0, time.UTC)
+	var gotUserID int64
+	mockPAT := &mockPersonalAccessTokenUsecase{
+		listTokensFunc: func(ctx context.Context, userID int64) ([]*domain.PersonalAccessToken, error) {
+			gotUserID = userID
+			return []*domain.PersonalAccessToken{
+				{ID: 2, Name: "deploy", TokenPrefix: "mzp_12345678", Scopes: []string{"me:read"}, LastUsedAt: &lastUsed, LastUsedIP: "192.0.2.9"},
+				{ID: 1, Name: "unused", TokenPrefix: "mzp_87654321"},
+			}, nil
+		},
+	}
+	handler := NewPersonalAccessTokenHandler(mockPAT, &mockSecurityEventUsecase{})
+	app := setupTestPersonalAccessTokenApp(handler, jwtSecret, mockPAT)
+
+	req := httptest.NewRequest("GET", "/api/v1/me/tokens", nil)
+	req.Header.Set("Authorization", "Bearer "+newDeviceTestToken(t, jwtSecret, 6002))
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int64(6002), gotUserID)
+
+	var response ListMyPersonalAccessTokensResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.NoError(t, json.Unmarshal(bodyBytes, &response))
+	assert.Len(t, response.Tokens, 2)
+	if assert.NotNil(t, response.Tokens[0].LastUsedAt) {
+		assert.Equal(t, "2030-01-03T00:00:00Z", *response.Tokens[0].LastUsedAt)
+	}
+	assert.Nil(t, response.Tokens[1].LastUsedAt)
+	assert.NotNil(t, response.Tokens[1].Scopes)
+	assert.NotContains(t, string(bodyBytes), "token_hash")
+}
+
+func TestRevokeMyPersonalAccessToken(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	tests := []struct {
+		name         string
+		path         string
+		revokeErr    error
+		expectedCode int
+		wantEvent    bool
+	}{
+		{name: "success", path: "/api/v1/me/tokens/5", expectedCode: 200, wantEvent: true},
+		{name: "not found", path: "/api/v1/me/tokens/5", revokeErr: usecase.ErrPersonalAccessTokenNotFound, expectedCode: 404},
+		{name: "non-numeric id", path: "/api/v1/me/tokens/abc", expectedCode: 404},
+		{name: "usecase error", path: "/api/v1/me/tokens/5", revokeErr: errors.New("db error"), expectedCode: 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPAT := &mockPersonalAccessTokenUsecase{
+				revokeTokenFunc: func(ctx context.Context, userID, tokenID int64) error {
+					assert.Equal(t, int64(6003), userID)
+					assert.Equal(t, int64(5), tokenID)
+					return tt.revokeErr
+				},
+			}
+			mockSecurityEvent := &mockSecurityEventUsecase{}
+			handler := NewPersonalAccessTokenHandler(mockPAT, mockSecurityEvent)
+			app := setupTestPersonalAccessTokenApp(handler, jwtSecret, mockPAT)
+
+			req := httptest.NewRequest("DELETE", tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+newDeviceTestToken(t, jwtSecret, 6003))
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			assert.Equal(t, tt.wantEvent, len(mockSecurityEvent.recorded) == 1)
+		})
+	}
+}
+
+func TestPersonalAccessTokenAuthentication(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	rawToken := "mzp_validtokenvalue"
+
+	mockPAT := &mockPersonalAccessTokenUsecase{
+		authenticateFunc: func(ctx context.Context, token, ipAddress string) (*domain.PersonalAccessToken, *domain.User, error) {
+			if token != rawToken {
+				return nil, nil, usecase.ErrInvalidPersonalAccessToken
+			}
+			return &domain.PersonalAccessToken{ID: 3, UserID: 6004, Scopes: []string{domain.TokenScopeMeRead}},
+				&domain.User{ID: 6004, Email: "bot@example.com", Roles: []*domain.Role{{Name: domain.RoleSupport, Permissions: []string{domain.PermissionSecurityEventsRead}}}},
+				nil
+		},
+	}
+	handler := NewPersonalAccessTokenHandler(mockPAT, &mockSecurityEventUsecase{})
+	app := setupTestPersonalAccessTokenApp(handler, jwtSecret, mockPAT)
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		token        string
+		expectedCode int
+		expectedErr  string
+	}{
+		{name: "read with read scope", method: "GET", path: "/api/v1/me/security-events", token: rawToken, expectedCode: 200},
+		{name: "write without write scope", method: "DELETE", path: "/api/v1/me/devices/1", token: rawToken, expectedCode: 403, expectedErr: "insufficient_scope"},
+		{name: "token management", method: "GET", path: "/api/v1/me/tokens", token: rawToken, expectedCode: 403, expectedErr: "personal_access_token_not_allowed"},
+		{name: "unknown token", method: "GET", path: "/api/v1/me/security-events", token: "mzp_unknown", expectedCode: 401, expectedErr: "invalid_token"},
+		{name: "access token is not limited by scopes", method: "DELETE", path: "/api/v1/me/devices/1", token: newDeviceTestToken(t, jwtSecret, 6005), expectedCode: 200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			bodyBytes, _ := io.ReadAll(resp.Body)
+			if tt.expectedErr != "" {
+				var errResp helper.ErrorResponse
+				assert.NoError(t, json.Unmarshal(bodyBytes, &errResp))
+				assert.Equal(t, tt.expectedErr, errResp.Error)
+			}
+			if tt.expectedCode == 200 && tt.token == rawToken {
+				assert.JSONEq(t, `{"user_id":6004,"permissions":["security_events:read"]}`, string(bodyBytes))
+			}
+		})
+	}
+}
+
+func TestOptionalAuthMiddleware_PersonalAccessTokenScope(t *testing.T) {
+	tests := []struct {
+		name       string
+		scopes     []string
+		wantViewer bool
+	}{
+		{name: "me:read identifies the viewer", scopes: []string{domain.TokenScopeMeRead}, wantViewer: true},
+		{name: "other scopes are anonymous", scopes: []string{"users:read"}, wantViewer: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPAT := &mockPersonalAccessTokenUsecase{
+				authenticateFunc: func(ctx context.Context, token, ipAddress string) (*domain.PersonalAccessToken, *domain.User, error) {
+					return &domain.PersonalAccessToken{ID: 4, UserID: 6006, Scopes: tt.scopes}, &domain.User{ID: 6006}, nil
+				},
+			}
+			mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+
+			app := fiber.New()
+			app.Get("/api/v1/users/:username", middleware.OptionalAuthMiddleware(util.NewHMACKeySet("test-secret-key"), helper.NewSessionHelper(mockRedis), mockPAT), func(c *fiber.Ctx) error {
+				_, ok := c.Locals("user_id").(int64)
+				return c.JSON(fiber.Map{"viewer": ok})
+			})
+
+			req := httptest.NewRequest("GET", "/api/v1/users/alice", nil)
+			req.Header.Set("Authorization", "Bearer mzp_validtokenvalue")
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, 200, resp.StatusCode)
+			var body struct {
+				Viewer bool `json:"viewer"`
+			}
+			bodyBytes, _ := io.ReadAll(resp.Body)
+			assert.NoError(t, json.Unmarshal(bodyBytes, &body))
+			assert.Equal(t, tt.wantViewer, body.Viewer)
+		})
+	}
+}
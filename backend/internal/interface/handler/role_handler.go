@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+)
+
+type RoleHandler struct {
+	roleUC          usecase.RoleUsecase
+	securityEventUC usecase.SecurityEventUsecase
+	sessionHelper   *helper.SessionHelper
+}
+
+func NewRoleHandler(roleUC usecase.RoleUsecase, securityEventUC usecase.SecurityEventUsecase, sessionHelper *helper.SessionHelper) *RoleHandler {
+	return &RoleHandler{
+		roleUC:          roleUC,
+		securityEventUC: securityEventUC,
+		sessionHelper:   sessionHelper,
+	}
+}
+
+type RoleResponse struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+type ListRolesResponse struct {
+	Roles []RoleResponse `json:"roles"`
+}
+
+type RoleMessageResponse struct {
+	Message string `json:"message"`
+}
+
+// ListRoles returns every role and its permissions
+//
+//	@Summary		List roles
+//	@Description	Returns every role with the permissions it grants. Requires the roles:read permission.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Success		200	{object}	ListRolesResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		403	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/admin/roles [get]
+func (h *RoleHandler) ListRoles(c *fiber.Ctx) error {
+	// 1. ロール一覧を取得
+	roles, err := h.roleUC.ListRoles(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 2. レスポンス返却
+	res := ListRolesResponse{
+		Roles: make([]RoleResponse, 0, len(roles)),
+	}
+	for _, role := range roles {
+		permissions := role.Permissions
+		if permissions == nil {
+			permissions = []string{}
+		}
+		res.Roles = append(res.Roles, RoleResponse{
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: permissions,
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// AssignUserRole grants a role to a user
+//
+//	@Summary		Assign a role
+//	@Description	Grants the role to the user. Granting a role the user already has succeeds without changes. The user's current access tokens are revoked so that the new permissions apply after the next token refresh. Requires the roles:manage permission.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			id		path		int		true	"User ID"
+//	@Param			role	path		string	true	"Role name"
+//	@Success		200		{object}	RoleMessageResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		403		{object}	helper.ErrorResponse
+//	@Failure		404		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/admin/users/{id}/roles/{role} [put]
+func (h *RoleHandler) AssignUserRole(c *fiber.Ctx) error {
+	return h.changeUserRole(c, true)
+}
+
+// RevokeUserRole takes a role away from a user
+//
+//	@Summary		Revoke a role
+//	@Description	Takes the role away from the user. Revoking a role the user does not have succeeds without changes. The user's current access tokens are revoked so that the lost permissions stop working immediately. Requires the roles:manage permission.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			id		path		int		true	"User ID"
+//	@Param			role	path		string	true	"Role name"
+//	@Success		200		{object}	RoleMessageResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		403		{object}	helper.ErrorResponse
+//	@Failure		404		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/admin/users/{id}/roles/{role} [delete]
+func (h *RoleHandler) RevokeUserRole(c *fiber.Ctx) error {
+	return h.changeUserRole(c, false)
+}
+
+func (h *RoleHandler) changeUserRole(c *fiber.Ctx, grant bool) error {
+	ctx := c.Context()
+
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得（操作者）
+	actorID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. 対象ユーザーとロールを取得
+	userID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return roleUserNotFound(c)
+	}
+	roleName := c.Params("role")
+
+	// 3. ロールを付与・剥奪
+	action := "granted"
+	if grant {
+		err = h.roleUC.AssignRole(ctx, userID, roleName)
+	} else {
+		action = "revoked"
+		err = h.roleUC.RevokeRole(ctx, userID, roleName)
+	}
+	if err != nil {
+		if errors.Is(err, usecase.ErrUserNotFound) {
+			return roleUserNotFound(c)
+		}
+		if errors.Is(err, usecase.ErrRoleNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(helper.ErrorResponse{
+				Error:   "role_not_found",
+				Message: "ロールが見つかりません",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 4. 発行済みのアクセストークンを失効させ、次回のリフレッシュで新しい権限を反映する
+	if err := h.sessionHelper.RevokeAccessTokensIssuedBefore(ctx, userID, time.Now()); err != nil {
+		fmt.Printf("アクセストークン失効エラー: %v\n", err)
+	}
+
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &userID,
+		Type:      domain.SecurityEventRoleChanged,
+		Succeeded: true,
+		Detail:    eventDetail(fmt.Sprintf("%s %s by user %d", action, roleName, actorID)),
+	})
+
+	// 5. レスポンス返却
+	message := "ロールを付与しました"
+	if !grant {
+		message = "ロールを剥奪しました"
+	}
+	return c.Status(fiber.StatusOK).JSON(RoleMessageResponse{
+		Message: message,
+	})
+}
+
+func roleUserNotFound(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusNotFound).JSON(helper.ErrorResponse{
+		Error:   "user_not_found",
+		Message: "ユーザーが見つかりません",
+	})
+}
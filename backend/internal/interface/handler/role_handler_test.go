@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/interface/middleware"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+	"github.com/keu-5/muzee/backend/internal/util"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// Mock RoleUsecase
+type mockRoleUsecase struct {
+	ensureBuiltinRolesFunc func(ctx context.Context) error
+	listRolesFunc          func(ctx context.Context) ([]*domain.Role, error)
+	assignRoleFunc         func(ctx context.Context, userID int64, roleName string) error
+	revokeRoleFunc         func(ctx context.Context, userID int64, roleName string) error
+}
+
+func (m *mockRoleUsecase) EnsureBuiltinRoles(ctx context.Context) error {
+	if m.ensureBuiltinRolesFunc != nil {
+		return m.ensureBuiltinRolesFunc(ctx)
+	}
+	return nil
+}
+
+func (m *mockRoleUsecase) ListRoles(ctx context.Context) ([]*domain.Role, error) {
+	if m.listRolesFunc != nil {
+		return m.listRolesFunc(ctx)
+	}
+	return []*domain.Role{}, nil
+}
+
+func (m *mockRoleUsecase) AssignRole(ctx context.Context, userID int64, roleName string) error {
+	if m.assignRoleFunc != nil {
+		return m.assignRoleFunc(ctx, userID, roleName)
+	}
+	return nil
+}
+
+func (m *mockRoleUsecase) RevokeRole(ctx context.Context, userID int64, roleName string) error {
+	if m.revokeRoleFunc != nil {
+		return m.revokeRoleFunc(ctx, userID, roleName)
+	}
+	return nil
+}
+
+func setupTestRoleApp(handler *RoleHandler, jwtSecret string) *fiber.App {
+	app := fiber.New()
+	authMiddleware := newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret))
+	app.Get("/api/v1/admin/roles", authMiddleware, middleware.RequirePermission(domain.PermissionRolesRead), handler.ListRoles)
+	app.Put("/api/v1/admin/users/:id/roles/:role", authMiddleware, middleware.RequirePermission(domain.PermissionRolesManage), handler.AssignUserRole)
+	app.Delete("/api/v1/admin/users/:id/roles/:role", authMiddleware, middleware.RequirePermission(domain.PermissionRolesManage), handler.RevokeUserRole)
+	return app
+}
+
+func newTestRoleHandler(roleUC usecase.RoleUsecase, securityEventUC usecase.SecurityEventUsecase) *RoleHandler {
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	return NewRoleHandler(roleUC, securityEventUC, helper.NewSessionHelper(mockRedis))
+}
+
+func TestListRoles_Unauthorized(t *testing.T) {
+	handler := newTestRoleHandler(&mockRoleUsecase{}, &mockSecurityEventUsecase{})
+	app := setupTestRoleApp(handler, "test-secret-key")
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/roles", nil)
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 401, resp.StatusCode)
+}
+
+func TestListRoles_Forbidden(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	handler := newTestRoleHandler(&mockRoleUsecase{}, &mockSecurityEventUsecase{})
+	app := setupTestRoleApp(handler, jwtSecret)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/roles", nil)
+	req.Header.Set("Authorization", "Bearer "+newDeviceTestToken(t, jwtSecret, 5001))
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 403, resp.StatusCode)
+
+	var errResp helper.ErrorResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.NoError(t, json.Unmarshal(bodyBytes, &errResp))
+	assert.Equal(t, "forbidden", errResp.Error)
+}
+
+func TestListRoles_Success(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	mockRole := &mockRoleUsecase{
+		listRolesFunc: func(ctx context.Context) ([]*domain.Role, error) {
+			return []*domain.Role{
+				{ID: 1, Name: domain.RoleAdmin, Description: "All permissions", Permissions: domain.AllPermissions},
+				{ID: 2, Name: "empty"},
+			}, nil
+		},
+	}
+	handler := newTestRoleHandler(mockRole, &mockSecurityEventUsecase{})
+	app := setupTestRoleApp(handler, jwtSecret)
+
+	req := httptest.NewRequest("GET", "/api/v1/admin/roles", nil)
+	req.Header.Set("Authorization", "Bearer "+newAdminTestToken(t, jwtSecret, 5002, domain.PermissionRolesRead))
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var response ListRolesResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.NoError(t, json.Unmarshal(bodyBytes, &response))
+	assert.Len(t, response.Roles, 2)
+	assert.Equal(t, "admin", response.Roles[0].Name)
+	assert.Equal(t, domain.AllPermissions, response.Roles[0].Permissions)
+	assert.NotNil(t, response.Roles[1].Permissions)
+	assert.Empty(t, response.Roles[1].Permissions)
+}
+
+func TestAssignUserRole(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	tests := []struct {
+		name         string
+		path         string
+		permissions  []string
+		assignErr    error
+		expectedCode int
+		expectedErr  string
+		wantCalled   bool
+	}{
+		{name: "success", path: "/api/v1/admin/users/42/roles/support", permissions: []string{domain.PermissionRolesManage}, expectedCode: 200, wantCalled: true},
+		{name: "missing permission", path: "/api/v1/admin/users/42/roles/support", permissions: []string{domain.PermissionRolesRead}, expectedCode: 403, expectedErr: "forbidden"},
+		{name: "non-numeric user id", path: "/api/v1/admin/users/abc/roles/support", permissions: []string{domain.PermissionRolesManage}, expectedCode: 404, expectedErr: "user_not_found"},
+		{name: "unknown user", path: "/api/v1/admin/users/42/roles/support", permissions: []string{domain.PermissionRolesManage}, assignErr: usecase.ErrUserNotFound, expectedCode: 404, expectedErr: "user_not_found", wantCalled: true},
+		{name: "unknown role", path: "/api/v1/admin/users/42/roles/support", permissions: []string{domain.PermissionRolesManage}, assignErr: usecase.ErrRoleNotFound, expectedCode: 404, expectedErr: "role_not_found", wantCalled: true},
+		{name: "usecase error", path: "/api/v1/admin/users/42/roles/support", permissions: []string{domain.PermissionRolesManage}, assignErr: errors.New("db error"), expectedCode: 500, expectedErr: "internal_server_error", wantCalled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			var gotUserID int64
+			var gotRole string
+			mockRole := &mockRoleUsecase{
+				assignRoleFunc: func(ctx context.Context, userID int64, roleName string) error {
+					called = true
+					gotUserID = userID
+					gotRole = roleName
+					return tt.assignErr
+				},
+			}
+			mockSecurityEvent := &mockSecurityEventUsecase{}
+			handler := newTestRoleHandler(mockRole, mockSecurityEvent)
+			app := setupTestRoleApp(handler, jwtSecret)
+
+			req := httptest.NewRequest("PUT", tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+newAdminTestToken(t, jwtSecret, 1, tt.permissions...))
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			assert.Equal(t, tt.wantCalled, called)
+			if tt.expectedErr != "" {
+				var errResp helper.ErrorResponse
+				bodyBytes, _ := io.ReadAll(resp.Body)
+				assert.NoError(t, json.Unmarshal(bodyBytes, &errResp))
+				assert.Equal(t, tt.expectedErr, errResp.Error)
+				assert.Empty(t, mockSecurityEvent.recorded)
+				return
+			}
+
+			assert.Equal(t, int64(42), gotUserID)
+			assert.Equal(t, "support", gotRole)
+			if assert.Len(t, mockSecurityEvent.recorded, 1) {
+				event := mockSecurityEvent.recorded[0]
+				assert.Equal(t, domain.SecurityEventRoleChanged, event.Type)
+				assert.Equal(t, int64(42), *event.UserID)
+				assert.Equal(t, "granted support by user 1", *event.Detail)
+			}
+		})
+	}
+}
+
+func TestRevokeUserRole_Success(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	var gotUserID int64
+	var gotRole string
+	mockRole := &mockRoleUsecase{
+		revokeRoleFunc: func(ctx context.Context, userID int64, roleName string) error {
+			gotUserID = userID
+			gotRole = roleName
+			return nil
+		},
+	}
+	mockSecurityEvent := &mockSecurityEventUsecase{}
+	handler := newTestRoleHandler(mockRole, mockSecurityEvent)
+	app := setupTestRoleApp(handler, jwtSecret)
+
+	req := httptest.NewRequest("DELETE", "/api/v1/admin/users/43/roles/admin", nil)
+	req.Header.Set("Authorization", "Bearer "+newAdminTestToken(t, jwtSecret, 1, domain.PermissionRolesManage))
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int64(43), gotUserID)
+	assert.Equal(t, "admin", gotRole)
+	if assert.Len(t, mockSecurityEvent.recorded, 1) {
+		assert.Equal(t, "revoked admin by user 1", *mockSecurityEvent.recorded[0].Detail)
+	}
+}
@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+)
+
+type SecurityEventHandler struct {
+	securityEventUC usecase.SecurityEventUsecase
+	validate        *validator.Validate
+}
+
+func NewSecurityEventHandler(securityEventUC usecase.SecurityEventUsecase) *SecurityEventHandler {
+	return &SecurityEventHandler{
+		securityEventUC: securityEventUC,
+		validate:        validator.New(),
+	}
+}
+
+type SecurityEventResponse struct {
+	ID        int64   `json:"id"`
+	UserID    *int64  `json:"user_id"`
+	EventType string  `json:"event_type"`
+	Status    string  `json:"status"`
+	Email     string  `json:"email"`
+	IPAddress string  `json:"ip_address"`
+	UserAgent string  `json:"user_agent"`
+	ClientID  string  `json:"client_id"`
+	Detail    *string `json:"detail"`
+	CreatedAt string  `json:"created_at"`
+}
+
+type ListSecurityEventsResponse struct {
+	Events []SecurityEventResponse `json:"events"`
+	// NextBefore is passed as before to fetch the next (older) page; null on the last page
+	NextBefore *int64 `json:"next_before"`
+}
+
+type ListMySecurityEventsQuery struct {
+	Before int64 `query:"before" validate:"omitempty,min=1"`
+	Limit  int   `query:"limit" validate:"omitempty,min=1,max=200"`
+}
+
+type SearchSecurityEventsQuery struct {
+	UserID    int64  `query:"user_id" validate:"omitempty,min=1"`
+	Email     string `query:"email" validate:"omitempty,max=255"`
+	EventType string `query:"event_type" validate:"omitempty,oneof=login token_refresh logout code_sent password_changed password_reset email_changed session_revoked role_changed personal_access_token_created personal_access_token_revoked"`
+	Status    string `query:"status" validate:"omitempty,oneof=succeeded failed"`
+	IPAddress string `query:"ip_address" validate:"omitempty,max=64"`
+	Since     string `query:"since" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Until     string `query:"until" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Before    int64  `query:"before" validate:"omitempty,min=1"`
+	Limit     int    `query:"limit" validate:"omitempty,min=1,max=200"`
+}
+
+// ListMySecurityEvents returns the security history of the authenticated user
+//
+//	@Summary		List my security events
+//	@Description	Returns logins, failed login attempts, token refreshes, logouts, code sends, password and email changes, session revocations, role changes and personal access token changes of the currently authenticated user, newest first, with the IP address and user agent of each request. Pass next_before as before to fetch older events.
+//	@Tags			security-events
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			before	query		int	false	"Only events with a smaller ID"
+//	@Param			limit	query		int	false	"Page size (default 50, max 200)"
+//	@Success		200		{object}	ListSecurityEventsResponse
+//	@Failure		400		{object}	helper.ErrorResponse
+//	@Failure		401		{object}	helper.ErrorResponse
+//	@Failure		500		{object}	helper.ErrorResponse
+//	@Router			/v1/me/security-events [get]
+func (h *SecurityEventHandler) ListMySecurityEvents(c *fiber.Ctx) error {
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. クエリパース
+	var query ListMySecurityEventsQuery
+	if err := c.QueryParser(&query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 3. バリデーション
+	if err := h.validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	// 4. イベントを取得
+	events, err := h.securityEventUC.ListUserEvents(c.Context(), userID, query.Before, query.Limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 5. レスポンス返却
+	return c.Status(fiber.StatusOK).JSON(buildListSecurityEventsResponse(events, query.Limit))
+}
+
+// SearchSecurityEvents queries the security events of all users
+//
+//	@Summary		Search security events
+//	@Description	Returns security events of all users matching the filters, newest first. Failed logins for unregistered addresses have no user_id and can be found by email or IP address. Pass next_before as before to fetch older events. Requires the security_events:read permission.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			user_id		query		int		false	"User ID"
+//	@Param			email		query		string	false	"Email address"
+//	@Param			event_type	query		string	false	"Event type"	Enums(login, token_refresh, logout, code_sent, password_changed, password_reset, email_changed, session_revoked, role_changed, personal_access_token_created, personal_access_token_revoked)
+//	@Param			status		query		string	false	"Outcome"		Enums(succeeded, failed)
+//	@Param			ip_address	query		string	false	"Client IP address"
+//	@Param			since		query		string	false	"Only events at or after this time (RFC 3339)"
+//	@Param			until		query		string	false	"Only events before this time (RFC 3339)"
+//	@Param			before		query		int		false	"Only events with a smaller ID"
+//	@Param			limit		query		int		false	"Page size (default 50, max 200)"
+//	@Success		200			{object}	ListSecurityEventsResponse
+//	@Failure		400			{object}	helper.ErrorResponse
+//	@Failure		401			{object}	helper.ErrorResponse
+//	@Failure		403			{object}	helper.ErrorResponse
+//	@Failure		500			{object}	helper.ErrorResponse
+//	@Router			/v1/admin/security-events [get]
+func (h *SecurityEventHandler) SearchSecurityEvents(c *fiber.Ctx) error {
+	// 1. クエリパース
+	var query SearchSecurityEventsQuery
+	if err := c.QueryParser(&query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "リクエストの形式が正しくありません",
+		})
+	}
+
+	// 2. バリデーション
+	if err := h.validate.Struct(query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	// 3. 検索条件を組み立て（時刻はバリデーション済み）
+	filter := domain.SecurityEventFilter{
+		Email:     query.Email,
+		Type:      domain.SecurityEventType(query.EventType),
+		IPAddress: query.IPAddress,
+		BeforeID:  query.Before,
+		Limit:     query.Limit,
+	}
+	if query.UserID != 0 {
+		filter.UserID = &query.UserID
+	}
+	if query.Status != "" {
+		succeeded := query.Status == "succeeded"
+		filter.Succeeded = &succeeded
+	}
+	if query.Since != "" {
+		since, _ := time.Parse(time.RFC3339, query.Since)
+		filter.Since = &since
+	}
+	if query.Until != "" {
+		until, _ := time.Parse(time.RFC3339, query.Until)
+		filter.Until = &until
+	}
+
+	// 4. イベントを取得
+	events, err := h.securityEventUC.SearchEvents(c.Context(), filter)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 5. レスポンス返却
+	return c.Status(fiber.StatusOK).JSON(buildListSecurityEventsResponse(events, query.Limit))
+}
+
+func buildListSecurityEventsResponse(events []*domain.SecurityEvent, limit int) ListSecurityEventsResponse {
+	res := ListSecurityEventsResponse{
+		Events: make([]SecurityEventResponse, 0, len(events)),
+	}
+	for _, event := range events {
+		status := "succeeded"
+		if !event.Succeeded {
+			status = "failed"
+		}
+		res.Events = append(res.Events, SecurityEventResponse{
+			ID:        event.ID,
+			UserID:    event.UserID,
+			EventType: string(event.Type),
+			Status:    status,
+			Email:     event.Email,
+			IPAddress: event.IPAddress,
+			UserAgent: event.UserAgent,
+			ClientID:  event.ClientID,
+			Detail:    event.Detail,
+			CreatedAt: event.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	if limit <= 0 {
+		limit = usecase.DefaultSecurityEventLimit
+	}
+	if len(events) > 0 && len(events) >= limit {
+		nextBefore := events[len(events)-1].ID
+		res.NextBefore = &nextBefore
+	}
+	return res
+}
+
+// recordSecurityEvent stores an event with the IP address and user agent of the request.
+// Failures are only logged so that the audit log never blocks authentication.
+func recordSecurityEvent(c *fiber.Ctx, securityEventUC usecase.SecurityEventUsecase, event domain.SecurityEvent) {
+	event.IPAddress = c.IP()
+	event.UserAgent = c.Get(fiber.HeaderUserAgent)
+	if err := securityEventUC.Record(c.Context(), &event); err != nil {
+		fmt.Printf("セキュリティイベント記録エラー: %v\n", err)
+	}
+}
+
+// eventDetail returns a pointer for SecurityEvent.Detail
+func eventDetail(detail string) *string {
+	return &detail
+}
@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/interface/middleware"
+	"github.com/keu-5/muzee/backend/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTestSecurityEventApp(handler *SecurityEventHandler, jwtSecret string) *fiber.App {
+	app := fiber.New()
+	authMiddleware := newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret))
+	app.Get("/api/v1/me/security-events", authMiddleware, handler.ListMySecurityEvents)
+	app.Get("/api/v1/admin/security-events", authMiddleware, middleware.RequirePermission(domain.PermissionSecurityEventsRead), handler.SearchSecurityEvents)
+	return app
+}
+
+func newAdminTestToken(t *testing.T, jwtSecret string, userID int64, permissions ...string) string {
+	token, err := util.GenerateAccessToken(userID, "", "admin@example.com", true, []string{domain.RoleAdmin}, permissions, util.NewHMACKeySet(jwtSecret))
+	assert.NoError(t, err)
+	return token
+}
+
+func TestListMySecurityEvents_Unauthorized(t *testing.T) {
+	handler := NewSecurityEventHandler(&mockSecurityEventUsecase{})
+	app := setupTestSecurityEventApp(handler, "test-secret-key")
+
+	req := httptest.NewRequest("GET", "/api/v1/me/security-events", nil)
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 401, resp.StatusCode)
+}
+
+func TestListMySecurityEvents_Success(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	createdAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	userID := int64(4001)
+	var gotUserID, gotBefore int64
+	var gotLimit int
+	mockSecurityEvent := &mockSecurityEventUsecase{
+		listUserEventsFunc: func(ctx context.Context, uid int64, beforeID int64, limit int) ([]*domain.SecurityEvent, error) {
+			gotUserID, gotBefore, gotLimit = uid, beforeID, limit
+			return []*domain.SecurityEvent{
+				{ID: 90, UserID: &userID, Type: domain.SecurityEventLogin, Succeeded: true, IPAddress: "192.0.2.1", Detail: eventDetail("password"), CreatedAt: createdAt},
+				{ID: 88, UserID: &userID, Type: domain.SecurityEventLogin, Succeeded: false, IPAddress: "192.0.2.2", Detail: eventDetail("invalid_password"), CreatedAt: createdAt},
+			}, nil
+		},
+	}
+	handler := NewSecurityEventHandler(mockSecurityEvent)
+	app := setupTestSecurityEventApp(handler, jwtSecret)
+
+	req := httptest.NewRequest("GET", "/api/v1/me/security-events?before=100&limit=2", nil)
+	req.Header.Set("Authorization", "Bearer "+newDeviceTestToken(t, jwtSecret, userID))
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, userID, gotUserID)
+	assert.Equal(t, int64(100), gotBefore)
+	assert.Equal(t, 2, gotLimit)
+
+	var response ListSecurityEventsResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.NoError(t, json.Unmarshal(bodyBytes, &response))
+	assert.Len(t, response.Events, 2)
+	assert.Equal(t, "login", response.Events[0].EventType)
+	assert.Equal(t, "succeeded", response.Events[0].Status)
+	assert.Equal(t, "failed", response.Events[1].Status)
+	assert.Equal(t, "2030-01-02T03:04:05Z", response.Events[0].CreatedAt)
+	if assert.NotNil(t, response.NextBefore) {
+		assert.Equal(t, int64(88), *response.NextBefore)
+	}
+}
+
+func TestListMySecurityEvents_LastPage(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	handler := NewSecurityEventHandler(&mockSecurityEventUsecase{})
+	app := setupTestSecurityEventApp(handler, jwtSecret)
+
+	req := httptest.NewRequest("GET", "/api/v1/me/security-events", nil)
+	req.Header.Set("Authorization", "Bearer "+newDeviceTestToken(t, jwtSecret, 4002))
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var response ListSecurityEventsResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.NoError(t, json.Unmarshal(bodyBytes, &response))
+	assert.Empty(t, response.Events)
+	assert.Nil(t, response.NextBefore)
+}
+
+func TestListMySecurityEvents_ValidationError(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	handler := NewSecurityEventHandler(&mockSecurityEventUsecase{})
+	app := setupTestSecurityEventApp(handler, jwtSecret)
+
+	req := httptest.NewRequest("GET", "/api/v1/me/security-events?limit=1000", nil)
+	req.Header.Set("Authorization", "Bearer "+newDeviceTestToken(t, jwtSecret, 4003))
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 400, resp.StatusCode)
+
+	var errResp helper.ErrorResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.NoError(t, json.Unmarshal(bodyBytes, &errResp))
+	assert.Equal(t, "validation_error", errResp.Error)
+}
+
+func TestSearchSecurityEvents_Forbidden(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	called := false
+	mockSecurityEvent := &mockSecurityEventUsecase{
+		searchEventsFunc: func(ctx context.Context, filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, error) {
+			called = true
+			return nil, nil
+		},
+	}
+	handler := NewSecurityEventHandler(mockSecurityEvent)
+	app := setupTestSecurityEventApp(handler, jwtSecret)
+
+	// 別の権限しか持たないユーザー
+	req := httptest.NewRequest("GET", "/api/v1/admin/security-events", nil)
+	req.Header.Set("Authorization", "Bearer "+newAdminTestToken(t, jwtSecret, 4004, domain.PermissionRolesRead))
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 403, resp.StatusCode)
+	assert.False(t, called)
+
+	var errResp helper.ErrorResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.NoError(t, json.Unmarshal(bodyBytes, &errResp))
+	assert.Equal(t, "forbidden", errResp.Error)
+}
+
+func TestSearchSecurityEvents(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	adminID := int64(1)
+
+	tests := []struct {
+		name         string
+		query        string
+		searchErr    error
+		expectedCode int
+		expectedErr  string
+		check        func(t *testing.T, filter domain.SecurityEventFilter)
+	}{
+		{
+			name:         "filters",
+			query:        "?user_id=42&email=Victim@Example.com&event_type=login&status=failed&ip_address=203.0.113.7&since=2030-01-01T00:00:00Z&until=2030-01-02T00:00:00%2B09:00&before=500&limit=20",
+			expectedCode: 200,
+			check: func(t *testing.T, filter domain.SecurityEventFilter) {
+				if assert.NotNil(t, filter.UserID) {
+					assert.Equal(t, int64(42), *filter.UserID)
+				}
+				assert.Equal(t, "Victim@Example.com", filter.Email)
+				assert.Equal(t, domain.SecurityEventLogin, filter.Type)
+				if assert.NotNil(t, filter.Succeeded) {
+					assert.False(t, *filter.Succeeded)
+				}
+				assert.Equal(t, "203.0.113.7", filter.IPAddress)
+				if assert.NotNil(t, filter.Since) {
+					assert.True(t, filter.Since.Equal(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)))
+				}
+				if assert.NotNil(t, filter.Until) {
+					assert.True(t, filter.Until.Equal(time.Date(2030, 1, 1, 15, 0, 0, 0, time.UTC)))
+				}
+				assert.Equal(t, int64(500), filter.BeforeID)
+				assert.Equal(t, 20, filter.Limit)
+			},
+		},
+		{
+			name:         "no filters",
+			expectedCode: 200,
+			check: func(t *testing.T, filter domain.SecurityEventFilter) {
+				assert.Nil(t, filter.UserID)
+				assert.Nil(t, filter.Succeeded)
+				assert.Nil(t, filter.Since)
+				assert.Nil(t, filter.Until)
+			},
+		},
+		{name: "invalid event type", query: "?event_type=unknown", expectedCode: 400, expectedErr: "validation_error"},
+		{name: "invalid status", query: "?status=maybe", expectedCode: 400, expectedErr: "validation_error"},
+		{name: "invalid since", query: "?since=yesterday", expectedCode: 400, expectedErr: "validation_error"},
+		{name: "usecase error", searchErr: errors.New("db error"), expectedCode: 500, expectedErr: "internal_server_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFilter domain.SecurityEventFilter
+			mockSecurityEvent := &mockSecurityEventUsecase{
+				searchEventsFunc: func(ctx context.Context, filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, error) {
+					gotFilter = filter
+					return []*domain.SecurityEvent{}, tt.searchErr
+				},
+			}
+			handler := NewSecurityEventHandler(mockSecurityEvent)
+			app := setupTestSecurityEventApp(handler, jwtSecret)
+
+			req := httptest.NewRequest("GET", "/api/v1/admin/security-events"+tt.query, nil)
+			req.Header.Set("Authorization", "Bearer "+newAdminTestToken(t, jwtSecret, adminID, domain.PermissionSecurityEventsRead))
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedCode, resp.StatusCode)
+			if tt.expectedErr != "" {
+				var errResp helper.ErrorResponse
+				bodyBytes, _ := io.ReadAll(resp.Body)
+				assert.NoError(t, json.Unmarshal(bodyBytes, &errResp))
+				assert.Equal(t, tt.expectedErr, errResp.Error)
+			}
+			if tt.check != nil {
+				tt.check(t, gotFilter)
+			}
+		})
+	}
+}
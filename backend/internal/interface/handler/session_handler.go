@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+)
+
+type SessionHandler struct {
+	sessionHelper   *helper.SessionHelper
+	securityEventUC usecase.SecurityEventUsecase
+	goEnv           string
+}
+
+func NewSessionHandler(sessionHelper *helper.SessionHelper, securityEventUC usecase.SecurityEventUsecase, goEnv string) *SessionHandler {
+	return &SessionHandler{
+		sessionHelper:   sessionHelper,
+		securityEventUC: securityEventUC,
+		goEnv:           goEnv,
+	}
+}
+
+type SessionResponse struct {
+	ID         string `json:"id"`
+	ClientID   string `json:"client_id"`
+	IPAddress  string `json:"ip_address"`
+	UserAgent  string `json:"user_agent"`
+	CreatedAt  string `json:"created_at"`
+	LastUsedAt string `json:"last_used_at"`
+}
+
+type ListMySessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+// ListMySessions returns the active sessions of the authenticated user
+//
+//	@Summary		List my sessions
+//	@Description	Returns the active login sessions of the currently authenticated user, most recently used first. Each session represents one device (client ID) and survives refresh token rotation.
+//	@Tags			sessions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Success		200	{object}	ListMySessionsResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/me/sessions [get]
+func (h *SessionHandler) ListMySessions(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. セッション一覧を取得
+	sessions, err := h.sessionHelper.ListSessions(ctx, userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 3. レスポンス返却
+	res := ListMySessionsResponse{
+		Sessions: make([]SessionResponse, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		res.Sessions = append(res.Sessions, SessionResponse{
+			ID:         session.ID,
+			ClientID:   session.ClientID,
+			IPAddress:  session.IPAddress,
+			UserAgent:  session.UserAgent,
+			CreatedAt:  time.Unix(session.CreatedAt, 0).UTC().Format(time.RFC3339),
+			LastUsedAt: time.Unix(session.LastUsedAt, 0).UTC().Format(time.RFC3339),
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+type RevokeSessionResponse struct {
+	Message string `json:"message"`
+}
+
+// RevokeMySession ends one of the authenticated user's sessions
+//
+//	@Summary		Revoke a session
+//	@Description	Revokes the specified session of the currently authenticated user. The refresh token and the access tokens of that device stop working immediately.
+//	@Tags			sessions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			id	path		string	true	"Session ID"
+//	@Success		200	{object}	RevokeSessionResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		404	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/me/sessions/{id} [delete]
+func (h *SessionHandler) RevokeMySession(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. セッションを失効
+	if err := h.sessionHelper.RevokeSession(ctx, userID, c.Params("id")); err != nil {
+		if errors.Is(err, helper.ErrSessionNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(helper.ErrorResponse{
+				Error:   "session_not_found",
+				Message: "セッションが見つかりません",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 3. 失効を記録
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &userID,
+		Type:      domain.SecurityEventSessionRevoked,
+		Succeeded: true,
+		Detail:    eventDetail(c.Params("id")),
+	})
+
+	// 4. レスポンス返却
+	return c.Status(fiber.StatusOK).JSON(RevokeSessionResponse{
+		Message: "セッションを終了しました",
+	})
+}
+
+// RevokeAllMySessions ends every session of the authenticated user
+//
+//	@Summary		Log out everywhere
+//	@Description	Revokes all sessions of the currently authenticated user, including the current one, invalidates every access token issued so far, and clears the auth cookies for web browsers.
+//	@Tags			sessions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Success		200	{object}	RevokeSessionResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/me/sessions [delete]
+func (h *SessionHandler) RevokeAllMySessions(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. 全セッションを失効
+	if err := h.sessionHelper.RevokeAllSessions(ctx, userID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 3. 失効を記録
+	recordSecurityEvent(c, h.securityEventUC, domain.SecurityEvent{
+		UserID:    &userID,
+		Type:      domain.SecurityEventSessionRevoked,
+		Succeeded: true,
+		Detail:    eventDetail("all"),
+	})
+
+	// 4. クッキー削除処理
+	isProduction := h.goEnv == "production"
+
+	c.Cookie(&fiber.Cookie{
+		Name:     "access_token",
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HTTPOnly: true,
+		Secure:   isProduction,
+		SameSite: "Lax",
+	})
+	c.Cookie(&fiber.Cookie{
+		Name:     "refresh_token",
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HTTPOnly: true,
+		Secure:   isProduction,
+		SameSite: "Lax",
+	})
+	helper.ClearCSRFCookie(c, isProduction)
+
+	// 5. レスポンス返却
+	return c.Status(fiber.StatusOK).JSON(RevokeSessionResponse{
+		Message: "全てのデバイスからログアウトしました",
+	})
+}
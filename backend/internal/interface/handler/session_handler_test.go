@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/util"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTestSessionApp(handler *SessionHandler, jwtSecret string) *fiber.App {
+	app := fiber.New()
+	app.Get("/api/v1/me/sessions", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.ListMySessions)
+	app.Delete("/api/v1/me/sessions", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.RevokeAllMySessions)
+	app.Delete("/api/v1/me/sessions/:id", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.RevokeMySession)
+	return app
+}
+
+func TestNewSessionHandler(t *testing.T) {
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+
+	handler := NewSessionHandler(sessionHelper, &mockSecurityEventUsecase{}, "development")
+
+	assert.NotNil(t, handler)
+	assert.NotNil(t, handler.sessionHelper)
+}
+
+func TestListMySessions_Unauthorized(t *testing.T) {
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+
+	handler := NewSessionHandler(sessionHelper, &mockSecurityEventUsecase{}, "development")
+	app := setupTestSessionApp(handler, "test-secret-key")
+
+	req := httptest.NewRequest("GET", "/api/v1/me/sessions", nil)
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 401, resp.StatusCode)
+}
+
+func TestListMySessions_Success(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	userID := int64(2001)
+
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+
+	ctx := context.Background()
+	if err := sessionHelper.RevokeAllSessions(ctx, userID); err != nil {
+		t.Skipf("Redis not available: %v", err)
+		return
+	}
+	_, err := sessionHelper.CreateSession(ctx, "test-list-token-1", userID, "client-a", "192.0.2.1", "agent-a")
+	assert.NoError(t, err)
+	_, err = sessionHelper.CreateSession(ctx, "test-list-token-2", userID, "client-b", "192.0.2.2", "agent-b")
+	assert.NoError(t, err)
+
+	handler := NewSessionHandler(sessionHelper, &mockSecurityEventUsecase{}, "development")
+	app := setupTestSessionApp(handler, jwtSecret)
+
+	token, err := util.GenerateAccessToken(userID, "", "test@example.com", false, nil, nil, util.NewHMACKeySet(jwtSecret))
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/api/v1/me/sessions", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var response ListMySessionsResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	err = json.Unmarshal(bodyBytes, &response)
+	assert.NoError(t, err)
+	assert.Len(t, response.Sessions, 2)
+
+	clientIDs := []string{response.Sessions[0].ClientID, response.Sessions[1].ClientID}
+	assert.ElementsMatch(t, []string{"client-a", "client-b"}, clientIDs)
+	for _, session := range response.Sessions {
+		assert.NotEmpty(t, session.ID)
+		assert.NotEmpty(t, session.IPAddress)
+		assert.NotEmpty(t, session.UserAgent)
+		assert.NotEmpty(t, session.CreatedAt)
+		assert.NotEmpty(t, session.LastUsedAt)
+	}
+}
+
+func TestRevokeMySession_Success(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	userID := int64(2002)
+
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+
+	ctx := context.Background()
+	refreshToken := "test-revoke-token-1"
+	session, err := sessionHelper.CreateSession(ctx, refreshToken, userID, "client-a", "192.0.2.1", "agent-a")
+	if err != nil {
+		t.Skipf("Redis not available: %v", err)
+		return
+	}
+
+	handler := NewSessionHandler(sessionHelper, &mockSecurityEventUsecase{}, "development")
+	app := setupTestSessionApp(handler, jwtSecret)
+
+	token, err := util.GenerateAccessToken(userID, "", "test@example.com", false, nil, nil, util.NewHMACKeySet(jwtSecret))
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", "/api/v1/me/sessions/"+session.ID, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	// リフレッシュトークンも失効していること
+	_, err = sessionHelper.GetRefreshToken(ctx, refreshToken)
+	assert.Error(t, err)
+}
+
+func TestRevokeMySession_OtherUsersSession(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+
+	ctx := context.Background()
+	refreshToken := "test-revoke-other-token-1"
+	session, err := sessionHelper.CreateSession(ctx, refreshToken, 2003, "client-a", "192.0.2.1", "agent-a")
+	if err != nil {
+		t.Skipf("Redis not available: %v", err)
+		return
+	}
+
+	handler := NewSessionHandler(sessionHelper, &mockSecurityEventUsecase{}, "development")
+	app := setupTestSessionApp(handler, jwtSecret)
+
+	// 別ユーザーのトークンで削除を試みる
+	token, err := util.GenerateAccessToken(2004, "", "other@example.com", false, nil, nil, util.NewHMACKeySet(jwtSecret))
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", "/api/v1/me/sessions/"+session.ID, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 404, resp.StatusCode)
+
+	var errResp helper.ErrorResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(bodyBytes, &errResp)
+	assert.Equal(t, "session_not_found", errResp.Error)
+
+	// セッションは残っていること
+	_, err = sessionHelper.GetRefreshToken(ctx, refreshToken)
+	assert.NoError(t, err)
+}
+
+func TestRevokeAllMySessions_Success(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	userID := int64(2005)
+
+	mockRedis := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	sessionHelper := helper.NewSessionHelper(mockRedis)
+
+	ctx := context.Background()
+	_, err := sessionHelper.CreateSession(ctx, "test-revoke-all-token-1", userID, "client-a", "192.0.2.1", "agent-a")
+	if err != nil {
+		t.Skipf("Redis not available: %v", err)
+		return
+	}
+	_, err = sessionHelper.CreateSession(ctx, "test-revoke-all-token-2", userID, "client-b", "192.0.2.2", "agent-b")
+	assert.NoError(t, err)
+
+	handler := NewSessionHandler(sessionHelper, &mockSecurityEventUsecase{}, "development")
+	app := setupTestSessionApp(handler, jwtSecret)
+
+	token, err := util.GenerateAccessToken(userID, "", "test@example.com", false, nil, nil, util.NewHMACKeySet(jwtSecret))
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest("DELETE", "/api/v1/me/sessions", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	sessions, err := sessionHelper.ListSessions(ctx, userID)
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+
+	_, err = sessionHelper.GetRefreshToken(ctx, "test-revoke-all-token-1")
+	assert.Error(t, err)
+	_, err = sessionHelper.GetRefreshToken(ctx, "test-revoke-all-token-2")
+	assert.Error(t, err)
+
+	// 呼び出し元のアクセストークンも失効していること
+	req = httptest.NewRequest("GET", "/api/v1/me/sessions", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err = app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 401, resp.StatusCode)
+}
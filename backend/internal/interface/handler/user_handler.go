@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
 	"github.com/keu-5/muzee/backend/internal/helper"
 	"github.com/keu-5/muzee/backend/internal/usecase"
 )
@@ -19,16 +20,18 @@ func NewUserHandler(userUC usecase.UserUsecase) *UserHandler {
 }
 
 type GetMeResponse struct {
-	ID        int64  `json:"id"`
-	Email     string `json:"email"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	ID          int64    `json:"id"`
+	Email       string   `json:"email"`
+	Roles       []string `json:"roles"`
+	Permissions []string `json:"permissions"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
 }
 
 // GetMe returns the current authenticated user's information
 //
 //	@Summary		Get current user
-//	@Description	Returns the current authenticated user's information. Accepts authentication via Bearer token (Authorization header) or HttpOnly cookie (access_token).
+//	@Description	Returns the current authenticated user's information, including the roles and permissions granted to the user. Accepts authentication via Bearer token (Authorization header) or HttpOnly cookie (access_token).
 //	@Tags			users
 //	@Accept			json
 //	@Produce		json
@@ -67,9 +70,11 @@ func (h *UserHandler) GetMe(c *fiber.Ctx) error {
 
 	// レスポンス返却
 	return c.JSON(GetMeResponse{
-		ID:        user.ID,
-		Email:     user.Email,
-		CreatedAt: user.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: user.UpdatedAt.Format(time.RFC3339),
+		ID:          user.ID,
+		Email:       user.Email,
+		Roles:       domain.RoleNames(user.Roles),
+		Permissions: domain.RolePermissions(user.Roles),
+		CreatedAt:   user.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   user.UpdatedAt.Format(time.RFC3339),
 	})
 }
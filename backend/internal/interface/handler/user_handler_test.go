@@ -14,14 +14,13 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/keu-5/muzee/backend/internal/domain"
 	"github.com/keu-5/muzee/backend/internal/helper"
-	"github.com/keu-5/muzee/backend/internal/interface/middleware"
 	"github.com/keu-5/muzee/backend/internal/util"
 	"github.com/stretchr/testify/assert"
 )
 
 func setupTestUserApp(handler *UserHandler, jwtSecret string) *fiber.App {
 	app := fiber.New()
-	app.Get("/api/v1/users/me", middleware.AuthMiddleware(jwtSecret), handler.GetMe)
+	app.Get("/api/v1/users/me", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.GetMe)
 	return app
 }
 
@@ -78,6 +77,46 @@ func TestGetMe_Success(t *testing.T) {
 	assert.Equal(t, "2024-01-02T00:00:00Z", response.UpdatedAt)
 }
 
+func TestGetMe_WithRoles(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	userID := int64(124)
+	email := "staff@example.com"
+
+	mockUser := &mockUserUsecase{
+		getUserByIDFunc: func(ctx context.Context, id int64) (*domain.User, error) {
+			return &domain.User{
+				ID:    userID,
+				Email: email,
+				Roles: []*domain.Role{
+					{Name: domain.RoleSupport, Permissions: []string{domain.PermissionSecurityEventsRead, domain.PermissionRolesRead}},
+					{Name: domain.RoleAdmin, Permissions: []string{domain.PermissionRolesManage, domain.PermissionSecurityEventsRead}},
+				},
+			}, nil
+		},
+	}
+
+	handler := NewUserHandler(mockUser)
+	app := setupTestUserApp(handler, jwtSecret)
+
+	token, err := generateTestAccessToken(userID, email, jwtSecret)
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/api/v1/users/me", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var response GetMeResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	assert.NoError(t, json.Unmarshal(bodyBytes, &response))
+	assert.Equal(t, []string{"admin", "support"}, response.Roles)
+	assert.Equal(t, []string{"roles:manage", "roles:read", "security_events:read"}, response.Permissions)
+}
+
 func TestGetMe_MissingAuthorizationHeader(t *testing.T) {
 	jwtSecret := "test-secret-key"
 	mockUser := &mockUserUsecase{}
@@ -515,7 +554,7 @@ func createToken(userID int64, email string, secret string, expiresIn time.Durat
 func generateSimpleToken(userID int64, email string, secret string) (string, error) {
 	// This will use util.GenerateAccessToken
 	// Import: "github.com/keu-5/muzee/backend/internal/util"
-	return util.GenerateAccessToken(userID, email, false, secret)
+	return util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(secret))
 }
 
 func generateExpiredToken(userID int64, email string, secret string, expiresIn time.Duration) (string, error) {
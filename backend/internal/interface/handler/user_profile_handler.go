@@ -1,11 +1,14 @@
 package handler
 
 import (
+	"errors"
 	"mime/multipart"
+	"strconv"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
 	"github.com/keu-5/muzee/backend/internal/helper"
 	"github.com/keu-5/muzee/backend/internal/usecase"
 )
@@ -25,17 +28,48 @@ func NewUserProfileHandler(userProfileUC usecase.UserProfileUsecase, fileHelper
 }
 
 type UserProfileResponse struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	Username  string    `json:"username"`
-	IconPath  string    `json:"icon_path"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Username string `json:"username"`
+	// IconPath is the largest icon rendition, kept for clients that do not read IconSizes
+	IconPath string `json:"icon_path"`
+	// IconSizes maps a rendition size in pixels ("48", "128", "400") to its object path
+	IconSizes map[string]string `json:"icon_sizes"`
+	// BannerPath is the largest banner rendition; BannerSizes is keyed by width ("600", "1500")
+	BannerPath  string            `json:"banner_path"`
+	BannerSizes map[string]string `json:"banner_sizes"`
+	Bio         string            `json:"bio"`
+	Links       []string          `json:"links"`
+	Location    string            `json:"location"`
+	// Birthday is formatted as YYYY-MM-DD
+	Birthday string `json:"birthday"`
+	Pronouns string `json:"pronouns"`
+	// Visibility maps each optional field to public, followers or only_me
+	Visibility map[string]string `json:"visibility"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// ProfileDetailsForm holds the optional profile fields accepted by the create and update forms.
+// On update an empty value clears the field; links are sent as repeated form values.
+type ProfileDetailsForm struct {
+	Bio                *string  `form:"bio" validate:"omitnil,max=500"`
+	Links              []string `form:"links" validate:"max=5,dive,omitempty,http_url,max=255"`
+	Location           *string  `form:"location" validate:"omitnil,max=100"`
+	Birthday           *string  `form:"birthday"`
+	Pronouns           *string  `form:"pronouns" validate:"omitnil,max=40"`
+	BioVisibility      *string  `form:"bio_visibility" validate:"omitnil,oneof=public followers only_me"`
+	LinksVisibility    *string  `form:"links_visibility" validate:"omitnil,oneof=public followers only_me"`
+	BannerVisibility   *string  `form:"banner_visibility" validate:"omitnil,oneof=public followers only_me"`
+	LocationVisibility *string  `form:"location_visibility" validate:"omitnil,oneof=public followers only_me"`
+	BirthdayVisibility *string  `form:"birthday_visibility" validate:"omitnil,oneof=public followers only_me"`
+	PronounsVisibility *string  `form:"pronouns_visibility" validate:"omitnil,oneof=public followers only_me"`
 }
 
 type CreateMyProfileRequest struct {
 	Name     string `form:"name" validate:"required,min=1,max=100"`
 	Username string `form:"username" validate:"required,min=1,max=50"`
+	ProfileDetailsForm
 }
 
 type CreateMyProfileResponse struct {
@@ -46,19 +80,32 @@ type CreateMyProfileResponse struct {
 // CreateMyProfile creates a user profile for the authenticated user
 //
 //	@Summary		Create user profile
-//	@Description	Creates a user profile for the currently authenticated user. Requires authentication via Bearer token (Authorization header) or HttpOnly cookie (access_token). Accepts multipart form data with optional icon image file.
+//	@Description	Creates a user profile for the currently authenticated user. Requires authentication via Bearer token (Authorization header) or HttpOnly cookie (access_token). Accepts multipart form data with optional icon and banner image files and optional profile fields, each with its own visibility (public by default).
 //	@Tags			user-profiles
 //	@Accept			multipart/form-data
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Security		CookieAuth
-//	@Param			name		formData	string	true	"User name (1-100 characters)"
-//	@Param			username	formData	string	true	"Username (1-50 characters)"
-//	@Param			icon		formData	file	false	"Profile icon image (max 5MB, JPEG/PNG/GIF/WebP)"
-//	@Success		201			{object}	CreateMyProfileResponse
-//	@Failure		400			{object}	helper.ErrorResponse
-//	@Failure		401			{object}	helper.ErrorResponse
-//	@Failure		500			{object}	helper.ErrorResponse
+//	@Param			name				formData	string		true	"User name (1-100 characters)"
+//	@Param			username			formData	string		true	"Username (1-50 characters: letters, digits, _ and -; unique ignoring case and look-alike characters)"
+//	@Param			icon				formData	file		false	"Profile icon image (max 5MB, JPEG/PNG/GIF/WebP; stored as 48/128/400px JPEG renditions)"
+//	@Param			banner				formData	file		false	"Profile banner image (max 5MB, JPEG/PNG/GIF/WebP; cropped to 3:1 and stored as 600/1500px wide JPEG renditions)"
+//	@Param			bio					formData	string		false	"Bio (max 500 characters)"
+//	@Param			links				formData	[]string	false	"Website links (http/https, max 5)"	collectionFormat(multi)
+//	@Param			location			formData	string		false	"Location (max 100 characters)"
+//	@Param			birthday			formData	string		false	"Birthday (YYYY-MM-DD)"
+//	@Param			pronouns			formData	string		false	"Pronouns (max 40 characters)"
+//	@Param			bio_visibility		formData	string		false	"Visibility of bio"			Enums(public, followers, only_me)
+//	@Param			links_visibility	formData	string		false	"Visibility of links"		Enums(public, followers, only_me)
+//	@Param			banner_visibility	formData	string		false	"Visibility of banner"		Enums(public, followers, only_me)
+//	@Param			location_visibility	formData	string		false	"Visibility of location"	Enums(public, followers, only_me)
+//	@Param			birthday_visibility	formData	string		false	"Visibility of birthday"	Enums(public, followers, only_me)
+//	@Param			pronouns_visibility	formData	string		false	"Visibility of pronouns"	Enums(public, followers, only_me)
+//	@Success		201					{object}	CreateMyProfileResponse
+//	@Failure		400					{object}	helper.ErrorResponse
+//	@Failure		401					{object}	helper.ErrorResponse
+//	@Failure		409					{object}	helper.ErrorResponse
+//	@Failure		500					{object}	helper.ErrorResponse
 //	@Router			/v1/me/profile [post]
 func (h *UserProfileHandler) CreateMyProfile(c *fiber.Ctx) error {
 	ctx := c.Context()
@@ -86,23 +133,29 @@ func (h *UserProfileHandler) CreateMyProfile(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
 	}
 
+	details, errRes := buildProfileDetails(req.ProfileDetailsForm)
+	if errRes != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(errRes)
+	}
+
 	// 4. 画像ファイルの処理（オプショナル）
-	var iconFile *multipart.FileHeader
-	var err error
-	iconFile, err = c.FormFile("icon")
-	if err == nil {
-		// ファイルが提供されている場合、バリデーション
-		if err := h.fileHelper.ValidateImageFile(iconFile); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
-				Error:   "invalid_file",
-				Message: err.Error(),
-			})
-		}
+	iconFile, bannerFile, err := h.formImageFiles(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_file",
+			Message: err.Error(),
+		})
 	}
 
 	// 5. ユーザープロフィール作成
-	profile, err := h.userProfileUC.CreateUserProfile(ctx, userID, req.Name, req.Username, iconFile)
+	profile, err := h.userProfileUC.CreateUserProfile(ctx, userID, req.Name, req.Username, details, iconFile, bannerFile)
 	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidImage) {
+			return invalidImage(c)
+		}
+		if status, res, ok := usernameErrorResponse(err); ok {
+			return c.Status(status).JSON(res)
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
 			Error:   "internal_server_error",
 			Message: "サーバーエラーが発生しました",
@@ -110,20 +163,9 @@ func (h *UserProfileHandler) CreateMyProfile(c *fiber.Ctx) error {
 	}
 
 	// 6. レスポンス返却
-	iconPathStr := ""
-	if profile.IconPath != nil {
-		iconPathStr = *profile.IconPath
-	}
 	res := CreateMyProfileResponse{
-		Message: "ユーザープロフィールが作成されました",
-		UserProfile: UserProfileResponse{
-			ID:        profile.ID,
-			Name:      profile.Name,
-			Username:  profile.Username,
-			IconPath:  iconPathStr,
-			CreatedAt: profile.CreatedAt,
-			UpdatedAt: profile.UpdatedAt,
-		},
+		Message:     "ユーザープロフィールが作成されました",
+		UserProfile: buildUserProfileResponse(profile),
 	}
 	return c.Status(fiber.StatusCreated).JSON(res)
 }
@@ -134,12 +176,16 @@ type CheckUsernameAvailabilityRequest struct {
 
 type CheckUsernameAvailabilityResponse struct {
 	Available bool `json:"available"`
+	// Reason is set when the username is unavailable: invalid, reserved, taken or confusable
+	Reason string `json:"reason,omitempty" enums:"invalid,reserved,taken,confusable"`
+	// Suggestions are available alternatives, best first; only set when the username is unavailable
+	Suggestions []string `json:"suggestions,omitempty"`
 }
 
 // CheckUsernameAvailability checks if a given username is available
 //
 //	@Summary		Check username availability
-//	@Description	Checks whether the specified username is available for registration. This endpoint does not require authentication.
+//	@Description	Checks whether the specified username is available for registration. Usernames are compared case-insensitively, and names that look like an existing or reserved username (e.g. "0" for "o", "1" for "l") are unavailable. When the username is unavailable, the response includes ranked alternative suggestions. This endpoint does not require authentication.
 //	@Tags			user-profiles
 //	@Accept			json
 //	@Produce		json
@@ -164,7 +210,7 @@ func (h *UserProfileHandler) CheckUsernameAvailability(c *fiber.Ctx) error {
 	ctx := c.Context()
 
 	// 2. ユーザーネームの利用可能性チェック
-	available, err := h.userProfileUC.IsUsernameAvailable(ctx, req.Username)
+	availability, err := h.userProfileUC.CheckUsernameAvailability(ctx, req.Username)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
 			Error:   "internal_server_error",
@@ -174,7 +220,9 @@ func (h *UserProfileHandler) CheckUsernameAvailability(c *fiber.Ctx) error {
 
 	// 3. レスポンス返却
 	res := CheckUsernameAvailabilityResponse{
-		Available: available,
+		Available:   availability.Available,
+		Reason:      string(availability.Reason),
+		Suggestions: availability.Suggestions,
 	}
 	return c.Status(fiber.StatusOK).JSON(res)
 }
@@ -218,27 +266,478 @@ func (h *UserProfileHandler) GetMyProfile(c *fiber.Ctx) error {
 		})
 	}
 	if profile == nil {
-		return c.Status(fiber.StatusNotFound).JSON(helper.ErrorResponse{
-			Error:   "not_found",
-			Message: "ユーザープロフィールが見つかりません",
+		return userProfileNotFound(c)
+	}
+
+	// 3. レスポンス返却
+	res := GetMyProfileResponse{
+		Message:     "ユーザープロフィールが取得されました",
+		UserProfile: buildUserProfileResponse(profile),
+	}
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// PublicUserProfileResponse is the view of a profile shown to other users; it has no email or internal IDs
+type PublicUserProfileResponse struct {
+	Name      string            `json:"name"`
+	Username  string            `json:"username"`
+	IconPath  string            `json:"icon_path"`
+	IconSizes map[string]string `json:"icon_sizes"`
+	// Optional fields are omitted when empty or hidden from the viewer
+	BannerPath   string                       `json:"banner_path,omitempty"`
+	BannerSizes  map[string]string            `json:"banner_sizes,omitempty"`
+	Bio          string                       `json:"bio,omitempty"`
+	Links        []string                     `json:"links,omitempty"`
+	Location     string                       `json:"location,omitempty"`
+	Birthday     string                       `json:"birthday,omitempty"`
+	Pronouns     string                       `json:"pronouns,omitempty"`
+	CreatedAt    time.Time                    `json:"created_at"`
+	Relationship *ProfileRelationshipResponse `json:"relationship,omitempty"`
+}
+
+// ProfileRelationshipResponse describes the viewer's relationship to the profile owner
+type ProfileRelationshipResponse struct {
+	IsSelf bool `json:"is_self"`
+}
+
+type GetUserProfileByUsernameResponse struct {
+	Message     string                    `json:"message"`
+	UserProfile PublicUserProfileResponse `json:"user_profile"`
+}
+
+// GetUserProfileByUsername retrieves the public profile of a user
+//
+//	@Summary		Get user profile by username
+//	@Description	Retrieves the public profile of the user with the given username. Authentication is optional; when the request carries a valid access token the response includes the viewer's relationship to the profile owner. An invalid token, or a personal access token without the me:read scope, is treated as an anonymous request.
+//	@Tags			user-profiles
+//	@Produce		json
+//	@Param			username	path		string	true	"Username"
+//	@Success		200			{object}	GetUserProfileByUsernameResponse
+//	@Failure		404			{object}	helper.ErrorResponse
+//	@Failure		500			{object}	helper.ErrorResponse
+//	@Router			/v1/users/{username} [get]
+func (h *UserProfileHandler) GetUserProfileByUsername(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	// 1. 閲覧者のuser_idを取得（未ログインの場合はnil）
+	var viewerID *int64
+	if userID, ok := c.Locals("user_id").(int64); ok {
+		viewerID = &userID
+	}
+
+	// 2. ユーザープロフィール取得
+	profile, relationship, err := h.userProfileUC.GetPublicUserProfile(ctx, c.Params("username"), viewerID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrUserProfileNotFound) {
+			return userProfileNotFound(c)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
 		})
 	}
 
 	// 3. レスポンス返却
-	iconPathStr := ""
-	if profile.IconPath != nil {
-		iconPathStr = *profile.IconPath
+	res := GetUserProfileByUsernameResponse{
+		Message:     "ユーザープロフィールが取得されました",
+		UserProfile: buildPublicUserProfileResponse(profile, relationship),
 	}
-	res := GetMyProfileResponse{
-		Message: "ユーザープロフィールが取得されました",
-		UserProfile: UserProfileResponse{
-			ID:        profile.ID,
-			Name:      profile.Name,
-			Username:  profile.Username,
-			IconPath:  iconPathStr,
-			CreatedAt: profile.CreatedAt,
-			UpdatedAt: profile.UpdatedAt,
-		},
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+type UpdateMyProfileRequest struct {
+	Name     *string `form:"name" validate:"omitnil,min=1,max=100"`
+	Username *string `form:"username" validate:"omitnil,min=1,max=50"`
+	ProfileDetailsForm
+}
+
+type UpdateMyProfileResponse struct {
+	Message     string              `json:"message"`
+	UserProfile UserProfileResponse `json:"user_profile"`
+}
+
+// UpdateMyProfile partially updates the profile of the authenticated user
+//
+//	@Summary		Update my user profile
+//	@Description	Updates the given fields of the profile of the currently authenticated user; omitted fields are left unchanged and an empty value clears an optional field. Accepts multipart form data. A new icon or banner replaces the current one, which is deleted from storage. If an upload fails the profile is left unchanged.
+//	@Tags			user-profiles
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Param			name				formData	string		false	"User name (1-100 characters)"
+//	@Param			username			formData	string		false	"Username (1-50 characters: letters, digits, _ and -; unique ignoring case and look-alike characters)"
+//	@Param			icon				formData	file		false	"Profile icon image (max 5MB, JPEG/PNG/GIF/WebP; stored as 48/128/400px JPEG renditions)"
+//	@Param			banner				formData	file		false	"Profile banner image (max 5MB, JPEG/PNG/GIF/WebP; cropped to 3:1 and stored as 600/1500px wide JPEG renditions)"
+//	@Param			bio					formData	string		false	"Bio (max 500 characters)"
+//	@Param			links				formData	[]string	false	"Website links (http/https, max 5)"	collectionFormat(multi)
+//	@Param			location			formData	string		false	"Location (max 100 characters)"
+//	@Param			birthday			formData	string		false	"Birthday (YYYY-MM-DD)"
+//	@Param			pronouns			formData	string		false	"Pronouns (max 40 characters)"
+//	@Param			bio_visibility		formData	string		false	"Visibility of bio"			Enums(public, followers, only_me)
+//	@Param			links_visibility	formData	string		false	"Visibility of links"		Enums(public, followers, only_me)
+//	@Param			banner_visibility	formData	string		false	"Visibility of banner"		Enums(public, followers, only_me)
+//	@Param			location_visibility	formData	string		false	"Visibility of location"	Enums(public, followers, only_me)
+//	@Param			birthday_visibility	formData	string		false	"Visibility of birthday"	Enums(public, followers, only_me)
+//	@Param			pronouns_visibility	formData	string		false	"Visibility of pronouns"	Enums(public, followers, only_me)
+//	@Success		200					{object}	UpdateMyProfileResponse
+//	@Failure		400					{object}	helper.ErrorResponse
+//	@Failure		401					{object}	helper.ErrorResponse
+//	@Failure		404					{object}	helper.ErrorResponse
+//	@Failure		409					{object}	helper.ErrorResponse
+//	@Failure		500					{object}	helper.ErrorResponse
+//	@Router			/v1/me/profile [patch]
+func (h *UserProfileHandler) UpdateMyProfile(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. フォームデータをパース
+	var req UpdateMyProfileRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "bad_request",
+			Message: "無効なリクエストボディです",
+		})
+	}
+
+	// 3. バリデーション
+	if err := h.validate.Struct(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.BuildValidationErrorResponse(err))
+	}
+
+	details, errRes := buildProfileDetails(req.ProfileDetailsForm)
+	if errRes != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(errRes)
+	}
+
+	// 4. 画像ファイルの処理（オプショナル）
+	iconFile, bannerFile, err := h.formImageFiles(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "invalid_file",
+			Message: err.Error(),
+		})
+	}
+
+	if req.Name == nil && req.Username == nil && iconFile == nil && bannerFile == nil && profileDetailsEmpty(details) {
+		return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+			Error:   "bad_request",
+			Message: "変更する項目を指定してください",
+		})
+	}
+
+	// 5. ユーザープロフィール更新
+	profile, err := h.userProfileUC.UpdateUserProfile(ctx, userID, req.Name, req.Username, details, iconFile, bannerFile)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrUserProfileNotFound):
+			return userProfileNotFound(c)
+		case errors.Is(err, usecase.ErrInvalidImage):
+			return invalidImage(c)
+		}
+		if status, res, ok := usernameErrorResponse(err); ok {
+			return c.Status(status).JSON(res)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 6. レスポンス返却
+	res := UpdateMyProfileResponse{
+		Message:     "ユーザープロフィールが更新されました",
+		UserProfile: buildUserProfileResponse(profile),
+	}
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// DeleteMyProfileIcon removes the icon of the authenticated user's profile
+//
+//	@Summary		Delete my profile icon
+//	@Description	Removes the icon from the profile of the currently authenticated user and deletes the image from storage. Succeeds without changes when the profile has no icon.
+//	@Tags			user-profiles
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Success		200	{object}	UpdateMyProfileResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		404	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/me/profile/icon [delete]
+func (h *UserProfileHandler) DeleteMyProfileIcon(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. アイコンを削除
+	profile, err := h.userProfileUC.DeleteUserProfileIcon(ctx, userID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrUserProfileNotFound) {
+			return userProfileNotFound(c)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 3. レスポンス返却
+	res := UpdateMyProfileResponse{
+		Message:     "アイコンが削除されました",
+		UserProfile: buildUserProfileResponse(profile),
+	}
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// DeleteMyProfileBanner removes the banner of the authenticated user's profile
+//
+//	@Summary		Delete my profile banner
+//	@Description	Removes the banner from the profile of the currently authenticated user and deletes the image from storage. Succeeds without changes when the profile has no banner.
+//	@Tags			user-profiles
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		CookieAuth
+//	@Success		200	{object}	UpdateMyProfileResponse
+//	@Failure		401	{object}	helper.ErrorResponse
+//	@Failure		404	{object}	helper.ErrorResponse
+//	@Failure		500	{object}	helper.ErrorResponse
+//	@Router			/v1/me/profile/banner [delete]
+func (h *UserProfileHandler) DeleteMyProfileBanner(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	// 1. ミドルウェアでlocalsに設定されたuser_idを取得
+	userID, ok := c.Locals("user_id").(int64)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "認証が必要です",
+		})
+	}
+
+	// 2. バナーを削除
+	profile, err := h.userProfileUC.DeleteUserProfileBanner(ctx, userID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrUserProfileNotFound) {
+			return userProfileNotFound(c)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// 3. レスポンス返却
+	res := UpdateMyProfileResponse{
+		Message:     "バナーが削除されました",
+		UserProfile: buildUserProfileResponse(profile),
 	}
 	return c.Status(fiber.StatusOK).JSON(res)
 }
+
+// formImageFiles returns the validated icon and banner; a field that was not sent is nil
+func (h *UserProfileHandler) formImageFiles(c *fiber.Ctx) (*multipart.FileHeader, *multipart.FileHeader, error) {
+	var files [2]*multipart.FileHeader
+	for i, field := range []string{"icon", "banner"} {
+		file, err := c.FormFile(field)
+		if err != nil {
+			continue
+		}
+		if err := h.fileHelper.ValidateImageFile(file); err != nil {
+			return nil, nil, err
+		}
+		files[i] = file
+	}
+	return files[0], files[1], nil
+}
+
+// buildProfileDetails converts the form into profile details; empty values are kept so that they clear the field
+func buildProfileDetails(form ProfileDetailsForm) (domain.UserProfileDetails, *helper.ErrorResponse) {
+	details := domain.UserProfileDetails{
+		Bio:      form.Bio,
+		Location: form.Location,
+		Pronouns: form.Pronouns,
+	}
+
+	if form.Links != nil {
+		links := make([]string, 0, len(form.Links))
+		for _, link := range form.Links {
+			if link != "" {
+				links = append(links, link)
+			}
+		}
+		details.Links = &links
+	}
+
+	if form.Birthday != nil {
+		var birthday time.Time
+		if *form.Birthday != "" {
+			parsed, err := time.Parse(time.DateOnly, *form.Birthday)
+			if err != nil || parsed.After(time.Now()) {
+				return details, &helper.ErrorResponse{
+					Error:   "validation_error",
+					Message: "入力内容に誤りがあります",
+					Details: []map[string]interface{}{{
+						"field":   "birthday",
+						"message": "YYYY-MM-DD形式で今日以前の日付を入力してください",
+					}},
+				}
+			}
+			birthday = parsed
+		}
+		details.Birthday = &birthday
+	}
+
+	visibility := map[domain.ProfileField]*string{
+		domain.ProfileFieldBio:      form.BioVisibility,
+		domain.ProfileFieldLinks:    form.LinksVisibility,
+		domain.ProfileFieldBanner:   form.BannerVisibility,
+		domain.ProfileFieldLocation: form.LocationVisibility,
+		domain.ProfileFieldBirthday: form.BirthdayVisibility,
+		domain.ProfileFieldPronouns: form.PronounsVisibility,
+	}
+	for field, v := range visibility {
+		if v == nil {
+			continue
+		}
+		if details.Visibility == nil {
+			details.Visibility = make(map[domain.ProfileField]domain.Visibility)
+		}
+		details.Visibility[field] = domain.Visibility(*v)
+	}
+	return details, nil
+}
+
+func profileDetailsEmpty(details domain.UserProfileDetails) bool {
+	return details.Bio == nil && details.Links == nil && details.Location == nil &&
+		details.Birthday == nil && details.Pronouns == nil && len(details.Visibility) == 0
+}
+
+func buildUserProfileResponse(profile *domain.UserProfile) UserProfileResponse {
+	res := UserProfileResponse{
+		ID:         profile.ID,
+		Name:       profile.Name,
+		Username:   profile.Username,
+		Bio:        derefString(profile.Bio),
+		Links:      profile.Links,
+		Location:   derefString(profile.Location),
+		Birthday:   formatBirthday(profile.Birthday),
+		Pronouns:   derefString(profile.Pronouns),
+		Visibility: make(map[string]string, len(domain.ProfileFields)),
+		CreatedAt:  profile.CreatedAt,
+		UpdatedAt:  profile.UpdatedAt,
+	}
+	res.IconPath, res.IconSizes = buildImageResponse(domain.IconRenditions(profile.IconPath), domain.IconSizes)
+	res.BannerPath, res.BannerSizes = buildImageResponse(domain.BannerRenditions(profile.BannerPath), domain.BannerSizes)
+	if res.Links == nil {
+		res.Links = []string{}
+	}
+	for _, field := range domain.ProfileFields {
+		res.Visibility[string(field)] = string(domain.FieldVisibility(profile, field))
+	}
+	return res
+}
+
+// buildImageResponse returns the largest rendition and the size map; both are empty without an image
+func buildImageResponse(renditions map[string]string, sizes []int) (string, map[string]string) {
+	if renditions == nil {
+		return "", map[string]string{}
+	}
+	largest := sizes[len(sizes)-1]
+	return renditions[strconv.Itoa(largest)], renditions
+}
+
+// buildPublicUserProfileResponse serializes a profile already filtered for the viewer
+func buildPublicUserProfileResponse(profile *domain.UserProfile, relationship *domain.ProfileRelationship) PublicUserProfileResponse {
+	res := PublicUserProfileResponse{
+		Name:      profile.Name,
+		Username:  profile.Username,
+		Bio:       derefString(profile.Bio),
+		Links:     profile.Links,
+		Location:  derefString(profile.Location),
+		Birthday:  formatBirthday(profile.Birthday),
+		Pronouns:  derefString(profile.Pronouns),
+		CreatedAt: profile.CreatedAt,
+	}
+	res.IconPath, res.IconSizes = buildImageResponse(domain.IconRenditions(profile.IconPath), domain.IconSizes)
+	if profile.BannerPath != nil {
+		res.BannerPath, res.BannerSizes = buildImageResponse(domain.BannerRenditions(profile.BannerPath), domain.BannerSizes)
+	}
+	if relationship != nil {
+		res.Relationship = &ProfileRelationshipResponse{
+			IsSelf: relationship.IsSelf,
+		}
+	}
+	return res
+}
+
+func formatBirthday(birthday *time.Time) string {
+	if birthday == nil {
+		return ""
+	}
+	return birthday.Format(time.DateOnly)
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func userProfileNotFound(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusNotFound).JSON(helper.ErrorResponse{
+		Error:   "not_found",
+		Message: "ユーザープロフィールが見つかりません",
+	})
+}
+
+// usernameErrorResponse maps a username refused by the usecase to a response; ok is false for other errors
+func usernameErrorResponse(err error) (status int, res helper.ErrorResponse, ok bool) {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidUsername):
+		return fiber.StatusBadRequest, helper.ErrorResponse{
+			Error:   "invalid_username",
+			Message: "ユーザーネームには英数字、アンダースコア、ハイフンのみ使用できます",
+		}, true
+	case errors.Is(err, usecase.ErrUsernameReserved):
+		return fiber.StatusConflict, helper.ErrorResponse{
+			Error:   "username_reserved",
+			Message: "このユーザーネームは使用できません",
+		}, true
+	case errors.Is(err, usecase.ErrUsernameAlreadyExists):
+		return fiber.StatusConflict, helper.ErrorResponse{
+			Error:   "username_already_exists",
+			Message: "このユーザーネームは既に使用されています",
+		}, true
+	case errors.Is(err, usecase.ErrUsernameConfusable):
+		return fiber.StatusConflict, helper.ErrorResponse{
+			Error:   "username_confusable",
+			Message: "既存のユーザーネームと紛らわしいため使用できません",
+		}, true
+	default:
+		return 0, helper.ErrorResponse{}, false
+	}
+}
+
+func invalidImage(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(helper.ErrorResponse{
+		Error:   "invalid_file",
+		Message: "画像を処理できませんでした",
+	})
+}
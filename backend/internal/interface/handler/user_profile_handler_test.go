@@ -5,30 +5,39 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
 	"io"
 	"mime/multipart"
+	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/keu-5/muzee/backend/internal/domain"
 	"github.com/keu-5/muzee/backend/internal/helper"
-	"github.com/keu-5/muzee/backend/internal/interface/middleware"
+	"github.com/keu-5/muzee/backend/internal/usecase"
 	"github.com/keu-5/muzee/backend/internal/util"
 	"github.com/stretchr/testify/assert"
 )
 
 // Mock UserProfileUsecase
 type mockUserProfileUsecase struct {
-	createUserProfileFunc      func(ctx context.Context, userID int64, name string, username string, iconFile *multipart.FileHeader) (*domain.UserProfile, error)
-	getUserProfileByUserIDFunc func(ctx context.Context, userID int64) (*domain.UserProfile, error)
-	isUsernameAvailableFunc    func(ctx context.Context, username string) (bool, error)
+	createUserProfileFunc       func(ctx context.Context, userID int64, name string, username string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error)
+	getUserProfileByUserIDFunc  func(ctx context.Context, userID int64) (*domain.UserProfile, error)
+	getPublicUserProfileFunc    func(ctx context.Context, username string, viewerID *int64) (*domain.UserProfile, *domain.ProfileRelationship, error)
+	checkUsernameFunc           func(ctx context.Context, username string) (*domain.UsernameAvailability, error)
+	updateUserProfileFunc       func(ctx context.Context, userID int64, name *string, username *string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error)
+	deleteUserProfileIconFunc   func(ctx context.Context, userID int64) (*domain.UserProfile, error)
+	deleteUserProfileBannerFunc func(ctx context.Context, userID int64) (*domain.UserProfile, error)
 }
 
-func (m *mockUserProfileUsecase) CreateUserProfile(ctx context.Context, userID int64, name string, username string, iconFile *multipart.FileHeader) (*domain.UserProfile, error) {
+func (m *mockUserProfileUsecase) CreateUserProfile(ctx context.Context, userID int64, name string, username string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
 	if m.createUserProfileFunc != nil {
-		return m.createUserProfileFunc(ctx, userID, name, username, iconFile)
+		return m.createUserProfileFunc(ctx, userID, name, username, details, iconFile, bannerFile)
 	}
 	// Default: no icon file provided
 	var iconPath *string
@@ -62,17 +71,48 @@ func (m *mockUserProfileUsecase) GetUserProfileByUserID(ctx context.Context, use
 	}, nil
 }
 
-func (m *mockUserProfileUsecase) IsUsernameAvailable(ctx context.Context, username string) (bool, error) {
-	if m.isUsernameAvailableFunc != nil {
-		return m.isUsernameAvailableFunc(ctx, username)
+func (m *mockUserProfileUsecase) GetPublicUserProfile(ctx context.Context, username string, viewerID *int64) (*domain.UserProfile, *domain.ProfileRelationship, error) {
+	if m.getPublicUserProfileFunc != nil {
+		return m.getPublicUserProfileFunc(ctx, username, viewerID)
 	}
-	return true, nil
+	return nil, nil, usecase.ErrUserProfileNotFound
+}
+
+func (m *mockUserProfileUsecase) UpdateUserProfile(ctx context.Context, userID int64, name *string, username *string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
+	if m.updateUserProfileFunc != nil {
+		return m.updateUserProfileFunc(ctx, userID, name, username, details, iconFile, bannerFile)
+	}
+	return &domain.UserProfile{ID: 1, UserID: userID, Name: "Test User", Username: "testuser"}, nil
+}
+
+func (m *mockUserProfileUsecase) DeleteUserProfileIcon(ctx context.Context, userID int64) (*domain.UserProfile, error) {
+	if m.deleteUserProfileIconFunc != nil {
+		return m.deleteUserProfileIconFunc(ctx, userID)
+	}
+	return &domain.UserProfile{ID: 1, UserID: userID, Name: "Test User", Username: "testuser"}, nil
+}
+
+func (m *mockUserProfileUsecase) DeleteUserProfileBanner(ctx context.Context, userID int64) (*domain.UserProfile, error) {
+	if m.deleteUserProfileBannerFunc != nil {
+		return m.deleteUserProfileBannerFunc(ctx, userID)
+	}
+	return &domain.UserProfile{ID: 1, UserID: userID, Name: "Test User", Username: "testuser"}, nil
+}
+
+func (m *mockUserProfileUsecase) CheckUsernameAvailability(ctx context.Context, username string) (*domain.UsernameAvailability, error) {
+	if m.checkUsernameFunc != nil {
+		return m.checkUsernameFunc(ctx, username)
+	}
+	return &domain.UsernameAvailability{Available: true}, nil
 }
 
 func setupTestUserProfileApp(handler *UserProfileHandler, jwtSecret string) *fiber.App {
 	app := fiber.New()
-	app.Post("/api/v1/users/me/profile", middleware.AuthMiddleware(jwtSecret), handler.CreateMyProfile)
-	app.Get("/api/v1/users/me/profile", middleware.AuthMiddleware(jwtSecret), handler.GetMyProfile)
+	app.Post("/api/v1/users/me/profile", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.CreateMyProfile)
+	app.Get("/api/v1/users/me/profile", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.GetMyProfile)
+	app.Patch("/api/v1/users/me/profile", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.UpdateMyProfile)
+	app.Delete("/api/v1/users/me/profile/icon", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.DeleteMyProfileIcon)
+	app.Delete("/api/v1/users/me/profile/banner", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.DeleteMyProfileBanner)
 	app.Get("/api/v1/user-profiles/check-username", handler.CheckUsernameAvailability)
 	return app
 }
@@ -92,7 +132,7 @@ func TestCreateMyProfile_Success(t *testing.T) {
 	email := "test@example.com"
 
 	mockUserProfile := &mockUserProfileUsecase{
-		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, iconFile *multipart.FileHeader) (*domain.UserProfile, error) {
+		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
 			return &domain.UserProfile{
 				ID:        1,
 				UserID:    uid,
@@ -110,7 +150,7 @@ func TestCreateMyProfile_Success(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Create multipart form request
@@ -147,7 +187,7 @@ func TestCreateMyProfile_Success_WithoutIconPath(t *testing.T) {
 	email := "test@example.com"
 
 	mockUserProfile := &mockUserProfileUsecase{
-		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, iconFile *multipart.FileHeader) (*domain.UserProfile, error) {
+		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
 			return &domain.UserProfile{
 				ID:        1,
 				UserID:    uid,
@@ -165,7 +205,7 @@ func TestCreateMyProfile_Success_WithoutIconPath(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Create multipart form request without icon
@@ -206,7 +246,7 @@ func TestCreateMyProfile_InvalidJSON(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Invalid form data
@@ -237,7 +277,7 @@ func TestCreateMyProfile_ValidationError_MissingName(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Missing name
@@ -275,7 +315,7 @@ func TestCreateMyProfile_ValidationError_MissingUsername(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Missing username
@@ -369,7 +409,7 @@ func TestCreateMyProfile_InternalServerError(t *testing.T) {
 	email := "test@example.com"
 
 	mockUserProfile := &mockUserProfileUsecase{
-		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, iconFile *multipart.FileHeader) (*domain.UserProfile, error) {
+		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
 			return nil, errors.New("database error")
 		},
 	}
@@ -379,7 +419,7 @@ func TestCreateMyProfile_InternalServerError(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Create request
@@ -429,7 +469,7 @@ func TestCreateMyProfile_WithGETMethod(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Try GET instead of POST - should now call GetMyProfile endpoint
@@ -448,8 +488,8 @@ func TestCheckUsernameAvailability_Success_Available(t *testing.T) {
 	jwtSecret := "test-secret-key"
 
 	mockUserProfile := &mockUserProfileUsecase{
-		isUsernameAvailableFunc: func(ctx context.Context, username string) (bool, error) {
-			return true, nil
+		checkUsernameFunc: func(ctx context.Context, username string) (*domain.UsernameAvailability, error) {
+			return &domain.UsernameAvailability{Available: true}, nil
 		},
 	}
 
@@ -479,11 +519,14 @@ func TestCheckUsernameAvailability_Success_NotAvailable(t *testing.T) {
 	jwtSecret := "test-secret-key"
 
 	mockUserProfile := &mockUserProfileUsecase{
-		isUsernameAvailableFunc: func(ctx context.Context, username string) (bool, error) {
+		checkUsernameFunc: func(ctx context.Context, username string) (*domain.UsernameAvailability, error) {
 			if username == "existinguser" {
-				return false, nil
+				return &domain.UsernameAvailability{
+					Reason:      domain.UsernameTaken,
+					Suggestions: []string{"existinguser1", "existinguser2"},
+				}, nil
 			}
-			return true, nil
+			return &domain.UsernameAvailability{Available: true}, nil
 		},
 	}
 
@@ -507,6 +550,8 @@ func TestCheckUsernameAvailability_Success_NotAvailable(t *testing.T) {
 	err = json.Unmarshal(bodyBytes, &response)
 	assert.NoError(t, err)
 	assert.False(t, response.Available)
+	assert.Equal(t, "taken", response.Reason)
+	assert.Equal(t, []string{"existinguser1", "existinguser2"}, response.Suggestions)
 }
 
 func TestCheckUsernameAvailability_ValidationError_MissingUsername(t *testing.T) {
@@ -561,8 +606,8 @@ func TestCheckUsernameAvailability_InternalServerError(t *testing.T) {
 	jwtSecret := "test-secret-key"
 
 	mockUserProfile := &mockUserProfileUsecase{
-		isUsernameAvailableFunc: func(ctx context.Context, username string) (bool, error) {
-			return false, errors.New("database error")
+		checkUsernameFunc: func(ctx context.Context, username string) (*domain.UsernameAvailability, error) {
+			return nil, errors.New("database error")
 		},
 	}
 
@@ -612,7 +657,7 @@ func TestCreateMyProfile_InvalidUsernameWithSpecialCharacters(t *testing.T) {
 	email := "test@example.com"
 
 	mockUserProfile := &mockUserProfileUsecase{
-		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, iconFile *multipart.FileHeader) (*domain.UserProfile, error) {
+		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
 			return nil, errors.New("validation error")
 		},
 	}
@@ -622,7 +667,7 @@ func TestCreateMyProfile_InvalidUsernameWithSpecialCharacters(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Create multipart form request with invalid username
@@ -654,7 +699,7 @@ func TestCreateMyProfile_InvalidUsernameWithSpaces(t *testing.T) {
 	email := "test@example.com"
 
 	mockUserProfile := &mockUserProfileUsecase{
-		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, iconFile *multipart.FileHeader) (*domain.UserProfile, error) {
+		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
 			return nil, errors.New("validation error")
 		},
 	}
@@ -664,7 +709,7 @@ func TestCreateMyProfile_InvalidUsernameWithSpaces(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Create multipart form request with username containing spaces
@@ -696,7 +741,7 @@ func TestCreateMyProfile_ValidUsernameWithUnderscore(t *testing.T) {
 	email := "test@example.com"
 
 	mockUserProfile := &mockUserProfileUsecase{
-		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, iconFile *multipart.FileHeader) (*domain.UserProfile, error) {
+		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
 			return &domain.UserProfile{
 				ID:        1,
 				UserID:    uid,
@@ -714,7 +759,7 @@ func TestCreateMyProfile_ValidUsernameWithUnderscore(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Create multipart form request with valid username
@@ -747,7 +792,7 @@ func TestCreateMyProfile_ValidUsernameWithHyphen(t *testing.T) {
 	email := "test@example.com"
 
 	mockUserProfile := &mockUserProfileUsecase{
-		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, iconFile *multipart.FileHeader) (*domain.UserProfile, error) {
+		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
 			return &domain.UserProfile{
 				ID:        1,
 				UserID:    uid,
@@ -765,7 +810,7 @@ func TestCreateMyProfile_ValidUsernameWithHyphen(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Create multipart form request with valid username
@@ -799,7 +844,7 @@ func TestCreateMyProfile_Success_WithIconFile(t *testing.T) {
 
 	iconPath := "user-icons/user_123/test-icon.jpg"
 	mockUserProfile := &mockUserProfileUsecase{
-		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, iconFile *multipart.FileHeader) (*domain.UserProfile, error) {
+		createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
 			// Verify that icon file was passed
 			if iconFile == nil {
 				t.Error("Expected iconFile to be non-nil")
@@ -821,7 +866,7 @@ func TestCreateMyProfile_Success_WithIconFile(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Create multipart form request with icon
@@ -836,9 +881,7 @@ func TestCreateMyProfile_Success_WithIconFile(t *testing.T) {
 		"Content-Type":        {"image/jpeg"},
 	})
 	assert.NoError(t, err)
-	// Write fake JPEG data (minimal valid JPEG header)
-	fakeJPEG := []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10, 0x4A, 0x46, 0x49, 0x46}
-	partWriter.Write(fakeJPEG)
+	partWriter.Write(newTestImage(t, "jpeg", 16, 16))
 	writer.Close()
 
 	req := httptest.NewRequest("POST", "/api/v1/users/me/profile", body)
@@ -872,7 +915,7 @@ func TestCreateMyProfile_InvalidFileType(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Create multipart form request with invalid file type
@@ -917,10 +960,10 @@ func TestCreateMyProfile_FileSizeTooLarge(t *testing.T) {
 	app := fiber.New(fiber.Config{
 		BodyLimit: 10 * 1024 * 1024, // 10MB for test
 	})
-	app.Post("/api/v1/users/me/profile", middleware.AuthMiddleware(jwtSecret), handler.CreateMyProfile)
+	app.Post("/api/v1/users/me/profile", newTestAuthMiddleware(util.NewHMACKeySet(jwtSecret)), handler.CreateMyProfile)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Create multipart form request with large file
@@ -987,7 +1030,7 @@ func TestGetMyProfile_Success(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	req := httptest.NewRequest("GET", "/api/v1/users/me/profile", nil)
@@ -1035,7 +1078,7 @@ func TestGetMyProfile_Success_WithoutIcon(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	req := httptest.NewRequest("GET", "/api/v1/users/me/profile", nil)
@@ -1120,7 +1163,7 @@ func TestGetMyProfile_NotFound(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	req := httptest.NewRequest("GET", "/api/v1/users/me/profile", nil)
@@ -1155,7 +1198,7 @@ func TestGetMyProfile_InternalServerError(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	req := httptest.NewRequest("GET", "/api/v1/users/me/profile", nil)
@@ -1185,7 +1228,7 @@ func TestGetMyProfile_WithPOSTMethod(t *testing.T) {
 	app := setupTestUserProfileApp(handler, jwtSecret)
 
 	// Create valid JWT token
-	token, err := util.GenerateAccessToken(userID, email, false, jwtSecret)
+	token, err := util.GenerateAccessToken(userID, "", email, false, nil, nil, util.NewHMACKeySet(jwtSecret))
 	assert.NoError(t, err)
 
 	// Try POST instead of GET
@@ -1199,3 +1242,716 @@ func TestGetMyProfile_WithPOSTMethod(t *testing.T) {
 	// Should be handled by CreateMyProfile endpoint, so it should return 400 for bad request
 	assert.NotEqual(t, 405, resp.StatusCode)
 }
+
+// ========== UpdateMyProfile Tests ==========
+
+func newUpdateMyProfileRequest(t *testing.T, jwtSecret string, fields map[string]string) *http.Request {
+	token, err := util.GenerateAccessToken(int64(123), "", "test@example.com", false, nil, nil, util.NewHMACKeySet(jwtSecret))
+	assert.NoError(t, err)
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	for key, value := range fields {
+		writer.WriteField(key, value)
+	}
+	writer.Close()
+
+	req := httptest.NewRequest("PATCH", "/api/v1/users/me/profile", body)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestUpdateMyProfile_Success_NameOnly(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	var gotName, gotUsername *string
+	mockUserProfile := &mockUserProfileUsecase{
+		updateUserProfileFunc: func(ctx context.Context, uid int64, name *string, username *string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
+			gotName, gotUsername = name, username
+			assert.Equal(t, int64(123), uid)
+			assert.Nil(t, iconFile)
+			return &domain.UserProfile{ID: 1, UserID: uid, Name: *name, Username: "testuser"}, nil
+		},
+	}
+
+	handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+	app := setupTestUserProfileApp(handler, jwtSecret)
+
+	resp, err := app.Test(newUpdateMyProfileRequest(t, jwtSecret, map[string]string{"name": "New Name"}), -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+	if assert.NotNil(t, gotName) {
+		assert.Equal(t, "New Name", *gotName)
+	}
+	assert.Nil(t, gotUsername)
+
+	var result UpdateMyProfileResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(bodyBytes, &result)
+	assert.Equal(t, "ユーザープロフィールが更新されました", result.Message)
+	assert.Equal(t, "New Name", result.UserProfile.Name)
+	assert.Equal(t, "testuser", result.UserProfile.Username)
+}
+
+func TestUpdateMyProfile_NoFields(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	mockUserProfile := &mockUserProfileUsecase{
+		updateUserProfileFunc: func(ctx context.Context, uid int64, name *string, username *string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
+			t.Error("UpdateUserProfile should not be called")
+			return nil, nil
+		},
+	}
+
+	handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+	app := setupTestUserProfileApp(handler, jwtSecret)
+
+	resp, err := app.Test(newUpdateMyProfileRequest(t, jwtSecret, map[string]string{}), -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 400, resp.StatusCode)
+
+	var errResp helper.ErrorResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(bodyBytes, &errResp)
+	assert.Equal(t, "bad_request", errResp.Error)
+}
+
+func TestUpdateMyProfile_ValidationError_EmptyName(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	handler := NewUserProfileHandler(&mockUserProfileUsecase{}, helper.NewFileHelper())
+	app := setupTestUserProfileApp(handler, jwtSecret)
+
+	resp, err := app.Test(newUpdateMyProfileRequest(t, jwtSecret, map[string]string{"name": ""}), -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 400, resp.StatusCode)
+
+	var errResp helper.ErrorResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(bodyBytes, &errResp)
+	assert.Equal(t, "validation_error", errResp.Error)
+}
+
+func TestUpdateMyProfile_ErrorMapping(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedError  string
+	}{
+		{"profile not found", usecase.ErrUserProfileNotFound, 404, "not_found"},
+		{"username taken", usecase.ErrUsernameAlreadyExists, 409, "username_already_exists"},
+		{"username reserved", usecase.ErrUsernameReserved, 409, "username_reserved"},
+		{"username confusable", usecase.ErrUsernameConfusable, 409, "username_confusable"},
+		{"invalid username", usecase.ErrInvalidUsername, 400, "invalid_username"},
+		{"internal error", errors.New("database error"), 500, "internal_server_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserProfile := &mockUserProfileUsecase{
+				updateUserProfileFunc: func(ctx context.Context, uid int64, name *string, username *string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
+					return nil, tt.err
+				},
+			}
+
+			handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+			app := setupTestUserProfileApp(handler, jwtSecret)
+
+			resp, err := app.Test(newUpdateMyProfileRequest(t, jwtSecret, map[string]string{"username": "taken"}), -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var errResp helper.ErrorResponse
+			bodyBytes, _ := io.ReadAll(resp.Body)
+			json.Unmarshal(bodyBytes, &errResp)
+			assert.Equal(t, tt.expectedError, errResp.Error)
+		})
+	}
+}
+
+// ========== DeleteMyProfileIcon Tests ==========
+
+func TestDeleteMyProfileIcon(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{"success", nil, 200},
+		{"profile not found", usecase.ErrUserProfileNotFound, 404},
+		{"internal error", errors.New("database error"), 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserProfile := &mockUserProfileUsecase{
+				deleteUserProfileIconFunc: func(ctx context.Context, uid int64) (*domain.UserProfile, error) {
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &domain.UserProfile{ID: 1, UserID: uid, Name: "Test User", Username: "testuser"}, nil
+				},
+			}
+
+			handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+			app := setupTestUserProfileApp(handler, jwtSecret)
+
+			token, err := util.GenerateAccessToken(int64(123), "", "test@example.com", false, nil, nil, util.NewHMACKeySet(jwtSecret))
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest("DELETE", "/api/v1/users/me/profile/icon", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			if tt.err == nil {
+				var result UpdateMyProfileResponse
+				bodyBytes, _ := io.ReadAll(resp.Body)
+				json.Unmarshal(bodyBytes, &result)
+				assert.Equal(t, "アイコンが削除されました", result.Message)
+				assert.Empty(t, result.UserProfile.IconPath)
+			}
+		})
+	}
+}
+
+// ========== GetUserProfileByUsername Tests ==========
+
+// setupTestPublicProfileApp sets user_id like OptionalAuthMiddleware when viewerID is given
+func setupTestPublicProfileApp(handler *UserProfileHandler, viewerID *int64) *fiber.App {
+	app := fiber.New()
+	app.Get("/api/v1/users/:username", func(c *fiber.Ctx) error {
+		if viewerID != nil {
+			c.Locals("user_id", *viewerID)
+		}
+		return c.Next()
+	}, handler.GetUserProfileByUsername)
+	return app
+}
+
+func TestGetUserProfileByUsername_Success_Anonymous(t *testing.T) {
+	iconPath := "user-icons/user_42/icon.png"
+	mockUserProfile := &mockUserProfileUsecase{
+		getPublicUserProfileFunc: func(ctx context.Context, username string, viewerID *int64) (*domain.UserProfile, *domain.ProfileRelationship, error) {
+			assert.Equal(t, "owner", username)
+			assert.Nil(t, viewerID)
+			return &domain.UserProfile{ID: 1, UserID: 42, Name: "Owner", Username: "owner", IconPath: &iconPath}, nil, nil
+		},
+	}
+
+	handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+	app := setupTestPublicProfileApp(handler, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/users/owner", nil)
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	var raw map[string]map[string]any
+	json.Unmarshal(bodyBytes, &raw)
+	profile := raw["user_profile"]
+	assert.Equal(t, "owner", profile["username"])
+	assert.Equal(t, iconPath, profile["icon_path"])
+	assert.NotContains(t, profile, "id")
+	assert.NotContains(t, profile, "user_id")
+	assert.NotContains(t, profile, "email")
+	assert.NotContains(t, profile, "relationship")
+}
+
+func TestGetUserProfileByUsername_Success_WithViewer(t *testing.T) {
+	viewerID := int64(42)
+	mockUserProfile := &mockUserProfileUsecase{
+		getPublicUserProfileFunc: func(ctx context.Context, username string, vid *int64) (*domain.UserProfile, *domain.ProfileRelationship, error) {
+			if assert.NotNil(t, vid) {
+				assert.Equal(t, viewerID, *vid)
+			}
+			return &domain.UserProfile{ID: 1, UserID: 42, Name: "Owner", Username: "owner"}, &domain.ProfileRelationship{IsSelf: true}, nil
+		},
+	}
+
+	handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+	app := setupTestPublicProfileApp(handler, &viewerID)
+
+	req := httptest.NewRequest("GET", "/api/v1/users/owner", nil)
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var result GetUserProfileByUsernameResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(bodyBytes, &result)
+	if assert.NotNil(t, result.UserProfile.Relationship) {
+		assert.True(t, result.UserProfile.Relationship.IsSelf)
+	}
+}
+
+func TestGetUserProfileByUsername_Errors(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedError  string
+	}{
+		{"unknown username", usecase.ErrUserProfileNotFound, 404, "not_found"},
+		{"internal error", errors.New("database error"), 500, "internal_server_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserProfile := &mockUserProfileUsecase{
+				getPublicUserProfileFunc: func(ctx context.Context, username string, viewerID *int64) (*domain.UserProfile, *domain.ProfileRelationship, error) {
+					return nil, nil, tt.err
+				},
+			}
+
+			handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+			app := setupTestPublicProfileApp(handler, nil)
+
+			req := httptest.NewRequest("GET", "/api/v1/users/nobody", nil)
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var errResp helper.ErrorResponse
+			bodyBytes, _ := io.ReadAll(resp.Body)
+			json.Unmarshal(bodyBytes, &errResp)
+			assert.Equal(t, tt.expectedError, errResp.Error)
+		})
+	}
+}
+
+// ========== Icon Image Tests ==========
+
+// testWebP is a 4x2 lossless WebP image
+var testWebP = []byte{
+	0x52, 0x49, 0x46, 0x46, 0x1C, 0x00, 0x00, 0x00, 0x57, 0x45, 0x42, 0x50,
+	0x56, 0x50, 0x38, 0x4C, 0x0F, 0x00, 0x00, 0x00, 0x2F, 0x03, 0x40, 0x00,
+	0x00, 0x88, 0x03, 0xFC, 0x1F, 0xE0, 0xBF, 0xFF, 0x51, 0x5A, 0x0A, 0x00,
+}
+
+// newTestImage encodes a blank width x height image as "jpeg" or "png"
+func newTestImage(t *testing.T, format string, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	var buf bytes.Buffer
+	var err error
+	if format == "png" {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, nil)
+	}
+	assert.NoError(t, err)
+	return buf.Bytes()
+}
+
+func TestUpdateMyProfile_IconValidation(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	tests := []struct {
+		name           string
+		contentType    string
+		data           []byte
+		expectedStatus int
+	}{
+		{"png sent as jpeg", "image/jpeg", newTestImage(t, "png", 16, 16), 200},
+		{"webp", "image/webp", testWebP, 200},
+		{"text sent as jpeg", "image/jpeg", []byte("This is not an image"), 400},
+		{"truncated jpeg", "image/jpeg", []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10, 0x4A, 0x46, 0x49, 0x46}, 400},
+		{"dimensions too large", "image/png", newTestImage(t, "png", util.MaxImageDimension+1, 1), 400},
+		{"too many pixels", "image/png", newTestImage(t, "png", 4100, 4100), 400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			mockUserProfile := &mockUserProfileUsecase{
+				updateUserProfileFunc: func(ctx context.Context, uid int64, name *string, username *string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
+					called = true
+					return &domain.UserProfile{ID: 1, UserID: uid, Name: "Test User", Username: "testuser"}, nil
+				},
+			}
+
+			handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+			app := setupTestUserProfileApp(handler, jwtSecret)
+
+			token, err := util.GenerateAccessToken(int64(123), "", "test@example.com", false, nil, nil, util.NewHMACKeySet(jwtSecret))
+			assert.NoError(t, err)
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			partWriter, err := writer.CreatePart(map[string][]string{
+				"Content-Disposition": {`form-data; name="icon"; filename="icon.jpg"`},
+				"Content-Type":        {tt.contentType},
+			})
+			assert.NoError(t, err)
+			partWriter.Write(tt.data)
+			writer.Close()
+
+			req := httptest.NewRequest("PATCH", "/api/v1/users/me/profile", body)
+			req.Header.Set("Authorization", "Bearer "+token)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tt.expectedStatus == 200, called)
+			if tt.expectedStatus == 400 {
+				var errResp helper.ErrorResponse
+				bodyBytes, _ := io.ReadAll(resp.Body)
+				json.Unmarshal(bodyBytes, &errResp)
+				assert.Equal(t, "invalid_file", errResp.Error)
+			}
+		})
+	}
+}
+
+func TestUpdateMyProfile_InvalidImage(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	mockUserProfile := &mockUserProfileUsecase{
+		updateUserProfileFunc: func(ctx context.Context, uid int64, name *string, username *string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
+			return nil, usecase.ErrInvalidImage
+		},
+	}
+
+	handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+	app := setupTestUserProfileApp(handler, jwtSecret)
+
+	resp, err := app.Test(newUpdateMyProfileRequest(t, jwtSecret, map[string]string{"name": "New Name"}), -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 400, resp.StatusCode)
+
+	var errResp helper.ErrorResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(bodyBytes, &errResp)
+	assert.Equal(t, "invalid_file", errResp.Error)
+}
+
+func TestGetMyProfile_IconSizes(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	renditionPrefix := "user-icons/user_123/3f1c2d4e"
+	legacyIcon := "user-icons/user_123/icon.png"
+
+	tests := []struct {
+		name             string
+		iconPath         *string
+		expectedIconPath string
+		expectedSizes    map[string]string
+	}{
+		{
+			name:             "renditions",
+			iconPath:         &renditionPrefix,
+			expectedIconPath: "user-icons/user_123/3f1c2d4e/400.jpg",
+			expectedSizes: map[string]string{
+				"48":  "user-icons/user_123/3f1c2d4e/48.jpg",
+				"128": "user-icons/user_123/3f1c2d4e/128.jpg",
+				"400": "user-icons/user_123/3f1c2d4e/400.jpg",
+			},
+		},
+		{
+			name:             "icon uploaded before renditions",
+			iconPath:         &legacyIcon,
+			expectedIconPath: "user-icons/user_123/icon.png",
+			expectedSizes: map[string]string{
+				"48":  "user-icons/user_123/icon.png",
+				"128": "user-icons/user_123/icon.png",
+				"400": "user-icons/user_123/icon.png",
+			},
+		},
+		{
+			name:          "no icon",
+			expectedSizes: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserProfile := &mockUserProfileUsecase{
+				getUserProfileByUserIDFunc: func(ctx context.Context, uid int64) (*domain.UserProfile, error) {
+					return &domain.UserProfile{ID: 1, UserID: uid, Name: "Test User", Username: "testuser", IconPath: tt.iconPath}, nil
+				},
+			}
+
+			handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+			app := setupTestUserProfileApp(handler, jwtSecret)
+
+			token, err := util.GenerateAccessToken(int64(123), "", "test@example.com", false, nil, nil, util.NewHMACKeySet(jwtSecret))
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest("GET", "/api/v1/users/me/profile", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, 200, resp.StatusCode)
+
+			var result GetMyProfileResponse
+			bodyBytes, _ := io.ReadAll(resp.Body)
+			json.Unmarshal(bodyBytes, &result)
+			assert.Equal(t, tt.expectedIconPath, result.UserProfile.IconPath)
+			assert.Equal(t, tt.expectedSizes, result.UserProfile.IconSizes)
+		})
+	}
+}
+
+func TestUpdateMyProfile_Details(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	var got domain.UserProfileDetails
+	mockUserProfile := &mockUserProfileUsecase{
+		updateUserProfileFunc: func(ctx context.Context, uid int64, name *string, username *string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
+			got = details
+			return &domain.UserProfile{
+				ID:         1,
+				UserID:     uid,
+				Username:   "testuser",
+				Bio:        details.Bio,
+				Links:      *details.Links,
+				Visibility: details.Visibility,
+			}, nil
+		},
+	}
+
+	handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+	app := setupTestUserProfileApp(handler, jwtSecret)
+
+	token, err := util.GenerateAccessToken(int64(123), "", "test@example.com", false, nil, nil, util.NewHMACKeySet(jwtSecret))
+	assert.NoError(t, err)
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("bio", "hello")
+	writer.WriteField("links", "https://example.com")
+	writer.WriteField("links", "")
+	writer.WriteField("links", "https://example.org/me")
+	writer.WriteField("birthday", "")
+	writer.WriteField("bio_visibility", "followers")
+	writer.Close()
+	req := httptest.NewRequest("PATCH", "/api/v1/users/me/profile", body)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := app.Test(req, -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+	if assert.NotNil(t, got.Bio) {
+		assert.Equal(t, "hello", *got.Bio)
+	}
+	if assert.NotNil(t, got.Links) {
+		assert.Equal(t, []string{"https://example.com", "https://example.org/me"}, *got.Links)
+	}
+	if assert.NotNil(t, got.Birthday) {
+		assert.True(t, got.Birthday.IsZero(), "an empty birthday clears the field")
+	}
+	assert.Nil(t, got.Location)
+	assert.Nil(t, got.Pronouns)
+	assert.Equal(t, map[domain.ProfileField]domain.Visibility{domain.ProfileFieldBio: domain.VisibilityFollowers}, got.Visibility)
+
+	var result UpdateMyProfileResponse
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(bodyBytes, &result)
+	assert.Equal(t, "hello", result.UserProfile.Bio)
+	assert.Equal(t, []string{"https://example.com", "https://example.org/me"}, result.UserProfile.Links)
+	assert.Equal(t, "followers", result.UserProfile.Visibility["bio"])
+	assert.Equal(t, "public", result.UserProfile.Visibility["birthday"])
+}
+
+func TestUpdateMyProfile_DetailsValidation(t *testing.T) {
+	jwtSecret := "test-secret-key"
+	tomorrow := time.Now().AddDate(0, 0, 1).Format(time.DateOnly)
+
+	tests := []struct {
+		name      string
+		fields    map[string]string
+		wantField string
+	}{
+		{name: "bio too long", fields: map[string]string{"bio": strings.Repeat("a", 501)}, wantField: "bio"},
+		{name: "link without scheme", fields: map[string]string{"links": "example.com"}, wantField: "links[0]"},
+		{name: "javascript link", fields: map[string]string{"links": "javascript:alert(1)"}, wantField: "links[0]"},
+		{name: "unknown visibility", fields: map[string]string{"location_visibility": "friends"}, wantField: "locationvisibility"},
+		{name: "malformed birthday", fields: map[string]string{"birthday": "2000/01/02"}, wantField: "birthday"},
+		{name: "future birthday", fields: map[string]string{"birthday": tomorrow}, wantField: "birthday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserProfile := &mockUserProfileUsecase{
+				updateUserProfileFunc: func(ctx context.Context, uid int64, name *string, username *string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
+					t.Error("UpdateUserProfile should not be called")
+					return nil, nil
+				},
+			}
+			handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+			app := setupTestUserProfileApp(handler, jwtSecret)
+
+			resp, err := app.Test(newUpdateMyProfileRequest(t, jwtSecret, tt.fields), -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, 400, resp.StatusCode)
+
+			var errResp helper.ErrorResponse
+			bodyBytes, _ := io.ReadAll(resp.Body)
+			json.Unmarshal(bodyBytes, &errResp)
+			assert.Equal(t, "validation_error", errResp.Error)
+			assert.Contains(t, string(bodyBytes), `"field":"`+tt.wantField+`"`)
+		})
+	}
+}
+
+func TestGetUserProfileByUsername_OmitsHiddenFields(t *testing.T) {
+	bio := "hello"
+	mockUserProfile := &mockUserProfileUsecase{
+		getPublicUserProfileFunc: func(ctx context.Context, username string, viewerID *int64) (*domain.UserProfile, *domain.ProfileRelationship, error) {
+			// 閲覧者に見せない項目はユースケースで取り除かれている
+			return &domain.UserProfile{ID: 1, UserID: 42, Name: "Owner", Username: "owner", Bio: &bio}, nil, nil
+		},
+	}
+
+	handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+	app := setupTestPublicProfileApp(handler, nil)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/users/owner", nil), -1)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, 200, resp.StatusCode)
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	var raw map[string]map[string]any
+	json.Unmarshal(bodyBytes, &raw)
+	profile := raw["user_profile"]
+	assert.Equal(t, "hello", profile["bio"])
+	for _, field := range []string{"banner_path", "banner_sizes", "links", "location", "birthday", "pronouns", "visibility"} {
+		assert.NotContains(t, profile, field)
+	}
+}
+
+func TestDeleteMyProfileBanner(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	tests := []struct {
+		name           string
+		deleteErr      error
+		expectedStatus int
+	}{
+		{name: "success", expectedStatus: 200},
+		{name: "profile not found", deleteErr: usecase.ErrUserProfileNotFound, expectedStatus: 404},
+		{name: "internal error", deleteErr: errors.New("storage error"), expectedStatus: 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserProfile := &mockUserProfileUsecase{
+				deleteUserProfileBannerFunc: func(ctx context.Context, uid int64) (*domain.UserProfile, error) {
+					assert.Equal(t, int64(123), uid)
+					if tt.deleteErr != nil {
+						return nil, tt.deleteErr
+					}
+					return &domain.UserProfile{ID: 1, UserID: uid, Username: "testuser"}, nil
+				},
+			}
+			handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+			app := setupTestUserProfileApp(handler, jwtSecret)
+
+			token, err := util.GenerateAccessToken(int64(123), "", "test@example.com", false, nil, nil, util.NewHMACKeySet(jwtSecret))
+			assert.NoError(t, err)
+			req := httptest.NewRequest("DELETE", "/api/v1/users/me/profile/banner", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			if tt.expectedStatus == 200 {
+				var result UpdateMyProfileResponse
+				bodyBytes, _ := io.ReadAll(resp.Body)
+				json.Unmarshal(bodyBytes, &result)
+				assert.Equal(t, "バナーが削除されました", result.Message)
+				assert.Empty(t, result.UserProfile.BannerPath)
+				assert.Equal(t, map[string]string{}, result.UserProfile.BannerSizes)
+			}
+		})
+	}
+}
+
+func TestCreateMyProfile_UsernameErrorMapping(t *testing.T) {
+	jwtSecret := "test-secret-key"
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedError  string
+	}{
+		{"username taken", usecase.ErrUsernameAlreadyExists, 409, "username_already_exists"},
+		{"username reserved", usecase.ErrUsernameReserved, 409, "username_reserved"},
+		{"username confusable", usecase.ErrUsernameConfusable, 409, "username_confusable"},
+		{"invalid username", usecase.ErrInvalidUsername, 400, "invalid_username"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserProfile := &mockUserProfileUsecase{
+				createUserProfileFunc: func(ctx context.Context, uid int64, name string, username string, details domain.UserProfileDetails, iconFile, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
+					return nil, tt.err
+				},
+			}
+			handler := NewUserProfileHandler(mockUserProfile, helper.NewFileHelper())
+			app := setupTestUserProfileApp(handler, jwtSecret)
+
+			token, err := util.GenerateAccessToken(int64(123), "", "test@example.com", false, nil, nil, util.NewHMACKeySet(jwtSecret))
+			assert.NoError(t, err)
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			writer.WriteField("name", "Test User")
+			writer.WriteField("username", "admin")
+			writer.Close()
+			req := httptest.NewRequest("POST", "/api/v1/users/me/profile", body)
+			req.Header.Set("Authorization", "Bearer "+token)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+
+			resp, err := app.Test(req, -1)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var errResp helper.ErrorResponse
+			bodyBytes, _ := io.ReadAll(resp.Body)
+			json.Unmarshal(bodyBytes, &errResp)
+			assert.Equal(t, tt.expectedError, errResp.Error)
+		})
+	}
+}
@@ -1,19 +1,27 @@
 package middleware
 
 import (
+	"errors"
+	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/domain"
 	"github.com/keu-5/muzee/backend/internal/helper"
+	"github.com/keu-5/muzee/backend/internal/usecase"
 	"github.com/keu-5/muzee/backend/internal/util"
 )
 
-// AuthMiddleware verifies JWT tokens and sets user information in context
-func AuthMiddleware(jwtSecret string) fiber.Handler {
+// AuthMiddleware verifies JWT tokens, rejects revoked ones and sets user information in context.
+// Personal access tokens are accepted in the Authorization header as well; their scopes are
+// stored in context for RequireScope.
+func AuthMiddleware(jwtKeys *util.JWTKeySet, sessionHelper *helper.SessionHelper, patUC usecase.PersonalAccessTokenUsecase) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		var tokenString string
 
 		tokenString = c.Cookies("access_token")
+		viaCookie := tokenString != ""
 
 		if tokenString == "" {
 			authHeader := c.Get("Authorization")
@@ -32,10 +40,15 @@ func AuthMiddleware(jwtSecret string) fiber.Handler {
 					Message: "トークンの形式が正しくありません",
 				})
 			}
+
+			// パーソナルアクセストークン
+			if util.IsPersonalAccessToken(tokenString) {
+				return authenticatePersonalAccessToken(c, patUC, tokenString)
+			}
 		}
 
 		// JWTを検証
-		claims, err := util.ValidateAccessToken(tokenString, jwtSecret)
+		claims, err := util.ValidateAccessToken(tokenString, jwtKeys)
 		if err != nil {
 			return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
 				Error:   "invalid_token",
@@ -43,10 +56,98 @@ func AuthMiddleware(jwtSecret string) fiber.Handler {
 			})
 		}
 
+		// 失効済みトークンでないか確認（Redis障害時は可用性を優先して通過させる）
+		revoked, err := sessionHelper.IsAccessTokenRevoked(c.Context(), claims.UserID, claims.ID, claims.SessionID, claims.IssuedAtTime())
+		if err != nil {
+			fmt.Printf("アクセストークン失効確認エラー: %v\n", err)
+		} else if revoked {
+			return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+				Error:   "token_revoked",
+				Message: "トークンは無効化されています。再度ログインしてください",
+			})
+		}
+
 		// コンテキストにユーザー情報を設定
 		c.Locals("user_id", claims.UserID)
 		c.Locals("email", claims.Email)
+		c.Locals("roles", claims.Roles)
+		c.Locals("permissions", claims.Permissions)
+		c.Locals("token_id", claims.ID)
+		c.Locals("session_id", claims.SessionID)
+		c.Locals("auth_via_cookie", viaCookie)
+		if claims.ExpiresAt != nil {
+			c.Locals("token_expires_at", claims.ExpiresAt.Time)
+		}
 
 		return c.Next()
 	}
 }
+
+// OptionalAuthMiddleware sets user_id in context when the request carries a valid token and
+// otherwise lets it through anonymously. It is meant for public GET endpoints whose response
+// only varies with the viewer, so an expired or revoked token never hides a public page.
+// Personal access tokens identify the viewer only when they carry the me:read scope.
+func OptionalAuthMiddleware(jwtKeys *util.JWTKeySet, sessionHelper *helper.SessionHelper, patUC usecase.PersonalAccessTokenUsecase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		tokenString := c.Cookies("access_token")
+		if tokenString == "" {
+			authHeader := c.Get("Authorization")
+			tokenString = strings.TrimPrefix(authHeader, "Bearer ")
+			if tokenString == authHeader {
+				return c.Next()
+			}
+
+			// パーソナルアクセストークン（本人向けの項目を読めるme:readスコープがなければ匿名として扱う）
+			if util.IsPersonalAccessToken(tokenString) {
+				token, user, err := patUC.Authenticate(c.Context(), tokenString, c.IP())
+				if err == nil && slices.Contains(token.Scopes, domain.TokenScopeMeRead) {
+					c.Locals("user_id", user.ID)
+				}
+				return c.Next()
+			}
+		}
+
+		// JWTを検証（無効なトークンは匿名として扱う）
+		claims, err := util.ValidateAccessToken(tokenString, jwtKeys)
+		if err != nil {
+			return c.Next()
+		}
+
+		revoked, err := sessionHelper.IsAccessTokenRevoked(c.Context(), claims.UserID, claims.ID, claims.SessionID, claims.IssuedAtTime())
+		if err != nil {
+			fmt.Printf("アクセストークン失効確認エラー: %v\n", err)
+		} else if revoked {
+			return c.Next()
+		}
+
+		c.Locals("user_id", claims.UserID)
+		return c.Next()
+	}
+}
+
+// authenticatePersonalAccessToken sets the owner of a personal access token in context
+func authenticatePersonalAccessToken(c *fiber.Ctx, patUC usecase.PersonalAccessTokenUsecase, tokenString string) error {
+	token, user, err := patUC.Authenticate(c.Context(), tokenString, c.IP())
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidPersonalAccessToken) {
+			return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+				Error:   "invalid_token",
+				Message: "トークンが無効または期限切れです",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(helper.ErrorResponse{
+			Error:   "internal_server_error",
+			Message: "サーバーエラーが発生しました",
+		})
+	}
+
+	// コンテキストにユーザー情報を設定（権限はトークン発行時ではなく現在のロールから求める）
+	c.Locals("user_id", user.ID)
+	c.Locals("email", user.Email)
+	c.Locals("roles", domain.RoleNames(user.Roles))
+	c.Locals("permissions", domain.RolePermissions(user.Roles))
+	c.Locals("personal_access_token_id", token.ID)
+	c.Locals("token_scopes", token.Scopes)
+
+	return c.Next()
+}
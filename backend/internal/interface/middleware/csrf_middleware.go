@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/helper"
+)
+
+// CSRFProtection requires the CSRF header to match the CSRF cookie on unsafe requests
+// authenticated by the access_token cookie. Clients sending a bearer token are not
+// affected, since browsers never attach the Authorization header on their own.
+// It must run after AuthMiddleware.
+func CSRFProtection() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		switch c.Method() {
+		case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions:
+			return c.Next()
+		}
+
+		if viaCookie, _ := c.Locals("auth_via_cookie").(bool); !viaCookie {
+			return c.Next()
+		}
+
+		if !helper.HasValidCSRFToken(c) {
+			return c.Status(fiber.StatusForbidden).JSON(helper.ErrorResponse{
+				Error:   "csrf_token_invalid",
+				Message: "CSRFトークンが無効です。ページを再読み込みしてください",
+			})
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/helper"
+)
+
+// RequirePermission only lets through users whose access token grants every given permission; it must run after AuthMiddleware
+func RequirePermission(permissions ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if _, ok := c.Locals("user_id").(int64); !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(helper.ErrorResponse{
+				Error:   "unauthorized",
+				Message: "認証が必要です",
+			})
+		}
+
+		granted, _ := c.Locals("permissions").([]string)
+		for _, permission := range permissions {
+			if !slices.Contains(granted, permission) {
+				return c.Status(fiber.StatusForbidden).JSON(helper.ErrorResponse{
+					Error:   "forbidden",
+					Message: "この操作を行う権限がありません",
+				})
+			}
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,120 @@
+package middleware
+
+import "time"
+
+var (
+	// SendCodeRateLimit is shared by sending and resending signup codes
+	SendCodeRateLimit = RateLimitPolicy{
+		Name:    "send_code",
+		Limit:   3,
+		Window:  5 * time.Minute,
+		Key:     KeyByEmail(),
+		Message: "送信回数が多すぎます。しばらく待ってから再度お試しください",
+	}
+
+	// VerifyCodeRateLimit is counted per IP and email so that resending a code does not reset it
+	VerifyCodeRateLimit = RateLimitPolicy{
+		Name:    "verify_code",
+		Limit:   10,
+		Window:  15 * time.Minute,
+		Key:     KeyByIPAndEmail(),
+		Message: "確認コードの入力回数が多すぎます。しばらく待ってから再度お試しください",
+	}
+
+	// LoginRateLimit limits password guesses against one account
+	LoginRateLimit = RateLimitPolicy{
+		Name:    "login",
+		Limit:   5,
+		Window:  15 * time.Minute,
+		Key:     KeyByEmail(),
+		Message: "短時間に複数回ログインが試行されました。しばらくお待ちください",
+	}
+
+	// LoginIPRateLimit limits one client trying many accounts
+	LoginIPRateLimit = RateLimitPolicy{
+		Name:    "login_ip",
+		Limit:   30,
+		Window:  15 * time.Minute,
+		Key:     KeyByIP(),
+		Message: "短時間に複数回ログインが試行されました。しばらくお待ちください",
+	}
+
+	// LoginMFARateLimit limits one client spraying second-factor codes across many MFA challenges;
+	// each challenge also counts its own failed attempts
+	LoginMFARateLimit = RateLimitPolicy{
+		Name:    "login_mfa",
+		Limit:   20,
+		Window:  15 * time.Minute,
+		Key:     KeyByIP(),
+		Message: "確認コードの入力回数が多すぎます。しばらく待ってから再度お試しください",
+	}
+
+	// LoginDeviceRateLimit limits one client spraying codes across many new-device challenges
+	LoginDeviceRateLimit = RateLimitPolicy{
+		Name:    "login_device",
+		Limit:   20,
+		Window:  15 * time.Minute,
+		Key:     KeyByIP(),
+		Message: "確認コードの入力回数が多すぎます。しばらく待ってから再度お試しください",
+	}
+
+	PasswordResetRateLimit = RateLimitPolicy{
+		Name:    "password_reset",
+		Limit:   3,
+		Window:  5 * time.Minute,
+		Key:     KeyByEmail(),
+		Message: "送信回数が多すぎます。しばらく待ってから再度お試しください",
+	}
+
+	// PasswordResetVerifyRateLimit is counted per IP and email so that requesting a new code does not reset it
+	PasswordResetVerifyRateLimit = RateLimitPolicy{
+		Name:    "password_reset_verify",
+		Limit:   10,
+		Window:  15 * time.Minute,
+		Key:     KeyByIPAndEmail(),
+		Message: "確認コードの入力回数が多すぎます。しばらく待ってから再度お試しください",
+	}
+
+	MagicLinkRateLimit = RateLimitPolicy{
+		Name:    "magic_link",
+		Limit:   3,
+		Window:  5 * time.Minute,
+		Key:     KeyByEmail(),
+		Message: "送信回数が多すぎます。しばらく待ってから再度お試しください",
+	}
+
+	// MagicLinkConsumeRateLimit limits one client trying many magic link tokens
+	MagicLinkConsumeRateLimit = RateLimitPolicy{
+		Name:    "magic_link_consume",
+		Limit:   20,
+		Window:  15 * time.Minute,
+		Key:     KeyByIP(),
+		Message: "短時間に複数回試行されました。しばらくお待ちください",
+	}
+
+	EmailChangeRateLimit = RateLimitPolicy{
+		Name:    "email_change",
+		Limit:   3,
+		Window:  5 * time.Minute,
+		Key:     KeyByUserID(),
+		Message: "送信回数が多すぎます。しばらく待ってから再度お試しください",
+	}
+
+	// EmailChangeConfirmRateLimit is counted per user so that requesting a new code does not reset it
+	EmailChangeConfirmRateLimit = RateLimitPolicy{
+		Name:    "email_change_confirm",
+		Limit:   10,
+		Window:  15 * time.Minute,
+		Key:     KeyByUserID(),
+		Message: "確認コードの入力回数が多すぎます。しばらく待ってから再度お試しください",
+	}
+
+	// PasswordChangeRateLimit limits guesses of the current password from a stolen session
+	PasswordChangeRateLimit = RateLimitPolicy{
+		Name:    "password_change",
+		Limit:   5,
+		Window:  15 * time.Minute,
+		Key:     KeyByUserID(),
+		Message: "短時間に複数回試行されました。しばらくお待ちください",
+	}
+)
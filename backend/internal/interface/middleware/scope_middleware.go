@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/keu-5/muzee/backend/internal/helper"
+)
+
+// RequireScope limits personal access tokens to the route groups their scopes grant:
+// GET and HEAD requests need <group>:read and other methods need <group>:write.
+// Requests authenticated with an access token (JWT) are not restricted. It must run after AuthMiddleware.
+func RequireScope(group string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		scopes, isPersonalAccessToken := c.Locals("token_scopes").([]string)
+		if !isPersonalAccessToken {
+			return c.Next()
+		}
+
+		required := group + ":write"
+		if c.Method() == fiber.MethodGet || c.Method() == fiber.MethodHead {
+			required = group + ":read"
+		}
+		if !slices.Contains(scopes, required) {
+			return c.Status(fiber.StatusForbidden).JSON(helper.ErrorResponse{
+				Error:   "insufficient_scope",
+				Message: "このトークンには必要なスコープ（" + required + "）がありません",
+			})
+		}
+
+		return c.Next()
+	}
+}
+
+// DenyPersonalAccessToken rejects requests authenticated with a personal access token, so that a leaked
+// token cannot be used to take over the account or create more tokens. It must run after AuthMiddleware.
+func DenyPersonalAccessToken() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if _, isPersonalAccessToken := c.Locals("personal_access_token_id").(int64); isPersonalAccessToken {
+			return c.Status(fiber.StatusForbidden).JSON(helper.ErrorResponse{
+				Error:   "personal_access_token_not_allowed",
+				Message: "この操作はパーソナルアクセストークンでは実行できません",
+			})
+		}
+
+		return c.Next()
+	}
+}
@@ -3,8 +3,12 @@ package interfacepkg
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/keu-5/muzee/backend/config"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/helper"
 	"github.com/keu-5/muzee/backend/internal/interface/handler"
 	"github.com/keu-5/muzee/backend/internal/interface/middleware"
+	"github.com/keu-5/muzee/backend/internal/usecase"
+	"github.com/keu-5/muzee/backend/internal/util"
 	fiberSwagger "github.com/swaggo/fiber-swagger"
 )
 
@@ -14,6 +18,20 @@ func RegisterRoutes(
 	authHandler *handler.AuthHandler,
 	userHandler *handler.UserHandler,
 	userProfileHandler *handler.UserProfileHandler,
+	sessionHandler *handler.SessionHandler,
+	jwksHandler *handler.JWKSHandler,
+	mfaHandler *handler.MFAHandler,
+	oidcHandler *handler.OIDCHandler,
+	accountHandler *handler.AccountHandler,
+	magicLinkHandler *handler.MagicLinkHandler,
+	deviceHandler *handler.DeviceHandler,
+	securityEventHandler *handler.SecurityEventHandler,
+	roleHandler *handler.RoleHandler,
+	personalAccessTokenHandler *handler.PersonalAccessTokenHandler,
+	jwtKeys *util.JWTKeySet,
+	sessionHelper *helper.SessionHelper,
+	patUC usecase.PersonalAccessTokenUsecase,
+	rateLimiter *helper.RateLimiter,
 	cfg *config.Config,
 ) {
 	if cfg != nil && cfg.GOEnv == "development" {
@@ -28,27 +46,87 @@ func RegisterRoutes(
 		return c.SendString("ok")
 	})
 
+	app.Get("/.well-known/jwks.json", jwksHandler.GetJWKS)
+
 	app.Post("/tests", testHandler.Create)
 	app.Get("/tests", testHandler.GetAll)
 
+	limit := func(policy middleware.RateLimitPolicy) fiber.Handler {
+		return middleware.RateLimit(rateLimiter, policy)
+	}
+
+	authenticate := middleware.AuthMiddleware(jwtKeys, sessionHelper, patUC)
+	csrf := middleware.CSRFProtection()
+
 	v1 := app.Group("/v1")
 	auth := v1.Group("/auth")
-	auth.Post("/login", authHandler.Login)
+	auth.Post("/login", limit(middleware.LoginIPRateLimit), limit(middleware.LoginRateLimit), authHandler.Login)
+	auth.Post("/login/mfa", limit(middleware.LoginMFARateLimit), authHandler.VerifyLoginMFA)
+	auth.Post("/login/device", limit(middleware.LoginDeviceRateLimit), authHandler.VerifyLoginDevice)
 	auth.Post("/refresh", authHandler.RefreshToken)
 	auth.Post("/logout", authHandler.Logout)
+	auth.Post("/magic-link", limit(middleware.MagicLinkRateLimit), magicLinkHandler.RequestMagicLink)
+	auth.Post("/magic-link/consume", limit(middleware.MagicLinkConsumeRateLimit), magicLinkHandler.ConsumeMagicLink)
+
+	oidc := auth.Group("/oidc")
+	oidc.Post("/:provider/authorize", oidcHandler.StartOIDCLogin)
+	oidc.Post("/:provider/callback", oidcHandler.OIDCCallback)
 
 	signup := auth.Group("/signup")
-	signup.Post("/send-code", authHandler.SendCode)
-	signup.Post("/resend-code", authHandler.ResendCode)
-	signup.Post("/verify-code", authHandler.VerifyCode)
+	signup.Post("/send-code", limit(middleware.SendCodeRateLimit), authHandler.SendCode)
+	signup.Post("/resend-code", limit(middleware.SendCodeRateLimit), authHandler.ResendCode)
+	signup.Post("/verify-code", limit(middleware.VerifyCodeRateLimit), authHandler.VerifyCode)
+
+	password := auth.Group("/password")
+	password.Post("/forgot", limit(middleware.PasswordResetRateLimit), authHandler.ForgotPassword)
+	password.Post("/reset", limit(middleware.PasswordResetVerifyRateLimit), authHandler.ResetPassword)
 
-	users := v1.Group("/users", middleware.AuthMiddleware(cfg.JWTSecret))
-	users.Get("/me", userHandler.GetMe)
+	auth.Post("/email/revert", accountHandler.RevertEmailChange)
+
+	users := v1.Group("/users")
+	users.Get("/me", authenticate, csrf, middleware.RequireScope("users"), userHandler.GetMe)
+	// /me より後に登録する
+	users.Get("/:username", middleware.OptionalAuthMiddleware(jwtKeys, sessionHelper, patUC), userProfileHandler.GetUserProfileByUsername)
 
 	userProfiles := v1.Group("/user-profiles")
 	userProfiles.Get("/check-username", userProfileHandler.CheckUsernameAvailability)
 
-	me := v1.Group("/me", middleware.AuthMiddleware(cfg.JWTSecret))
+	// 認証情報を変更する操作はパーソナルアクセストークンでは行えない
+	sessionOnly := middleware.DenyPersonalAccessToken()
+
+	me := v1.Group("/me", authenticate, csrf, middleware.RequireScope("me"))
+	me.Delete("/", sessionOnly, accountHandler.DeleteMe)
 	me.Post("/profile", userProfileHandler.CreateMyProfile)
 	me.Get("/profile", userProfileHandler.GetMyProfile)
+	me.Patch("/profile", userProfileHandler.UpdateMyProfile)
+	me.Delete("/profile/icon", userProfileHandler.DeleteMyProfileIcon)
+	me.Delete("/profile/banner", userProfileHandler.DeleteMyProfileBanner)
+	me.Post("/email", sessionOnly, limit(middleware.EmailChangeRateLimit), accountHandler.RequestEmailChange)
+	me.Post("/email/confirm", sessionOnly, limit(middleware.EmailChangeConfirmRateLimit), accountHandler.ConfirmEmailChange)
+	me.Put("/password", sessionOnly, limit(middleware.PasswordChangeRateLimit), accountHandler.ChangePassword)
+	me.Get("/sessions", sessionHandler.ListMySessions)
+	me.Delete("/sessions", sessionHandler.RevokeAllMySessions)
+	me.Delete("/sessions/:id", sessionHandler.RevokeMySession)
+	me.Get("/devices", deviceHandler.ListMyDevices)
+	me.Patch("/devices/:id", deviceHandler.UpdateMyDevice)
+	me.Delete("/devices/:id", deviceHandler.DeleteMyDevice)
+	me.Get("/security-events", securityEventHandler.ListMySecurityEvents)
+
+	tokens := me.Group("/tokens", sessionOnly)
+	tokens.Get("/", personalAccessTokenHandler.ListMyPersonalAccessTokens)
+	tokens.Post("/", personalAccessTokenHandler.CreateMyPersonalAccessToken)
+	tokens.Delete("/:id", personalAccessTokenHandler.RevokeMyPersonalAccessToken)
+
+	mfa := me.Group("/mfa", sessionOnly)
+	mfa.Get("/", mfaHandler.GetMyMFAStatus)
+	mfa.Post("/totp", mfaHandler.BeginTOTPEnrollment)
+	mfa.Post("/totp/confirm", mfaHandler.ConfirmTOTPEnrollment)
+	mfa.Post("/totp/disable", mfaHandler.DisableTOTP)
+	mfa.Post("/recovery-codes", mfaHandler.RegenerateRecoveryCodes)
+
+	admin := v1.Group("/admin", authenticate, csrf, middleware.RequireScope("admin"))
+	admin.Get("/security-events", middleware.RequirePermission(domain.PermissionSecurityEventsRead), securityEventHandler.SearchSecurityEvents)
+	admin.Get("/roles", middleware.RequirePermission(domain.PermissionRolesRead), roleHandler.ListRoles)
+	admin.Put("/users/:id/roles/:role", middleware.RequirePermission(domain.PermissionRolesManage), roleHandler.AssignUserRole)
+	admin.Delete("/users/:id/roles/:role", middleware.RequirePermission(domain.PermissionRolesManage), roleHandler.RevokeUserRole)
 }
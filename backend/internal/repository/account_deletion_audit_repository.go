@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/keu-5/muzee/backend/ent"
+	"github.com/keu-5/muzee/backend/ent/accountdeletionaudit"
+	"github.com/keu-5/muzee/backend/internal/domain"
+)
+
+type AccountDeletionAuditRepository interface {
+	Create(ctx context.Context, userID int64, step domain.AccountDeletionStep, succeeded bool, detail *string) (*domain.AccountDeletionAudit, error)
+}
+
+type accountDeletionAuditRepository struct {
+	client *ent.Client
+}
+
+func NewAccountDeletionAuditRepository(client *ent.Client) AccountDeletionAuditRepository {
+	return &accountDeletionAuditRepository{client: client}
+}
+
+func (r *accountDeletionAuditRepository) Create(ctx context.Context, userID int64, step domain.AccountDeletionStep, succeeded bool, detail *string) (*domain.AccountDeletionAudit, error) {
+	status := accountdeletionaudit.StatusSucceeded
+	if !succeeded {
+		status = accountdeletionaudit.StatusFailed
+	}
+
+	audit, err := r.client.AccountDeletionAudit.Create().
+		SetUserID(userID).
+		SetStep(accountdeletionaudit.Step(step)).
+		SetStatus(status).
+		SetNillableDetail(detail).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.AccountDeletionAudit{
+		ID:        audit.ID,
+		UserID:    audit.UserID,
+		Step:      domain.AccountDeletionStep(audit.Step),
+		Succeeded: audit.Status == accountdeletionaudit.StatusSucceeded,
+		Detail:    audit.Detail,
+		CreatedAt: audit.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/keu-5/muzee/backend/ent"
+	"github.com/keu-5/muzee/backend/ent/personalaccesstoken"
+	"github.com/keu-5/muzee/backend/ent/user"
+	"github.com/keu-5/muzee/backend/internal/domain"
+)
+
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, userID int64, name, tokenHash, tokenPrefix string, scopes []string, expiresAt time.Time) (*domain.PersonalAccessToken, error)
+	GetByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error)
+	ListByUserID(ctx context.Context, userID int64) ([]*domain.PersonalAccessToken, error)
+	CountByUserID(ctx context.Context, userID int64) (int, error)
+	TouchLastUsed(ctx context.Context, id int64, at time.Time, ipAddress string) error
+	Delete(ctx context.Context, userID, id int64) (bool, error)
+}
+
+type personalAccessTokenRepository struct {
+	client *ent.Client
+}
+
+func NewPersonalAccessTokenRepository(client *ent.Client) PersonalAccessTokenRepository {
+	return &personalAccessTokenRepository{client: client}
+}
+
+func (r *personalAccessTokenRepository) Create(ctx context.Context, userID int64, name, tokenHash, tokenPrefix string, scopes []string, expiresAt time.Time) (*domain.PersonalAccessToken, error) {
+	token, err := r.client.PersonalAccessToken.Create().
+		SetUserID(userID).
+		SetName(name).
+		SetTokenHash(tokenHash).
+		SetTokenPrefix(tokenPrefix).
+		SetScopes(scopes).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPersonalAccessToken(token, userID), nil
+}
+
+func (r *personalAccessTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	token, err := r.client.PersonalAccessToken.
+		Query().
+		Where(personalaccesstoken.TokenHashEQ(tokenHash)).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainPersonalAccessToken(token, token.Edges.User.ID), nil
+}
+
+// ListByUserID returns the user's tokens, newest first
+func (r *personalAccessTokenRepository) ListByUserID(ctx context.Context, userID int64) ([]*domain.PersonalAccessToken, error) {
+	tokens, err := r.client.PersonalAccessToken.
+		Query().
+		Where(personalaccesstoken.HasUserWith(user.IDEQ(userID))).
+		Order(ent.Desc(personalaccesstoken.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.PersonalAccessToken, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, toDomainPersonalAccessToken(token, userID))
+	}
+	return result, nil
+}
+
+func (r *personalAccessTokenRepository) CountByUserID(ctx context.Context, userID int64) (int, error) {
+	return r.client.PersonalAccessToken.
+		Query().
+		Where(personalaccesstoken.HasUserWith(user.IDEQ(userID))).
+		Count(ctx)
+}
+
+func (r *personalAccessTokenRepository) TouchLastUsed(ctx context.Context, id int64, at time.Time, ipAddress string) error {
+	return r.client.PersonalAccessToken.
+		UpdateOneID(id).
+		SetLastUsedAt(at).
+		SetLastUsedIP(ipAddress).
+		Exec(ctx)
+}
+
+// Delete revokes one of the user's tokens and reports whether it existed
+func (r *personalAccessTokenRepository) Delete(ctx context.Context, userID, id int64) (bool, error) {
+	n, err := r.client.PersonalAccessToken.
+		Delete().
+		Where(
+			personalaccesstoken.IDEQ(id),
+			personalaccesstoken.HasUserWith(user.IDEQ(userID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func toDomainPersonalAccessToken(token *ent.PersonalAccessToken, userID int64) *domain.PersonalAccessToken {
+	return &domain.PersonalAccessToken{
+		ID:          token.ID,
+		UserID:      userID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      token.Scopes,
+		ExpiresAt:   token.ExpiresAt,
+		LastUsedAt:  token.LastUsedAt,
+		LastUsedIP:  token.LastUsedIP,
+		CreatedAt:   token.CreatedAt,
+	}
+}
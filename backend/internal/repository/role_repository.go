@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/keu-5/muzee/backend/ent"
+	"github.com/keu-5/muzee/backend/ent/role"
+	"github.com/keu-5/muzee/backend/ent/user"
+	"github.com/keu-5/muzee/backend/internal/domain"
+)
+
+type RoleRepository interface {
+	Upsert(ctx context.Context, name, description string, permissions []string) (*domain.Role, error)
+	GetByName(ctx context.Context, name string) (*domain.Role, error)
+	List(ctx context.Context) ([]*domain.Role, error)
+	AddToUser(ctx context.Context, userID, roleID int64) (bool, error)
+	RemoveFromUser(ctx context.Context, userID, roleID int64) (bool, error)
+}
+
+type roleRepository struct {
+	client *ent.Client
+}
+
+func NewRoleRepository(client *ent.Client) RoleRepository {
+	return &roleRepository{client: client}
+}
+
+// Upsert creates the role or overwrites the description and permissions of the existing one
+func (r *roleRepository) Upsert(ctx context.Context, name, description string, permissions []string) (*domain.Role, error) {
+	existing, err := r.client.Role.
+		Query().
+		Where(role.NameEQ(name)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	var saved *ent.Role
+	if existing == nil {
+		saved, err = r.client.Role.Create().
+			SetName(name).
+			SetDescription(description).
+			SetPermissions(permissions).
+			Save(ctx)
+	} else {
+		saved, err = existing.Update().
+			SetDescription(description).
+			SetPermissions(permissions).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRole(saved), nil
+}
+
+func (r *roleRepository) GetByName(ctx context.Context, name string) (*domain.Role, error) {
+	found, err := r.client.Role.
+		Query().
+		Where(role.NameEQ(name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainRole(found), nil
+}
+
+func (r *roleRepository) List(ctx context.Context) ([]*domain.Role, error) {
+	roles, err := r.client.Role.
+		Query().
+		Order(ent.Asc(role.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRoles(roles), nil
+}
+
+// AddToUser grants the role and reports whether the user exists; granting a role the user already has is a no-op
+func (r *roleRepository) AddToUser(ctx context.Context, userID, roleID int64) (bool, error) {
+	n, err := r.client.User.
+		Update().
+		Where(
+			user.IDEQ(userID),
+			user.Not(user.HasRolesWith(role.IDEQ(roleID))),
+		).
+		AddRoleIDs(roleID).
+		Save(ctx)
+	if err != nil || n > 0 {
+		return n > 0, err
+	}
+	return r.client.User.Query().Where(user.IDEQ(userID)).Exist(ctx)
+}
+
+// RemoveFromUser revokes the role and reports whether the user exists
+func (r *roleRepository) RemoveFromUser(ctx context.Context, userID, roleID int64) (bool, error) {
+	n, err := r.client.User.
+		Update().
+		Where(user.IDEQ(userID)).
+		RemoveRoleIDs(roleID).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func toDomainRole(r *ent.Role) *domain.Role {
+	return &domain.Role{
+		ID:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: r.Permissions,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+func toDomainRoles(roles []*ent.Role) []*domain.Role {
+	result := make([]*domain.Role, 0, len(roles))
+	for _, r := range roles {
+		result = append(result, toDomainRole(r))
+	}
+	return result
+}
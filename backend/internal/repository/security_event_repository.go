@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/keu-5/muzee/backend/ent"
+	"github.com/keu-5/muzee/backend/ent/predicate"
+	"github.com/keu-5/muzee/backend/ent/securityevent"
+	"github.com/keu-5/muzee/backend/internal/domain"
+)
+
+type SecurityEventRepository interface {
+	Create(ctx context.Context, event *domain.SecurityEvent) (*domain.SecurityEvent, error)
+	List(ctx context.Context, filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, error)
+}
+
+type securityEventRepository struct {
+	client *ent.Client
+}
+
+func NewSecurityEventRepository(client *ent.Client) SecurityEventRepository {
+	return &securityEventRepository{client: client}
+}
+
+func (r *securityEventRepository) Create(ctx context.Context, event *domain.SecurityEvent) (*domain.SecurityEvent, error) {
+	status := securityevent.StatusSucceeded
+	if !event.Succeeded {
+		status = securityevent.StatusFailed
+	}
+
+	created, err := r.client.SecurityEvent.Create().
+		SetNillableUserID(event.UserID).
+		SetEventType(securityevent.EventType(event.Type)).
+		SetStatus(status).
+		SetEmail(event.Email).
+		SetIPAddress(event.IPAddress).
+		SetUserAgent(event.UserAgent).
+		SetClientID(event.ClientID).
+		SetNillableDetail(event.Detail).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSecurityEvent(created), nil
+}
+
+// List returns the events matching the filter, newest first
+func (r *securityEventRepository) List(ctx context.Context, filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, error) {
+	var predicates []predicate.SecurityEvent
+	if filter.UserID != nil {
+		predicates = append(predicates, securityevent.UserIDEQ(*filter.UserID))
+	}
+	if filter.Email != "" {
+		predicates = append(predicates, securityevent.EmailEQ(filter.Email))
+	}
+	if filter.Type != "" {
+		predicates = append(predicates, securityevent.EventTypeEQ(securityevent.EventType(filter.Type)))
+	}
+	if filter.Succeeded != nil {
+		status := securityevent.StatusSucceeded
+		if !*filter.Succeeded {
+			status = securityevent.StatusFailed
+		}
+		predicates = append(predicates, securityevent.StatusEQ(status))
+	}
+	if filter.IPAddress != "" {
+		predicates = append(predicates, securityevent.IPAddressEQ(filter.IPAddress))
+	}
+	if filter.Since != nil {
+		predicates = append(predicates, securityevent.CreatedAtGTE(*filter.Since))
+	}
+	if filter.Until != nil {
+		predicates = append(predicates, securityevent.CreatedAtLT(*filter.Until))
+	}
+	if filter.BeforeID > 0 {
+		predicates = append(predicates, securityevent.IDLT(filter.BeforeID))
+	}
+
+	events, err := r.client.SecurityEvent.
+		Query().
+		Where(predicates...).
+		Order(ent.Desc(securityevent.FieldID)).
+		Limit(filter.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.SecurityEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, toDomainSecurityEvent(event))
+	}
+	return result, nil
+}
+
+func toDomainSecurityEvent(event *ent.SecurityEvent) *domain.SecurityEvent {
+	return &domain.SecurityEvent{
+		ID:        event.ID,
+		UserID:    event.UserID,
+		Type:      domain.SecurityEventType(event.EventType),
+		Succeeded: event.Status == securityevent.StatusSucceeded,
+		Email:     event.Email,
+		IPAddress: event.IPAddress,
+		UserAgent: event.UserAgent,
+		ClientID:  event.ClientID,
+		Detail:    event.Detail,
+		CreatedAt: event.CreatedAt,
+	}
+}
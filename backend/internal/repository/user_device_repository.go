@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/keu-5/muzee/backend/ent"
+	"github.com/keu-5/muzee/backend/ent/user"
+	"github.com/keu-5/muzee/backend/ent/userdevice"
+	"github.com/keu-5/muzee/backend/internal/domain"
+)
+
+type UserDeviceRepository interface {
+	Create(ctx context.Context, userID int64, clientID, ipAddress, userAgent string, trusted bool) (*domain.UserDevice, error)
+	GetByClientID(ctx context.Context, userID int64, clientID string) (*domain.UserDevice, error)
+	ListByUserID(ctx context.Context, userID int64) ([]*domain.UserDevice, error)
+	Touch(ctx context.Context, id int64, ipAddress, userAgent string, trusted bool) error
+	SetTrusted(ctx context.Context, userID, id int64, trusted bool) (bool, error)
+	Delete(ctx context.Context, userID, id int64) (bool, error)
+}
+
+type userDeviceRepository struct {
+	client *ent.Client
+}
+
+func NewUserDeviceRepository(client *ent.Client) UserDeviceRepository {
+	return &userDeviceRepository{client: client}
+}
+
+func (r *userDeviceRepository) Create(ctx context.Context, userID int64, clientID, ipAddress, userAgent string, trusted bool) (*domain.UserDevice, error) {
+	device, err := r.client.UserDevice.Create().
+		SetUserID(userID).
+		SetClientID(clientID).
+		SetIPAddress(ipAddress).
+		SetUserAgent(userAgent).
+		SetTrusted(trusted).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainUserDevice(device, userID), nil
+}
+
+func (r *userDeviceRepository) GetByClientID(ctx context.Context, userID int64, clientID string) (*domain.UserDevice, error) {
+	device, err := r.client.UserDevice.
+		Query().
+		Where(
+			userdevice.ClientIDEQ(clientID),
+			userdevice.HasUserWith(user.IDEQ(userID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainUserDevice(device, userID), nil
+}
+
+func (r *userDeviceRepository) ListByUserID(ctx context.Context, userID int64) ([]*domain.UserDevice, error) {
+	devices, err := r.client.UserDevice.
+		Query().
+		Where(userdevice.HasUserWith(user.IDEQ(userID))).
+		Order(ent.Desc(userdevice.FieldLastSeenAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.UserDevice, 0, len(devices))
+	for _, device := range devices {
+		result = append(result, toDomainUserDevice(device, userID))
+	}
+	return result, nil
+}
+
+// Touch records another login from the device; trusted only ever upgrades the device
+func (r *userDeviceRepository) Touch(ctx context.Context, id int64, ipAddress, userAgent string, trusted bool) error {
+	update := r.client.UserDevice.
+		UpdateOneID(id).
+		SetIPAddress(ipAddress).
+		SetUserAgent(userAgent).
+		SetLastSeenAt(time.Now())
+	if trusted {
+		update = update.SetTrusted(true)
+	}
+	return update.Exec(ctx)
+}
+
+// SetTrusted updates the trust flag of one of the user's devices and reports whether it exists
+func (r *userDeviceRepository) SetTrusted(ctx context.Context, userID, id int64, trusted bool) (bool, error) {
+	n, err := r.client.UserDevice.
+		Update().
+		Where(
+			userdevice.IDEQ(id),
+			userdevice.HasUserWith(user.IDEQ(userID)),
+		).
+		SetTrusted(trusted).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Delete forgets one of the user's devices and reports whether it existed
+func (r *userDeviceRepository) Delete(ctx context.Context, userID, id int64) (bool, error) {
+	n, err := r.client.UserDevice.
+		Delete().
+		Where(
+			userdevice.IDEQ(id),
+			userdevice.HasUserWith(user.IDEQ(userID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func toDomainUserDevice(device *ent.UserDevice, userID int64) *domain.UserDevice {
+	return &domain.UserDevice{
+		ID:         device.ID,
+		UserID:     userID,
+		ClientID:   device.ClientID,
+		Trusted:    device.Trusted,
+		IPAddress:  device.IPAddress,
+		UserAgent:  device.UserAgent,
+		CreatedAt:  device.CreatedAt,
+		LastSeenAt: device.LastSeenAt,
+	}
+}
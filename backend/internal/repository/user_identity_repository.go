@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/keu-5/muzee/backend/ent"
+	"github.com/keu-5/muzee/backend/ent/user"
+	"github.com/keu-5/muzee/backend/ent/useridentity"
+	"github.com/keu-5/muzee/backend/internal/domain"
+)
+
+type UserIdentityRepository interface {
+	Create(ctx context.Context, userID int64, provider, subject string, email *string) (*domain.UserIdentity, error)
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*domain.UserIdentity, error)
+}
+
+type userIdentityRepository struct {
+	client *ent.Client
+}
+
+func NewUserIdentityRepository(client *ent.Client) UserIdentityRepository {
+	return &userIdentityRepository{client: client}
+}
+
+func (r *userIdentityRepository) Create(ctx context.Context, userID int64, provider, subject string, email *string) (*domain.UserIdentity, error) {
+	identity, err := r.client.UserIdentity.Create().
+		SetUserID(userID).
+		SetProvider(provider).
+		SetSubject(subject).
+		SetNillableEmail(email).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.UserIdentity{
+		ID:        identity.ID,
+		UserID:    userID,
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		Email:     identity.Email,
+		CreatedAt: identity.CreatedAt,
+		UpdatedAt: identity.UpdatedAt,
+	}, nil
+}
+
+func (r *userIdentityRepository) GetByProviderSubject(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	identity, err := r.client.UserIdentity.
+		Query().
+		Where(
+			useridentity.ProviderEQ(provider),
+			useridentity.SubjectEQ(subject),
+		).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &domain.UserIdentity{
+		ID:        identity.ID,
+		UserID:    identity.Edges.User.ID,
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		Email:     identity.Email,
+		CreatedAt: identity.CreatedAt,
+		UpdatedAt: identity.UpdatedAt,
+	}, nil
+}
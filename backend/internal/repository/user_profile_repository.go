@@ -4,16 +4,21 @@ import (
 	"context"
 
 	"github.com/keu-5/muzee/backend/ent"
+	"github.com/keu-5/muzee/backend/ent/predicate"
 	"github.com/keu-5/muzee/backend/ent/user"
 	"github.com/keu-5/muzee/backend/ent/userprofile"
 	"github.com/keu-5/muzee/backend/internal/domain"
 )
 
 type UserProfileRepository interface {
-	Create(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error)
+	Create(ctx context.Context, userID int64, name string, username string, iconPath *string, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error)
 	GetByUserID(ctx context.Context, userID int64) (*domain.UserProfile, error)
+	GetByUsername(ctx context.Context, username string) (*domain.UserProfile, error)
+	Update(ctx context.Context, userID int64, update domain.UserProfileUpdate) (*domain.UserProfile, error)
 	ExistsByUserID(ctx context.Context, userID int64) (bool, error)
 	ExistsByUsername(ctx context.Context, username string) (bool, error)
+	FindUsedUsernameSkeletons(ctx context.Context, skeletons []string, excludeUserID int64) ([]string, error)
+	DeleteByUserID(ctx context.Context, userID int64) error
 }
 
 type userProfileRepository struct {
@@ -24,44 +29,159 @@ func NewUserProfileRepository(client *ent.Client) UserProfileRepository {
 	return &userProfileRepository{client: client}
 }
 
-func (r *userProfileRepository) Create(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error) {
-	profile, err := r.client.UserProfile.Create().
+func (r *userProfileRepository) Create(ctx context.Context, userID int64, name string, username string, iconPath *string, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error) {
+	builder := r.client.UserProfile.Create().
 		SetUserID(userID).
 		SetName(name).
 		SetUsername(username).
+		SetUsernameCanonical(domain.CanonicalUsername(username)).
+		SetUsernameSkeleton(domain.UsernameSkeleton(username)).
 		SetNillableIconPath(iconPath).
-		Save(ctx)
+		SetNillableBannerPath(bannerPath)
+
+	// 空の値は未設定として扱う
+	if details.Bio != nil && *details.Bio != "" {
+		builder.SetBio(*details.Bio)
+	}
+	if details.Links != nil && len(*details.Links) > 0 {
+		builder.SetLinks(*details.Links)
+	}
+	if details.Location != nil && *details.Location != "" {
+		builder.SetLocation(*details.Location)
+	}
+	if details.Birthday != nil && !details.Birthday.IsZero() {
+		builder.SetBirthday(*details.Birthday)
+	}
+	if details.Pronouns != nil && *details.Pronouns != "" {
+		builder.SetPronouns(*details.Pronouns)
+	}
+	if len(details.Visibility) > 0 {
+		builder.SetFieldVisibility(mergeFieldVisibility(nil, details.Visibility))
+	}
+
+	profile, err := builder.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &domain.UserProfile{
-		ID:        profile.ID,
-		Name:      profile.Name,
-		Username:  profile.Username,
-		IconPath:  profile.IconPath,
-		CreatedAt: profile.CreatedAt,
-		UpdatedAt: profile.UpdatedAt,
-	}, nil
+	return toDomainUserProfile(profile, userID), nil
 }
 
 func (r *userProfileRepository) GetByUserID(ctx context.Context, userID int64) (*domain.UserProfile, error) {
-	{
+	profile, err := r.client.UserProfile.
+		Query().
+		Where(userprofile.HasUserWith(user.ID(userID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainUserProfile(profile, userID), nil
+}
+
+// GetByUsername looks up a profile case-insensitively; profiles whose canonical username
+// could not be backfilled are still found by an exact match
+func (r *userProfileRepository) GetByUsername(ctx context.Context, username string) (*domain.UserProfile, error) {
+	for _, where := range []predicate.UserProfile{
+		userprofile.UsernameCanonicalEQ(domain.CanonicalUsername(username)),
+		userprofile.UsernameEQ(username),
+	} {
 		profile, err := r.client.UserProfile.
 			Query().
-			Where(userprofile.HasUserWith(user.ID(userID))).
+			Where(where).
+			WithUser().
 			Only(ctx)
 		if err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
 			return nil, err
 		}
-		return &domain.UserProfile{
-			ID:        profile.ID,
-			Name:      profile.Name,
-			Username:  profile.Username,
-			IconPath:  profile.IconPath,
-			CreatedAt: profile.CreatedAt,
-			UpdatedAt: profile.UpdatedAt,
-		}, nil
+		return toDomainUserProfile(profile, profile.Edges.User.ID), nil
+	}
+	return nil, nil
+}
+
+// Update applies a partial update and returns the updated profile, or nil if the user has no profile
+func (r *userProfileRepository) Update(ctx context.Context, userID int64, update domain.UserProfileUpdate) (*domain.UserProfile, error) {
+	profile, err := r.client.UserProfile.
+		Query().
+		Where(userprofile.HasUserWith(user.ID(userID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	builder := profile.Update()
+	if update.Name != nil {
+		builder.SetName(*update.Name)
+	}
+	if update.Username != nil {
+		builder.SetUsername(*update.Username).
+			SetUsernameCanonical(domain.CanonicalUsername(*update.Username)).
+			SetUsernameSkeleton(domain.UsernameSkeleton(*update.Username))
+	}
+	if update.ClearIcon {
+		builder.ClearIconPath()
+	} else if update.IconPath != nil {
+		builder.SetIconPath(*update.IconPath)
+	}
+	if update.ClearBanner {
+		builder.ClearBannerPath()
+	} else if update.BannerPath != nil {
+		builder.SetBannerPath(*update.BannerPath)
+	}
+
+	// 空の値は項目の削除として扱う
+	details := update.Details
+	if details.Bio != nil {
+		if *details.Bio == "" {
+			builder.ClearBio()
+		} else {
+			builder.SetBio(*details.Bio)
+		}
 	}
+	if details.Links != nil {
+		if len(*details.Links) == 0 {
+			builder.ClearLinks()
+		} else {
+			builder.SetLinks(*details.Links)
+		}
+	}
+	if details.Location != nil {
+		if *details.Location == "" {
+			builder.ClearLocation()
+		} else {
+			builder.SetLocation(*details.Location)
+		}
+	}
+	if details.Birthday != nil {
+		if details.Birthday.IsZero() {
+			builder.ClearBirthday()
+		} else {
+			builder.SetBirthday(*details.Birthday)
+		}
+	}
+	if details.Pronouns != nil {
+		if *details.Pronouns == "" {
+			builder.ClearPronouns()
+		} else {
+			builder.SetPronouns(*details.Pronouns)
+		}
+	}
+	if len(details.Visibility) > 0 {
+		builder.SetFieldVisibility(mergeFieldVisibility(profile.FieldVisibility, details.Visibility))
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainUserProfile(updated, userID), nil
 }
 
 func (r *userProfileRepository) ExistsByUserID(ctx context.Context, userID int64) (bool, error) {
@@ -75,13 +195,80 @@ func (r *userProfileRepository) ExistsByUserID(ctx context.Context, userID int64
 	return exists, nil
 }
 
+// ExistsByUsername reports whether a profile uses the username, ignoring case
 func (r *userProfileRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
 	exists, err := r.client.UserProfile.
 		Query().
-		Where(userprofile.UsernameEQ(username)).
+		Where(userprofile.Or(
+			userprofile.UsernameCanonicalEQ(domain.CanonicalUsername(username)),
+			userprofile.UsernameEQ(username),
+		)).
 		Exist(ctx)
 	if err != nil {
 		return false, err
 	}
 	return exists, nil
 }
+
+// FindUsedUsernameSkeletons returns which of the skeletons belong to profiles other than excludeUserID's
+func (r *userProfileRepository) FindUsedUsernameSkeletons(ctx context.Context, skeletons []string, excludeUserID int64) ([]string, error) {
+	if len(skeletons) == 0 {
+		return nil, nil
+	}
+	used, err := r.client.UserProfile.
+		Query().
+		Where(
+			userprofile.UsernameSkeletonIn(skeletons...),
+			userprofile.Not(userprofile.HasUserWith(user.ID(excludeUserID))),
+		).
+		Select(userprofile.FieldUsernameSkeleton).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return used, nil
+}
+
+func (r *userProfileRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	_, err := r.client.UserProfile.
+		Delete().
+		Where(userprofile.HasUserWith(user.ID(userID))).
+		Exec(ctx)
+	return err
+}
+
+// mergeFieldVisibility returns the stored visibility map with the changes applied
+func mergeFieldVisibility(current map[string]string, changes map[domain.ProfileField]domain.Visibility) map[string]string {
+	merged := make(map[string]string, len(current)+len(changes))
+	for field, visibility := range current {
+		merged[field] = visibility
+	}
+	for field, visibility := range changes {
+		merged[string(field)] = string(visibility)
+	}
+	return merged
+}
+
+func toDomainUserProfile(profile *ent.UserProfile, userID int64) *domain.UserProfile {
+	visibility := make(map[domain.ProfileField]domain.Visibility, len(profile.FieldVisibility))
+	for field, v := range profile.FieldVisibility {
+		visibility[domain.ProfileField(field)] = domain.Visibility(v)
+	}
+
+	return &domain.UserProfile{
+		ID:         profile.ID,
+		UserID:     userID,
+		Name:       profile.Name,
+		Username:   profile.Username,
+		IconPath:   profile.IconPath,
+		BannerPath: profile.BannerPath,
+		Bio:        profile.Bio,
+		Links:      profile.Links,
+		Location:   profile.Location,
+		Birthday:   profile.Birthday,
+		Pronouns:   profile.Pronouns,
+		Visibility: visibility,
+		CreatedAt:  profile.CreatedAt,
+		UpdatedAt:  profile.UpdatedAt,
+	}
+}
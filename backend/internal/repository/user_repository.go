@@ -2,9 +2,13 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/keu-5/muzee/backend/ent"
+	"github.com/keu-5/muzee/backend/ent/personalaccesstoken"
 	"github.com/keu-5/muzee/backend/ent/user"
+	"github.com/keu-5/muzee/backend/ent/userdevice"
+	"github.com/keu-5/muzee/backend/ent/useridentity"
 	"github.com/keu-5/muzee/backend/internal/domain"
 )
 
@@ -16,6 +20,17 @@ type UserRepository interface {
 	Create(ctx context.Context, email, passwordHash string) (*domain.User, error)
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
 	GetByID(ctx context.Context, id int64) (*domain.User, error)
+	UpdatePasswordHash(ctx context.Context, id int64, passwordHash string) error
+	UpdateEmail(ctx context.Context, id int64, email string) error
+	SetPendingTOTPSecret(ctx context.Context, id int64, encryptedSecret string) error
+	EnableTOTP(ctx context.Context, id int64, usedStep int64, recoveryCodeHashes []string) error
+	DisableTOTP(ctx context.Context, id int64) error
+	ConsumeTOTPStep(ctx context.Context, id int64, step int64) (bool, error)
+	UpdateRecoveryCodeHashes(ctx context.Context, id int64, recoveryCodeHashes []string) error
+	ScheduleDeletion(ctx context.Context, id int64, at time.Time) error
+	CancelDeletion(ctx context.Context, id int64) error
+	ListDueForDeletion(ctx context.Context, before time.Time, limit int) ([]*domain.User, error)
+	Delete(ctx context.Context, id int64) error
 }
 
 type userRepository struct {
@@ -47,6 +62,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.
 	u, err := r.client.User.
 		Query().
 		Where(user.EmailEQ(email)).
+		WithRoles().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -56,11 +72,17 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.
 	}
 
 	return &domain.User{
-		ID:           u.ID,
-		Email:        u.Email,
-		PasswordHash: u.PasswordHash,
-		CreatedAt:    u.CreatedAt,
-		UpdatedAt:    u.UpdatedAt,
+		ID:                     u.ID,
+		Email:                  u.Email,
+		PasswordHash:           u.PasswordHash,
+		TOTPSecret:             u.TotpSecret,
+		TOTPEnabled:            u.TotpEnabled,
+		TOTPLastUsedStep:       u.TotpLastUsedStep,
+		TOTPRecoveryCodeHashes: u.TotpRecoveryCodeHashes,
+		DeletionScheduledAt:    u.DeletionScheduledAt,
+		Roles:                  toDomainRoles(u.Edges.Roles),
+		CreatedAt:              u.CreatedAt,
+		UpdatedAt:              u.UpdatedAt,
 	}, nil
 }
 
@@ -68,6 +90,7 @@ func (r *userRepository) GetByID(ctx context.Context, id int64) (*domain.User, e
 	u, err := r.client.User.
 		Query().
 		Where(user.IDEQ(id)).
+		WithRoles().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -77,10 +100,160 @@ func (r *userRepository) GetByID(ctx context.Context, id int64) (*domain.User, e
 	}
 
 	return &domain.User{
-		ID:           u.ID,
-		Email:        u.Email,
-		PasswordHash: u.PasswordHash,
-		CreatedAt:    u.CreatedAt,
-		UpdatedAt:    u.UpdatedAt,
+		ID:                     u.ID,
+		Email:                  u.Email,
+		PasswordHash:           u.PasswordHash,
+		TOTPSecret:             u.TotpSecret,
+		TOTPEnabled:            u.TotpEnabled,
+		TOTPLastUsedStep:       u.TotpLastUsedStep,
+		TOTPRecoveryCodeHashes: u.TotpRecoveryCodeHashes,
+		DeletionScheduledAt:    u.DeletionScheduledAt,
+		Roles:                  toDomainRoles(u.Edges.Roles),
+		CreatedAt:              u.CreatedAt,
+		UpdatedAt:              u.UpdatedAt,
 	}, nil
 }
+
+func (r *userRepository) UpdatePasswordHash(ctx context.Context, id int64, passwordHash string) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetPasswordHash(passwordHash).
+		Exec(ctx)
+}
+
+func (r *userRepository) UpdateEmail(ctx context.Context, id int64, email string) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetEmail(email).
+		Exec(ctx)
+}
+
+// SetPendingTOTPSecret stores a new secret that is not active until EnableTOTP is called
+func (r *userRepository) SetPendingTOTPSecret(ctx context.Context, id int64, encryptedSecret string) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetTotpSecret(encryptedSecret).
+		SetTotpEnabled(false).
+		SetTotpLastUsedStep(0).
+		ClearTotpRecoveryCodeHashes().
+		Exec(ctx)
+}
+
+func (r *userRepository) EnableTOTP(ctx context.Context, id int64, usedStep int64, recoveryCodeHashes []string) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetTotpEnabled(true).
+		SetTotpLastUsedStep(usedStep).
+		SetTotpRecoveryCodeHashes(recoveryCodeHashes).
+		Exec(ctx)
+}
+
+func (r *userRepository) DisableTOTP(ctx context.Context, id int64) error {
+	return r.client.User.
+		UpdateOneID(id).
+		ClearTotpSecret().
+		SetTotpEnabled(false).
+		SetTotpLastUsedStep(0).
+		ClearTotpRecoveryCodeHashes().
+		Exec(ctx)
+}
+
+// ConsumeTOTPStep records step as used and reports false if it (or a later step) was already used
+func (r *userRepository) ConsumeTOTPStep(ctx context.Context, id int64, step int64) (bool, error) {
+	n, err := r.client.User.
+		Update().
+		Where(user.IDEQ(id), user.TotpLastUsedStepLT(step)).
+		SetTotpLastUsedStep(step).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (r *userRepository) UpdateRecoveryCodeHashes(ctx context.Context, id int64, recoveryCodeHashes []string) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetTotpRecoveryCodeHashes(recoveryCodeHashes).
+		Exec(ctx)
+}
+
+func (r *userRepository) ScheduleDeletion(ctx context.Context, id int64, at time.Time) error {
+	return r.client.User.
+		UpdateOneID(id).
+		SetDeletionScheduledAt(at).
+		Exec(ctx)
+}
+
+// CancelDeletion clears a scheduled deletion; it is a no-op when none is scheduled
+func (r *userRepository) CancelDeletion(ctx context.Context, id int64) error {
+	return r.client.User.
+		Update().
+		Where(
+			user.IDEQ(id),
+			user.DeletionScheduledAtNotNil(),
+		).
+		ClearDeletionScheduledAt().
+		Exec(ctx)
+}
+
+// ListDueForDeletion returns users whose grace period ended before the given time, oldest first
+func (r *userRepository) ListDueForDeletion(ctx context.Context, before time.Time, limit int) ([]*domain.User, error) {
+	users, err := r.client.User.
+		Query().
+		Where(user.DeletionScheduledAtLTE(before)).
+		Order(ent.Asc(user.FieldDeletionScheduledAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.User, 0, len(users))
+	for _, u := range users {
+		result = append(result, &domain.User{
+			ID:                  u.ID,
+			Email:               u.Email,
+			DeletionScheduledAt: u.DeletionScheduledAt,
+			CreatedAt:           u.CreatedAt,
+			UpdatedAt:           u.UpdatedAt,
+		})
+	}
+	return result, nil
+}
+
+// Delete removes the user together with the linked OIDC identities, devices and personal access tokens
+func (r *userRepository) Delete(ctx context.Context, id int64) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.UserIdentity.
+		Delete().
+		Where(useridentity.HasUserWith(user.IDEQ(id))).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.UserDevice.
+		Delete().
+		Where(userdevice.HasUserWith(user.IDEQ(id))).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.PersonalAccessToken.
+		Delete().
+		Where(personalaccesstoken.HasUserWith(user.IDEQ(id))).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.User.DeleteOneID(id).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/keu-5/muzee/backend/config"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/repository"
+)
+
+// accountDeletionBatchSize caps how many accounts a single purge run deletes
+const accountDeletionBatchSize = 100
+
+// auditDetailMaxLen matches the column length of AccountDeletionAudit.detail
+const auditDetailMaxLen = 1000
+
+// FileDeleter removes uploaded objects; implemented by infrastructure.StorageService
+type FileDeleter interface {
+	DeleteFile(ctx context.Context, bucketName string, objectName string) error
+}
+
+// SessionRevoker ends every login of a user; implemented by helper.SessionHelper
+type SessionRevoker interface {
+	RevokeAllSessions(ctx context.Context, userID int64) error
+}
+
+type AccountDeletionUsecase interface {
+	ScheduleDeletion(ctx context.Context, userID int64) (time.Time, error)
+	PurgeDueAccounts(ctx context.Context) (int, error)
+}
+
+type accountDeletionUsecase struct {
+	userRepo        repository.UserRepository
+	userProfileRepo repository.UserProfileRepository
+	auditRepo       repository.AccountDeletionAuditRepository
+	fileDeleter     FileDeleter
+	sessionRevoker  SessionRevoker
+	cfg             *config.Config
+	now             func() time.Time
+}
+
+func NewAccountDeletionUsecase(
+	userRepo repository.UserRepository,
+	userProfileRepo repository.UserProfileRepository,
+	auditRepo repository.AccountDeletionAuditRepository,
+	fileDeleter FileDeleter,
+	sessionRevoker SessionRevoker,
+	cfg *config.Config,
+) AccountDeletionUsecase {
+	return &accountDeletionUsecase{
+		userRepo:        userRepo,
+		userProfileRepo: userProfileRepo,
+		auditRepo:       auditRepo,
+		fileDeleter:     fileDeleter,
+		sessionRevoker:  sessionRevoker,
+		cfg:             cfg,
+		now:             time.Now,
+	}
+}
+
+// ScheduleDeletion marks the account for deletion once the grace period has passed and returns that time
+func (u *accountDeletionUsecase) ScheduleDeletion(ctx context.Context, userID int64) (time.Time, error) {
+	scheduledAt := u.now().Add(u.cfg.AccountDeletionGracePeriod)
+	if err := u.userRepo.ScheduleDeletion(ctx, userID, scheduledAt); err != nil {
+		return time.Time{}, err
+	}
+
+	detail := scheduledAt.UTC().Format(time.RFC3339)
+	if err := u.record(ctx, userID, domain.AccountDeletionStepScheduled, &detail, nil); err != nil {
+		return time.Time{}, err
+	}
+	return scheduledAt, nil
+}
+
+// PurgeDueAccounts deletes every account whose grace period has ended and returns how many were deleted.
+// A failed account is left scheduled so the next run retries it from the failed step.
+func (u *accountDeletionUsecase) PurgeDueAccounts(ctx context.Context) (int, error) {
+	users, err := u.userRepo.ListDueForDeletion(ctx, u.now(), accountDeletionBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	var errs []error
+	for _, user := range users {
+		deleted, err := u.purgeAccount(ctx, user.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", user.ID, err))
+			continue
+		}
+		if deleted {
+			purged++
+		}
+	}
+	return purged, errors.Join(errs...)
+}
+
+// purgeAccount deletes the account unless the deletion was cancelled in the meantime, and reports whether it did
+func (u *accountDeletionUsecase) purgeAccount(ctx context.Context, userID int64) (bool, error) {
+	// 一覧取得後に再ログインで取り消されていないか確認
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if user == nil || user.DeletionScheduledAt == nil || user.DeletionScheduledAt.After(u.now()) {
+		return false, nil
+	}
+
+	// 1. 全セッションを失効
+	err = u.sessionRevoker.RevokeAllSessions(ctx, userID)
+	if err := u.record(ctx, userID, domain.AccountDeletionStepSessions, nil, err); err != nil {
+		return false, err
+	}
+
+	// 2. アイコン画像とプロフィールを削除
+	hasProfile, err := u.userProfileRepo.ExistsByUserID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if hasProfile {
+		profile, err := u.userProfileRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			return false, err
+		}
+
+		// プロフィールより先に削除し、参照先のないパスが残らないようにする
+		if profile != nil && profile.IconPath != nil {
+			err = u.deleteObjects(ctx, domain.IconObjectNames(profile.IconPath))
+			if err := u.record(ctx, userID, domain.AccountDeletionStepIcon, profile.IconPath, err); err != nil {
+				return false, err
+			}
+		}
+		if profile != nil && profile.BannerPath != nil {
+			err = u.deleteObjects(ctx, domain.BannerObjectNames(profile.BannerPath))
+			if err := u.record(ctx, userID, domain.AccountDeletionStepBanner, profile.BannerPath, err); err != nil {
+				return false, err
+			}
+		}
+
+		err = u.userProfileRepo.DeleteByUserID(ctx, userID)
+		if err := u.record(ctx, userID, domain.AccountDeletionStepProfile, nil, err); err != nil {
+			return false, err
+		}
+	}
+
+	// 3. ユーザーを削除
+	err = u.userRepo.Delete(ctx, userID)
+	if err := u.record(ctx, userID, domain.AccountDeletionStepUser, nil, err); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// deleteObjects removes every object of an image and returns the joined errors
+func (u *accountDeletionUsecase) deleteObjects(ctx context.Context, objectNames []string) error {
+	var errs []error
+	for _, objectName := range objectNames {
+		errs = append(errs, u.fileDeleter.DeleteFile(ctx, u.cfg.S3PublicBucket, objectName))
+	}
+	return errors.Join(errs...)
+}
+
+// record writes the audit entry for a step and returns the step error, or the audit error if it could not be written
+func (u *accountDeletionUsecase) record(ctx context.Context, userID int64, step domain.AccountDeletionStep, detail *string, stepErr error) error {
+	if stepErr != nil {
+		message := stepErr.Error()
+		if detail != nil {
+			message = *detail + ": " + message
+		}
+		if len(message) > auditDetailMaxLen {
+			message = message[:auditDetailMaxLen]
+		}
+		detail = &message
+	}
+
+	if _, err := u.auditRepo.Create(ctx, userID, step, stepErr == nil, detail); err != nil {
+		return errors.Join(stepErr, fmt.Errorf("failed to record %s audit: %w", step, err))
+	}
+	return stepErr
+}
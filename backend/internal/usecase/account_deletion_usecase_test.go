@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/keu-5/muzee/backend/config"
+	"github.com/keu-5/muzee/backend/internal/domain"
+)
+
+// Mock AccountDeletionAuditRepository
+type mockAccountDeletionAuditRepository struct {
+	audits []*domain.AccountDeletionAudit
+}
+
+func (m *mockAccountDeletionAuditRepository) Create(ctx context.Context, userID int64, step domain.AccountDeletionStep, succeeded bool, detail *string) (*domain.AccountDeletionAudit, error) {
+	audit := &domain.AccountDeletionAudit{
+		ID:        int64(len(m.audits) + 1),
+		UserID:    userID,
+		Step:      step,
+		Succeeded: succeeded,
+		Detail:    detail,
+		CreatedAt: time.Now(),
+	}
+	m.audits = append(m.audits, audit)
+	return audit, nil
+}
+
+func (m *mockAccountDeletionAuditRepository) steps() []string {
+	steps := make([]string, 0, len(m.audits))
+	for _, audit := range m.audits {
+		status := "ok"
+		if !audit.Succeeded {
+			status = "failed"
+		}
+		steps = append(steps, string(audit.Step)+":"+status)
+	}
+	return steps
+}
+
+// Mock FileDeleter
+type mockFileDeleter struct {
+	deleteFileFunc func(ctx context.Context, bucketName string, objectName string) error
+}
+
+func (m *mockFileDeleter) DeleteFile(ctx context.Context, bucketName string, objectName string) error {
+	if m.deleteFileFunc != nil {
+		return m.deleteFileFunc(ctx, bucketName, objectName)
+	}
+	return nil
+}
+
+// Mock SessionRevoker
+type mockSessionRevoker struct {
+	revokedUserIDs []int64
+}
+
+func (m *mockSessionRevoker) RevokeAllSessions(ctx context.Context, userID int64) error {
+	m.revokedUserIDs = append(m.revokedUserIDs, userID)
+	return nil
+}
+
+func newTestAccountDeletionConfig() *config.Config {
+	return &config.Config{
+		S3PublicBucket:             "public-uploads",
+		AccountDeletionGracePeriod: 72 * time.Hour,
+	}
+}
+
+func TestScheduleDeletion(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	var gotID int64
+	var gotAt time.Time
+	userRepo := &mockUserRepository{
+		scheduleDeletionFunc: func(ctx context.Context, id int64, at time.Time) error {
+			gotID = id
+			gotAt = at
+			return nil
+		},
+	}
+	auditRepo := &mockAccountDeletionAuditRepository{}
+
+	uc := NewAccountDeletionUsecase(userRepo, &mockUserProfileRepository{}, auditRepo, &mockFileDeleter{}, &mockSessionRevoker{}, newTestAccountDeletionConfig()).(*accountDeletionUsecase)
+	uc.now = func() time.Time { return now }
+
+	scheduledAt, err := uc.ScheduleDeletion(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("ScheduleDeletion() error = %v", err)
+	}
+
+	want := now.Add(72 * time.Hour)
+	if !scheduledAt.Equal(want) || !gotAt.Equal(want) {
+		t.Errorf("Expected deletion at %v, got %v (stored %v)", want, scheduledAt, gotAt)
+	}
+	if gotID != 7 {
+		t.Errorf("Expected user ID 7, got %d", gotID)
+	}
+	if steps := auditRepo.steps(); !reflect.DeepEqual(steps, []string{"scheduled:ok"}) {
+		t.Errorf("Unexpected audit steps: %v", steps)
+	}
+}
+
+func TestPurgeDueAccounts(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	due := now.Add(-time.Minute)
+	iconPath := "user-icons/user_1/icon.png"
+	iconPrefix := "user-icons/user_1/3f1c2d4e"
+	bannerPrefix := "user-banners/user_1/9a8b7c6d"
+
+	tests := []struct {
+		name          string
+		scheduledAt   *time.Time
+		iconPath      *string
+		bannerPath    *string
+		hasProfile    bool
+		deleteFileErr error
+		wantPurged    int
+		wantErr       bool
+		wantSteps     []string
+		wantDeleted   bool
+	}{
+		{
+			name:        "profile with icon",
+			scheduledAt: &due,
+			iconPath:    &iconPath,
+			hasProfile:  true,
+			wantPurged:  1,
+			wantSteps:   []string{"sessions:ok", "icon:ok", "profile:ok", "user:ok"},
+			wantDeleted: true,
+		},
+		{
+			name:        "profile with icon renditions",
+			scheduledAt: &due,
+			iconPath:    &iconPrefix,
+			hasProfile:  true,
+			wantPurged:  1,
+			wantSteps:   []string{"sessions:ok", "icon:ok", "profile:ok", "user:ok"},
+			wantDeleted: true,
+		},
+		{
+			name:        "profile with icon and banner",
+			scheduledAt: &due,
+			iconPath:    &iconPrefix,
+			bannerPath:  &bannerPrefix,
+			hasProfile:  true,
+			wantPurged:  1,
+			wantSteps:   []string{"sessions:ok", "icon:ok", "banner:ok", "profile:ok", "user:ok"},
+			wantDeleted: true,
+		},
+		{
+			name:        "no profile",
+			scheduledAt: &due,
+			wantPurged:  1,
+			wantSteps:   []string{"sessions:ok", "user:ok"},
+			wantDeleted: true,
+		},
+		{
+			name:          "icon deletion failure stops before the profile is removed",
+			scheduledAt:   &due,
+			iconPath:      &iconPath,
+			hasProfile:    true,
+			deleteFileErr: errors.New("storage unavailable"),
+			wantPurged:    0,
+			wantErr:       true,
+			wantSteps:     []string{"sessions:ok", "icon:failed"},
+			wantDeleted:   false,
+		},
+		{
+			name:        "cancelled by login after listing",
+			scheduledAt: nil,
+			hasProfile:  true,
+			wantPurged:  0,
+			wantSteps:   []string{},
+			wantDeleted: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deletedUser, deletedProfile bool
+			var deletedObjects []string
+
+			userRepo := &mockUserRepository{
+				listDueForDeletionFunc: func(ctx context.Context, before time.Time, limit int) ([]*domain.User, error) {
+					if !before.Equal(now) {
+						t.Errorf("Expected due accounts before %v, got %v", now, before)
+					}
+					return []*domain.User{{ID: 1, DeletionScheduledAt: &due}}, nil
+				},
+				getByIDFunc: func(ctx context.Context, id int64) (*domain.User, error) {
+					return &domain.User{ID: id, DeletionScheduledAt: tt.scheduledAt}, nil
+				},
+				deleteFunc: func(ctx context.Context, id int64) error {
+					deletedUser = true
+					return nil
+				},
+			}
+			profileRepo := &mockUserProfileRepository{
+				existsByUserIDFunc: func(ctx context.Context, userID int64) (bool, error) {
+					return tt.hasProfile, nil
+				},
+				getByUserIDFunc: func(ctx context.Context, userID int64) (*domain.UserProfile, error) {
+					return &domain.UserProfile{ID: 1, UserID: userID, IconPath: tt.iconPath, BannerPath: tt.bannerPath}, nil
+				},
+				deleteByUserIDFunc: func(ctx context.Context, userID int64) error {
+					deletedProfile = true
+					return nil
+				},
+			}
+			fileDeleter := &mockFileDeleter{
+				deleteFileFunc: func(ctx context.Context, bucketName string, objectName string) error {
+					if bucketName != "public-uploads" {
+						t.Errorf("Expected public bucket, got %s", bucketName)
+					}
+					deletedObjects = append(deletedObjects, objectName)
+					return tt.deleteFileErr
+				},
+			}
+			auditRepo := &mockAccountDeletionAuditRepository{}
+			revoker := &mockSessionRevoker{}
+
+			uc := NewAccountDeletionUsecase(userRepo, profileRepo, auditRepo, fileDeleter, revoker, newTestAccountDeletionConfig()).(*accountDeletionUsecase)
+			uc.now = func() time.Time { return now }
+
+			purged, err := uc.PurgeDueAccounts(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PurgeDueAccounts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if purged != tt.wantPurged {
+				t.Errorf("Expected %d purged, got %d", tt.wantPurged, purged)
+			}
+			if steps := auditRepo.steps(); !reflect.DeepEqual(steps, tt.wantSteps) {
+				t.Errorf("Expected audit steps %v, got %v", tt.wantSteps, steps)
+			}
+			if deletedUser != tt.wantDeleted {
+				t.Errorf("Expected user deleted = %v, got %v", tt.wantDeleted, deletedUser)
+			}
+			if tt.deleteFileErr != nil && deletedProfile {
+				t.Error("Expected profile to be kept when the icon could not be deleted")
+			}
+			wantObjects := append(domain.IconObjectNames(tt.iconPath), domain.BannerObjectNames(tt.bannerPath)...)
+			if tt.iconPath != nil && tt.scheduledAt != nil && !reflect.DeepEqual(deletedObjects, wantObjects) {
+				t.Errorf("Expected objects %v to be deleted, got %v", wantObjects, deletedObjects)
+			}
+			if tt.scheduledAt == nil && len(revoker.revokedUserIDs) != 0 {
+				t.Error("Expected sessions to be kept for a cancelled deletion")
+			}
+		})
+	}
+}
@@ -3,33 +3,58 @@ package usecase
 import (
 	"context"
 
-	"golang.org/x/crypto/bcrypt"
+	"github.com/keu-5/muzee/backend/config"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/util"
 )
 
 type AuthUsecase interface {
 	HashPassword(password string) (string, error)
 	VerifyPassword(password, hash string) error
+	RehashPasswordIfNeeded(ctx context.Context, user *domain.User, password string) error
 	CheckEmailExists(ctx context.Context, email string) (bool, error)
 }
 
 type authUsecase struct {
-	userUC UserUsecase
+	userUC     UserUsecase
+	hashParams util.Argon2Params
 }
 
-func NewAuthUsecase(userUC UserUsecase) AuthUsecase {
-	return &authUsecase{userUC: userUC}
+func NewAuthUsecase(userUC UserUsecase, cfg *config.Config) AuthUsecase {
+	return &authUsecase{
+		userUC: userUC,
+		hashParams: util.Argon2Params{
+			Memory:      cfg.Argon2Memory,
+			Iterations:  cfg.Argon2Iterations,
+			Parallelism: cfg.Argon2Parallelism,
+		},
+	}
 }
 
 func (a *authUsecase) HashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), 10)
-	if err != nil {
-		return "", err
-	}
-	return string(bytes), nil
+	return util.HashPassword(password, a.hashParams)
 }
 
 func (a *authUsecase) VerifyPassword(password, hash string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	return util.VerifyPassword(password, hash)
+}
+
+// RehashPasswordIfNeeded replaces a bcrypt hash or an Argon2id hash with outdated parameters.
+// The password must already have been verified against user.PasswordHash.
+func (a *authUsecase) RehashPasswordIfNeeded(ctx context.Context, user *domain.User, password string) error {
+	if !util.PasswordHashNeedsRehash(user.PasswordHash, a.hashParams) {
+		return nil
+	}
+
+	passwordHash, err := a.HashPassword(password)
+	if err != nil {
+		return err
+	}
+	if err := a.userUC.UpdatePassword(ctx, user.ID, passwordHash); err != nil {
+		return err
+	}
+	user.PasswordHash = passwordHash
+	return nil
 }
 
 func (a *authUsecase) CheckEmailExists(ctx context.Context, email string) (bool, error) {
@@ -3,19 +3,32 @@ package usecase
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/keu-5/muzee/backend/config"
 	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/util"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// testPasswordHashConfig keeps Argon2id cheap so the tests stay fast
+var testPasswordHashConfig = &config.Config{
+	Argon2Memory:      1024,
+	Argon2Iterations:  1,
+	Argon2Parallelism: 1,
+}
+
 // Mock UserUsecase
 type mockUserUsecase struct {
 	getUserByEmailFunc         func(ctx context.Context, email string) (*domain.User, error)
 	getUserByIDFunc            func(ctx context.Context, id int64) (*domain.User, error)
 	createUserFunc             func(ctx context.Context, email, passwordHash string) (*domain.User, error)
 	checkUserProfileExistsFunc func(ctx context.Context, userID int64) (bool, error)
+	updatePasswordFunc         func(ctx context.Context, userID int64, passwordHash string) error
+	updateEmailFunc            func(ctx context.Context, userID int64, email string) error
+	cancelAccountDeletionFunc  func(ctx context.Context, userID int64) error
 }
 
 func (m *mockUserUsecase) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
@@ -52,9 +65,30 @@ func (m *mockUserUsecase) CheckUserProfileExists(ctx context.Context, userID int
 	return false, nil
 }
 
+func (m *mockUserUsecase) UpdatePassword(ctx context.Context, userID int64, passwordHash string) error {
+	if m.updatePasswordFunc != nil {
+		return m.updatePasswordFunc(ctx, userID, passwordHash)
+	}
+	return nil
+}
+
+func (m *mockUserUsecase) UpdateEmail(ctx context.Context, userID int64, email string) error {
+	if m.updateEmailFunc != nil {
+		return m.updateEmailFunc(ctx, userID, email)
+	}
+	return nil
+}
+
+func (m *mockUserUsecase) CancelAccountDeletion(ctx context.Context, userID int64) error {
+	if m.cancelAccountDeletionFunc != nil {
+		return m.cancelAccountDeletionFunc(ctx, userID)
+	}
+	return nil
+}
+
 func TestNewAuthUsecase(t *testing.T) {
 	mockUserUC := &mockUserUsecase{}
-	usecase := NewAuthUsecase(mockUserUC)
+	usecase := NewAuthUsecase(mockUserUC, testPasswordHashConfig)
 
 	if usecase == nil {
 		t.Fatal("Expected usecase to be non-nil")
@@ -63,7 +97,7 @@ func TestNewAuthUsecase(t *testing.T) {
 
 func TestHashPassword(t *testing.T) {
 	mockUserUC := &mockUserUsecase{}
-	usecase := NewAuthUsecase(mockUserUC)
+	usecase := NewAuthUsecase(mockUserUC, testPasswordHashConfig)
 
 	tests := []struct {
 		name     string
@@ -85,6 +119,11 @@ func TestHashPassword(t *testing.T) {
 			password: "this_is_a_very_long_password_with_many_characters_1234567890",
 			wantErr:  false,
 		},
+		{
+			name:     "password longer than 72 bytes",
+			password: strings.Repeat("long-passphrase ", 8),
+			wantErr:  false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -106,8 +145,13 @@ func TestHashPassword(t *testing.T) {
 					t.Error("HashPassword() returned the original password")
 				}
 
+				// Verify that new hashes use Argon2id with the configured parameters
+				if !strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$") {
+					t.Errorf("HashPassword() = %q, want an Argon2id hash with the configured parameters", hash)
+				}
+
 				// Verify that the hash can be used to compare with the original password
-				err = bcrypt.CompareHashAndPassword([]byte(hash), []byte(tt.password))
+				err = usecase.VerifyPassword(tt.password, hash)
 				if err != nil {
 					t.Errorf("Generated hash cannot be verified with original password: %v", err)
 				}
@@ -118,7 +162,7 @@ func TestHashPassword(t *testing.T) {
 
 func TestVerifyPassword(t *testing.T) {
 	mockUserUC := &mockUserUsecase{}
-	usecase := NewAuthUsecase(mockUserUC)
+	usecase := NewAuthUsecase(mockUserUC, testPasswordHashConfig)
 
 	// First, create a hash to test against
 	password := "password123"
@@ -175,6 +219,90 @@ func TestVerifyPassword(t *testing.T) {
 	}
 }
 
+func TestVerifyPassword_LegacyBcrypt(t *testing.T) {
+	usecase := NewAuthUsecase(&mockUserUsecase{}, testPasswordHashConfig)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("Failed to create bcrypt hash: %v", err)
+	}
+
+	if err := usecase.VerifyPassword("password123", string(hash)); err != nil {
+		t.Errorf("VerifyPassword() error = %v, want nil for a bcrypt hash", err)
+	}
+	if err := usecase.VerifyPassword("wrongpassword", string(hash)); !errors.Is(err, util.ErrPasswordMismatch) {
+		t.Errorf("VerifyPassword() error = %v, want ErrPasswordMismatch", err)
+	}
+}
+
+func TestRehashPasswordIfNeeded(t *testing.T) {
+	ctx := context.Background()
+	password := "Tr0mbone-Maple"
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("Failed to create bcrypt hash: %v", err)
+	}
+	outdatedHash, err := util.HashPassword(password, util.Argon2Params{Memory: 512, Iterations: 1, Parallelism: 1})
+	if err != nil {
+		t.Fatalf("Failed to create outdated hash: %v", err)
+	}
+	currentHash, err := NewAuthUsecase(&mockUserUsecase{}, testPasswordHashConfig).HashPassword(password)
+	if err != nil {
+		t.Fatalf("Failed to create current hash: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		hash       string
+		updateErr  error
+		wantUpdate bool
+		wantErr    bool
+	}{
+		{name: "bcrypt hash", hash: string(bcryptHash), wantUpdate: true},
+		{name: "outdated Argon2id parameters", hash: outdatedHash, wantUpdate: true},
+		{name: "current parameters", hash: currentHash, wantUpdate: false},
+		{name: "update error", hash: string(bcryptHash), updateErr: errors.New("database error"), wantUpdate: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updatedID int64
+			var updatedHash string
+			mockUserUC := &mockUserUsecase{
+				updatePasswordFunc: func(ctx context.Context, userID int64, passwordHash string) error {
+					updatedID = userID
+					updatedHash = passwordHash
+					return tt.updateErr
+				},
+			}
+			usecase := NewAuthUsecase(mockUserUC, testPasswordHashConfig)
+			user := &domain.User{ID: 7, PasswordHash: tt.hash}
+
+			err := usecase.RehashPasswordIfNeeded(ctx, user, password)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RehashPasswordIfNeeded() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantUpdate {
+				if updatedHash != "" {
+					t.Errorf("Expected no update, got hash %q", updatedHash)
+				}
+				return
+			}
+			if updatedID != 7 {
+				t.Errorf("Expected update for user 7, got %d", updatedID)
+			}
+			if util.PasswordHashNeedsRehash(updatedHash, util.Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1}) {
+				t.Errorf("Expected a hash with the current parameters, got %q", updatedHash)
+			}
+			if err := usecase.VerifyPassword(password, updatedHash); err != nil {
+				t.Errorf("Rehashed password cannot be verified: %v", err)
+			}
+		})
+	}
+}
+
 func TestCheckEmailExists(t *testing.T) {
 	ctx := context.Background()
 	now := time.Now()
@@ -242,7 +370,7 @@ func TestCheckEmailExists(t *testing.T) {
 			mockUserUC := &mockUserUsecase{
 				getUserByEmailFunc: tt.mockGetByEmail,
 			}
-			usecase := NewAuthUsecase(mockUserUC)
+			usecase := NewAuthUsecase(mockUserUC, testPasswordHashConfig)
 
 			exists, err := usecase.CheckEmailExists(ctx, tt.email)
 			if (err != nil) != tt.wantErr {
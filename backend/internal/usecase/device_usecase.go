@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"unicode/utf8"
+
+	"github.com/keu-5/muzee/backend/config"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/repository"
+)
+
+// NEW_DEVICE_VERIFICATION values
+const (
+	NewDeviceVerificationOff    = "off"
+	NewDeviceVerificationAlert  = "alert"
+	NewDeviceVerificationStepUp = "step_up"
+)
+
+// deviceIPAddressMaxLen and deviceUserAgentMaxLen match the column lengths of UserDevice
+const (
+	deviceIPAddressMaxLen = 64
+	deviceUserAgentMaxLen = 512
+)
+
+var ErrDeviceNotFound = errors.New("device not found")
+
+type DeviceUsecase interface {
+	RequiresStepUp(ctx context.Context, userID int64, clientID string) (bool, error)
+	RecordLogin(ctx context.Context, userID int64, clientID, ipAddress, userAgent string, trusted bool) (*domain.UserDevice, bool, error)
+	ListDevices(ctx context.Context, userID int64) ([]*domain.UserDevice, error)
+	SetTrusted(ctx context.Context, userID, deviceID int64, trusted bool) error
+	DeleteDevice(ctx context.Context, userID, deviceID int64) error
+}
+
+type deviceUsecase struct {
+	deviceRepo repository.UserDeviceRepository
+	cfg        *config.Config
+}
+
+func NewDeviceUsecase(deviceRepo repository.UserDeviceRepository, cfg *config.Config) DeviceUsecase {
+	return &deviceUsecase{
+		deviceRepo: deviceRepo,
+		cfg:        cfg,
+	}
+}
+
+// RequiresStepUp reports whether a password login from clientID must be confirmed with an emailed code
+func (u *deviceUsecase) RequiresStepUp(ctx context.Context, userID int64, clientID string) (bool, error) {
+	if u.cfg.NewDeviceVerification != NewDeviceVerificationStepUp {
+		return false, nil
+	}
+
+	device, err := u.deviceRepo.GetByClientID(ctx, userID, clientID)
+	if err != nil {
+		return false, err
+	}
+	return device == nil || !device.Trusted, nil
+}
+
+// RecordLogin remembers the client a user logged in from and reports whether
+// it had never been seen before and the user should be alerted.
+// trusted marks the device as trusted; it never revokes trust.
+func (u *deviceUsecase) RecordLogin(ctx context.Context, userID int64, clientID, ipAddress, userAgent string, trusted bool) (*domain.UserDevice, bool, error) {
+	ipAddress = truncate(ipAddress, deviceIPAddressMaxLen)
+	userAgent = truncate(userAgent, deviceUserAgentMaxLen)
+
+	device, err := u.deviceRepo.GetByClientID(ctx, userID, clientID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if device == nil {
+		device, err = u.deviceRepo.Create(ctx, userID, clientID, ipAddress, userAgent, trusted)
+		if err == nil {
+			return device, u.cfg.NewDeviceVerification != NewDeviceVerificationOff, nil
+		}
+
+		// 同じクライアントから同時にログインした場合は、先に登録された方を使う
+		device, _ = u.deviceRepo.GetByClientID(ctx, userID, clientID)
+		if device == nil {
+			return nil, false, err
+		}
+	}
+
+	if err := u.deviceRepo.Touch(ctx, device.ID, ipAddress, userAgent, trusted); err != nil {
+		return nil, false, err
+	}
+	device.IPAddress = ipAddress
+	device.UserAgent = userAgent
+	device.Trusted = device.Trusted || trusted
+	return device, false, nil
+}
+
+func (u *deviceUsecase) ListDevices(ctx context.Context, userID int64) ([]*domain.UserDevice, error) {
+	return u.deviceRepo.ListByUserID(ctx, userID)
+}
+
+func (u *deviceUsecase) SetTrusted(ctx context.Context, userID, deviceID int64, trusted bool) error {
+	found, err := u.deviceRepo.SetTrusted(ctx, userID, deviceID, trusted)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrDeviceNotFound
+	}
+	return nil
+}
+
+// DeleteDevice forgets a device, so the next login from it is treated as new again
+func (u *deviceUsecase) DeleteDevice(ctx context.Context, userID, deviceID int64) error {
+	found, err := u.deviceRepo.Delete(ctx, userID, deviceID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrDeviceNotFound
+	}
+	return nil
+}
+
+// truncate cuts s to at most maxLen bytes without splitting a UTF-8 character
+func truncate(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	s = s[:maxLen]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keu-5/muzee/backend/config"
+	"github.com/keu-5/muzee/backend/internal/domain"
+)
+
+// Mock UserDeviceRepository backed by a slice
+type mockUserDeviceRepository struct {
+	devices   []*domain.UserDevice
+	createErr error
+}
+
+func (m *mockUserDeviceRepository) Create(ctx context.Context, userID int64, clientID, ipAddress, userAgent string, trusted bool) (*domain.UserDevice, error) {
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	device := &domain.UserDevice{
+		ID:         int64(len(m.devices) + 1),
+		UserID:     userID,
+		ClientID:   clientID,
+		Trusted:    trusted,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		CreatedAt:  time.Now(),
+		LastSeenAt: time.Now(),
+	}
+	m.devices = append(m.devices, device)
+	copied := *device
+	return &copied, nil
+}
+
+func (m *mockUserDeviceRepository) GetByClientID(ctx context.Context, userID int64, clientID string) (*domain.UserDevice, error) {
+	for _, device := range m.devices {
+		if device.UserID == userID && device.ClientID == clientID {
+			copied := *device
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockUserDeviceRepository) ListByUserID(ctx context.Context, userID int64) ([]*domain.UserDevice, error) {
+	var result []*domain.UserDevice
+	for _, device := range m.devices {
+		if device.UserID == userID {
+			result = append(result, device)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockUserDeviceRepository) Touch(ctx context.Context, id int64, ipAddress, userAgent string, trusted bool) error {
+	for _, device := range m.devices {
+		if device.ID == id {
+			device.IPAddress = ipAddress
+			device.UserAgent = userAgent
+			device.Trusted = device.Trusted || trusted
+			device.LastSeenAt = time.Now()
+		}
+	}
+	return nil
+}
+
+func (m *mockUserDeviceRepository) SetTrusted(ctx context.Context, userID, id int64, trusted bool) (bool, error) {
+	for _, device := range m.devices {
+		if device.UserID == userID && device.ID == id {
+			device.Trusted = trusted
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockUserDeviceRepository) Delete(ctx context.Context, userID, id int64) (bool, error) {
+	for i, device := range m.devices {
+		if device.UserID == userID && device.ID == id {
+			m.devices = append(m.devices[:i], m.devices[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestRecordLogin_AlertsOnlyForNewDevice(t *testing.T) {
+	tests := []struct {
+		mode      string
+		wantAlert bool
+	}{
+		{mode: NewDeviceVerificationAlert, wantAlert: true},
+		{mode: NewDeviceVerificationStepUp, wantAlert: true},
+		{mode: NewDeviceVerificationOff, wantAlert: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			repo := &mockUserDeviceRepository{}
+			uc := NewDeviceUsecase(repo, &config.Config{NewDeviceVerification: tt.mode})
+
+			device, alert, err := uc.RecordLogin(context.Background(), 1, "client-a", "203.0.113.7", "Agent/1.0", false)
+			if err != nil {
+				t.Fatalf("RecordLogin() error = %v", err)
+			}
+			if alert != tt.wantAlert {
+				t.Errorf("first login alert = %v, want %v", alert, tt.wantAlert)
+			}
+			if device.ClientID != "client-a" || device.IPAddress != "203.0.113.7" {
+				t.Errorf("unexpected device: %+v", device)
+			}
+
+			device, alert, err = uc.RecordLogin(context.Background(), 1, "client-a", "198.51.100.1", "Agent/2.0", false)
+			if err != nil {
+				t.Fatalf("RecordLogin() error = %v", err)
+			}
+			if alert {
+				t.Error("Expected no alert for a known device")
+			}
+			if device.IPAddress != "198.51.100.1" || device.UserAgent != "Agent/2.0" {
+				t.Errorf("Expected the device to be updated, got %+v", device)
+			}
+			if len(repo.devices) != 1 {
+				t.Errorf("Expected 1 device, got %d", len(repo.devices))
+			}
+		})
+	}
+}
+
+func TestRecordLogin_TrustOnlyUpgrades(t *testing.T) {
+	repo := &mockUserDeviceRepository{}
+	uc := NewDeviceUsecase(repo, &config.Config{NewDeviceVerification: NewDeviceVerificationAlert})
+	ctx := context.Background()
+
+	if _, _, err := uc.RecordLogin(ctx, 1, "client-a", "", "", true); err != nil {
+		t.Fatalf("RecordLogin() error = %v", err)
+	}
+	device, _, err := uc.RecordLogin(ctx, 1, "client-a", "", "", false)
+	if err != nil {
+		t.Fatalf("RecordLogin() error = %v", err)
+	}
+	if !device.Trusted || !repo.devices[0].Trusted {
+		t.Error("Expected a later login not to revoke trust")
+	}
+}
+
+func TestRecordLogin_TruncatesLongValues(t *testing.T) {
+	repo := &mockUserDeviceRepository{}
+	uc := NewDeviceUsecase(repo, &config.Config{})
+
+	userAgent := strings.Repeat("あ", 200)
+	device, _, err := uc.RecordLogin(context.Background(), 1, "client-a", strings.Repeat("1", 100), userAgent, false)
+	if err != nil {
+		t.Fatalf("RecordLogin() error = %v", err)
+	}
+	if len(device.IPAddress) != deviceIPAddressMaxLen {
+		t.Errorf("Expected IP address to be truncated to %d bytes, got %d", deviceIPAddressMaxLen, len(device.IPAddress))
+	}
+	if len(device.UserAgent) > deviceUserAgentMaxLen || !strings.HasPrefix(userAgent, device.UserAgent) {
+		t.Errorf("Expected user agent to be truncated on a character boundary, got %d bytes", len(device.UserAgent))
+	}
+}
+
+func TestRecordLogin_ConcurrentCreate(t *testing.T) {
+	// Another request registered the device between the lookup and the insert
+	repo := &mockUserDeviceRepository{createErr: errors.New("duplicate key")}
+	uc := NewDeviceUsecase(repo, &config.Config{NewDeviceVerification: NewDeviceVerificationAlert})
+
+	if _, _, err := uc.RecordLogin(context.Background(), 1, "client-a", "", "", false); err == nil {
+		t.Error("Expected the create error when the device still does not exist")
+	}
+
+	repo.devices = []*domain.UserDevice{{ID: 1, UserID: 1, ClientID: "client-a"}}
+	repo.createErr = nil
+	_, alert, err := uc.RecordLogin(context.Background(), 1, "client-a", "", "", false)
+	if err != nil || alert {
+		t.Errorf("RecordLogin() = alert %v, err %v; want no alert and no error", alert, err)
+	}
+}
+
+func TestRequiresStepUp(t *testing.T) {
+	repo := &mockUserDeviceRepository{devices: []*domain.UserDevice{
+		{ID: 1, UserID: 1, ClientID: "trusted", Trusted: true},
+		{ID: 2, UserID: 1, ClientID: "known"},
+	}}
+
+	tests := []struct {
+		name     string
+		mode     string
+		clientID string
+		want     bool
+	}{
+		{name: "step-up new device", mode: NewDeviceVerificationStepUp, clientID: "new", want: true},
+		{name: "step-up untrusted device", mode: NewDeviceVerificationStepUp, clientID: "known", want: true},
+		{name: "step-up trusted device", mode: NewDeviceVerificationStepUp, clientID: "trusted", want: false},
+		{name: "alert new device", mode: NewDeviceVerificationAlert, clientID: "new", want: false},
+		{name: "off new device", mode: NewDeviceVerificationOff, clientID: "new", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewDeviceUsecase(repo, &config.Config{NewDeviceVerification: tt.mode})
+			got, err := uc.RequiresStepUp(context.Background(), 1, tt.clientID)
+			if err != nil {
+				t.Fatalf("RequiresStepUp() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RequiresStepUp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetTrustedAndDeleteDevice_NotFound(t *testing.T) {
+	repo := &mockUserDeviceRepository{devices: []*domain.UserDevice{{ID: 1, UserID: 2, ClientID: "other-user"}}}
+	uc := NewDeviceUsecase(repo, &config.Config{})
+	ctx := context.Background()
+
+	if err := uc.SetTrusted(ctx, 1, 1, true); !errors.Is(err, ErrDeviceNotFound) {
+		t.Errorf("SetTrusted() error = %v, want ErrDeviceNotFound", err)
+	}
+	if err := uc.DeleteDevice(ctx, 1, 1); !errors.Is(err, ErrDeviceNotFound) {
+		t.Errorf("DeleteDevice() error = %v, want ErrDeviceNotFound", err)
+	}
+	if err := uc.SetTrusted(ctx, 2, 1, true); err != nil || !repo.devices[0].Trusted {
+		t.Errorf("SetTrusted() error = %v, trusted = %v", err, repo.devices[0].Trusted)
+	}
+	if err := uc.DeleteDevice(ctx, 2, 1); err != nil || len(repo.devices) != 0 {
+		t.Errorf("DeleteDevice() error = %v, remaining = %d", err, len(repo.devices))
+	}
+}
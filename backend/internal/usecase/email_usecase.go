@@ -2,14 +2,26 @@ package usecase
 
 import (
 	"fmt"
+	"html"
+	"time"
 )
 
+// jst is the time zone shown in security notices
+var jst = time.FixedZone("JST", 9*60*60)
+
 type EmailSender interface {
 	Send(to, subject, html string) error
 }
 
 type EmailUsecase interface {
 	SendVerificationCode(email, code string) error
+	SendPasswordResetCode(email, code string) error
+	SendEmailChangeCode(email, code string) error
+	SendEmailChangedNotice(oldEmail, newEmail, revertURL string) error
+	SendPasswordChangedNotice(email, resetURL string) error
+	SendMagicLink(email, loginURL string) error
+	SendNewDeviceAlert(email, ipAddress, userAgent string, at time.Time) error
+	SendDeviceVerificationCode(email, code, ipAddress, userAgent string) error
 }
 
 type emailUsecase struct {
@@ -40,3 +52,143 @@ func (u *emailUsecase) SendVerificationCode(email, code string) error {
 
 	return u.emailClient.Send(email, subject, html)
 }
+
+func (u *emailUsecase) SendPasswordResetCode(email, code string) error {
+	subject := "【Muzee】パスワード再設定コード"
+	html := fmt.Sprintf(`
+		<div style="font-family: sans-serif; max-width: 600px; margin: 0 auto;">
+			<h2>パスワード再設定コード</h2>
+			<p>パスワードを再設定するには、以下の確認コードを入力してください：</p>
+			<div style="background-color: #f5f5f5; padding: 20px; text-align: center; font-size: 32px; font-weight: bold; letter-spacing: 8px;">
+				%s
+			</div>
+			<p style="color: #666; font-size: 14px;">
+				※このコードの有効期限は15分です<br>
+				※心当たりがない場合は、このメールを無視してください。パスワードは変更されません
+			</p>
+		</div>
+	`, code)
+
+	return u.emailClient.Send(email, subject, html)
+}
+
+func (u *emailUsecase) SendEmailChangeCode(email, code string) error {
+	subject := "【Muzee】メールアドレス変更の確認コード"
+	html := fmt.Sprintf(`
+		<div style="font-family: sans-serif; max-width: 600px; margin: 0 auto;">
+			<h2>メールアドレス変更の確認コード</h2>
+			<p>このメールアドレスをMuzeeのログイン用アドレスに設定するには、以下の確認コードを入力してください：</p>
+			<div style="background-color: #f5f5f5; padding: 20px; text-align: center; font-size: 32px; font-weight: bold; letter-spacing: 8px;">
+				%s
+			</div>
+			<p style="color: #666; font-size: 14px;">
+				※このコードの有効期限は15分です<br>
+				※心当たりがない場合は、このメールを無視してください
+			</p>
+		</div>
+	`, code)
+
+	return u.emailClient.Send(email, subject, html)
+}
+
+func (u *emailUsecase) SendEmailChangedNotice(oldEmail, newEmail, revertURL string) error {
+	subject := "【Muzee】メールアドレスが変更されました"
+	html := fmt.Sprintf(`
+		<div style="font-family: sans-serif; max-width: 600px; margin: 0 auto;">
+			<h2>メールアドレスが変更されました</h2>
+			<p>アカウントのログイン用メールアドレスが <strong>%s</strong> に変更されました。</p>
+			<p>この変更に心当たりがない場合は、以下のリンクから元のメールアドレスに戻し、パスワードを再設定してください：</p>
+			<p style="text-align: center;">
+				<a href="%s" style="display: inline-block; background-color: #333; color: #fff; padding: 12px 24px; text-decoration: none;">メールアドレスを元に戻す</a>
+			</p>
+			<p style="color: #666; font-size: 14px;">
+				※このリンクの有効期限は7日間です<br>
+				※ご自身で変更した場合は、このメールを無視してください
+			</p>
+		</div>
+	`, newEmail, revertURL)
+
+	return u.emailClient.Send(oldEmail, subject, html)
+}
+
+func (u *emailUsecase) SendPasswordChangedNotice(email, resetURL string) error {
+	subject := "【Muzee】パスワードが変更されました"
+	html := fmt.Sprintf(`
+		<div style="font-family: sans-serif; max-width: 600px; margin: 0 auto;">
+			<h2>パスワードが変更されました</h2>
+			<p>アカウントのパスワードが変更されました。変更を行ったデバイス以外では、再度ログインが必要です。</p>
+			<p>この変更に心当たりがない場合は、第三者に不正にアクセスされた可能性があります。以下のリンクから直ちにパスワードを再設定してください：</p>
+			<p style="text-align: center;">
+				<a href="%s" style="display: inline-block; background-color: #333; color: #fff; padding: 12px 24px; text-decoration: none;">パスワードを再設定する</a>
+			</p>
+			<p style="color: #666; font-size: 14px;">
+				※ご自身で変更した場合は、このメールを無視してください
+			</p>
+		</div>
+	`, resetURL)
+
+	return u.emailClient.Send(email, subject, html)
+}
+
+func (u *emailUsecase) SendMagicLink(email, loginURL string) error {
+	subject := "【Muzee】ログイン用リンク"
+	html := fmt.Sprintf(`
+		<div style="font-family: sans-serif; max-width: 600px; margin: 0 auto;">
+			<h2>ログイン用リンク</h2>
+			<p>以下のリンクからMuzeeにログインできます。リンクは一度だけ、リクエストしたデバイスでのみ使用できます：</p>
+			<p style="text-align: center;">
+				<a href="%s" style="display: inline-block; background-color: #333; color: #fff; padding: 12px 24px; text-decoration: none;">ログインする</a>
+			</p>
+			<p style="color: #666; font-size: 14px;">
+				※このリンクの有効期限は15分です<br>
+				※心当たりがない場合は、このメールを無視してください
+			</p>
+		</div>
+	`, loginURL)
+
+	return u.emailClient.Send(email, subject, html)
+}
+
+func (u *emailUsecase) SendNewDeviceAlert(email, ipAddress, userAgent string, at time.Time) error {
+	subject := "【Muzee】新しいデバイスからのログイン"
+	body := fmt.Sprintf(`
+		<div style="font-family: sans-serif; max-width: 600px; margin: 0 auto;">
+			<h2>新しいデバイスからのログイン</h2>
+			<p>これまでに使用されたことのないデバイスから、アカウントへのログインがありました：</p>
+			<table style="background-color: #f5f5f5; padding: 20px; width: 100%%;">
+				<tr><td>日時</td><td>%s</td></tr>
+				<tr><td>IPアドレス</td><td>%s</td></tr>
+				<tr><td>ブラウザ・端末</td><td>%s</td></tr>
+			</table>
+			<p>ご自身のログインであれば、設定画面からこのデバイスを信頼済みにできます。</p>
+			<p style="color: #666; font-size: 14px;">
+				※心当たりがない場合は、第三者に不正にアクセスされた可能性があります。直ちにパスワードを変更し、設定画面からこのデバイスを削除してください
+			</p>
+		</div>
+	`, at.In(jst).Format("2006年1月2日 15:04 (JST)"), html.EscapeString(ipAddress), html.EscapeString(userAgent))
+
+	return u.emailClient.Send(email, subject, body)
+}
+
+func (u *emailUsecase) SendDeviceVerificationCode(email, code, ipAddress, userAgent string) error {
+	subject := "【Muzee】新しいデバイスの確認コード"
+	body := fmt.Sprintf(`
+		<div style="font-family: sans-serif; max-width: 600px; margin: 0 auto;">
+			<h2>新しいデバイスの確認コード</h2>
+			<p>これまでに使用されたことのないデバイスからログインしようとしています。ログインを完了するには、以下の確認コードを入力してください：</p>
+			<div style="background-color: #f5f5f5; padding: 20px; text-align: center; font-size: 32px; font-weight: bold; letter-spacing: 8px;">
+				%s
+			</div>
+			<table style="padding: 20px 0; width: 100%%;">
+				<tr><td>IPアドレス</td><td>%s</td></tr>
+				<tr><td>ブラウザ・端末</td><td>%s</td></tr>
+			</table>
+			<p style="color: #666; font-size: 14px;">
+				※このコードの有効期限は10分です<br>
+				※心当たりがない場合は、パスワードが第三者に知られている可能性があります。直ちにパスワードを変更してください
+			</p>
+		</div>
+	`, code, html.EscapeString(ipAddress), html.EscapeString(userAgent))
+
+	return u.emailClient.Send(email, subject, body)
+}
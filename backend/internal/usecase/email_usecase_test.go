@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 )
 
 // Mock EmailClient
@@ -175,3 +176,235 @@ func TestSendVerificationCodeHTMLStructure(t *testing.T) {
 		}
 	}
 }
+
+func TestSendPasswordResetCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		code     string
+		mockSend func(to, subject, html string) error
+		wantErr  bool
+	}{
+		{
+			name:  "successful send",
+			email: "test@example.com",
+			code:  "654321",
+			mockSend: func(to, subject, html string) error {
+				return nil
+			},
+			wantErr: false,
+		},
+		{
+			name:  "email client error",
+			email: "error@example.com",
+			code:  "999999",
+			mockSend: func(to, subject, html string) error {
+				return errors.New("failed to send email")
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedTo, capturedSubject, capturedHTML string
+			mockClient := &mockEmailClient{
+				sendFunc: func(to, subject, html string) error {
+					capturedTo = to
+					capturedSubject = subject
+					capturedHTML = html
+					return tt.mockSend(to, subject, html)
+				},
+			}
+			usecase := NewEmailUsecase(mockClient)
+
+			err := usecase.SendPasswordResetCode(tt.email, tt.code)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SendPasswordResetCode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if capturedTo != tt.email {
+				t.Errorf("Expected email to be '%s', got '%s'", tt.email, capturedTo)
+			}
+			if capturedSubject != "【Muzee】パスワード再設定コード" {
+				t.Errorf("Expected subject to be '【Muzee】パスワード再設定コード', got '%s'", capturedSubject)
+			}
+			if !strings.Contains(capturedHTML, tt.code) {
+				t.Errorf("Expected HTML to contain the reset code '%s'", tt.code)
+			}
+			if !strings.Contains(capturedHTML, "15分") {
+				t.Error("Expected HTML to contain expiration message '15分'")
+			}
+		})
+	}
+}
+
+func TestSendEmailChangeCode(t *testing.T) {
+	var capturedTo, capturedSubject, capturedHTML string
+	mockClient := &mockEmailClient{
+		sendFunc: func(to, subject, html string) error {
+			capturedTo = to
+			capturedSubject = subject
+			capturedHTML = html
+			return nil
+		},
+	}
+	usecase := NewEmailUsecase(mockClient)
+
+	if err := usecase.SendEmailChangeCode("new@example.com", "246810"); err != nil {
+		t.Fatalf("SendEmailChangeCode() error = %v", err)
+	}
+	if capturedTo != "new@example.com" {
+		t.Errorf("Expected email to be 'new@example.com', got '%s'", capturedTo)
+	}
+	if capturedSubject != "【Muzee】メールアドレス変更の確認コード" {
+		t.Errorf("Unexpected subject '%s'", capturedSubject)
+	}
+	if !strings.Contains(capturedHTML, "246810") {
+		t.Error("Expected HTML to contain the confirmation code")
+	}
+}
+
+func TestSendEmailChangedNotice(t *testing.T) {
+	var capturedTo, capturedHTML string
+	mockClient := &mockEmailClient{
+		sendFunc: func(to, subject, html string) error {
+			capturedTo = to
+			capturedHTML = html
+			return nil
+		},
+	}
+	usecase := NewEmailUsecase(mockClient)
+
+	revertURL := "http://localhost:3000/email/revert?token=abc"
+	if err := usecase.SendEmailChangedNotice("old@example.com", "new@example.com", revertURL); err != nil {
+		t.Fatalf("SendEmailChangedNotice() error = %v", err)
+	}
+
+	// 通知は旧メールアドレスに送る
+	if capturedTo != "old@example.com" {
+		t.Errorf("Expected email to be 'old@example.com', got '%s'", capturedTo)
+	}
+	if !strings.Contains(capturedHTML, "new@example.com") {
+		t.Error("Expected HTML to contain the new email")
+	}
+	if !strings.Contains(capturedHTML, revertURL) {
+		t.Error("Expected HTML to contain the revert link")
+	}
+}
+
+func TestSendPasswordChangedNotice(t *testing.T) {
+	var capturedTo, capturedSubject, capturedHTML string
+	mockClient := &mockEmailClient{
+		sendFunc: func(to, subject, html string) error {
+			capturedTo = to
+			capturedSubject = subject
+			capturedHTML = html
+			return nil
+		},
+	}
+	usecase := NewEmailUsecase(mockClient)
+
+	resetURL := "http://localhost:3000/password/forgot"
+	if err := usecase.SendPasswordChangedNotice("user@example.com", resetURL); err != nil {
+		t.Fatalf("SendPasswordChangedNotice() error = %v", err)
+	}
+
+	if capturedTo != "user@example.com" {
+		t.Errorf("Expected email to be 'user@example.com', got '%s'", capturedTo)
+	}
+	if capturedSubject != "【Muzee】パスワードが変更されました" {
+		t.Errorf("Unexpected subject: %s", capturedSubject)
+	}
+	if !strings.Contains(capturedHTML, resetURL) {
+		t.Error("Expected HTML to contain the password reset link")
+	}
+}
+
+func TestSendMagicLink(t *testing.T) {
+	var capturedTo, capturedSubject, capturedHTML string
+	mockClient := &mockEmailClient{
+		sendFunc: func(to, subject, html string) error {
+			capturedTo = to
+			capturedSubject = subject
+			capturedHTML = html
+			return nil
+		},
+	}
+	usecase := NewEmailUsecase(mockClient)
+
+	loginURL := "http://localhost:3000/login/magic?token=abc"
+	if err := usecase.SendMagicLink("user@example.com", loginURL); err != nil {
+		t.Fatalf("SendMagicLink() error = %v", err)
+	}
+
+	if capturedTo != "user@example.com" {
+		t.Errorf("Expected email to be 'user@example.com', got '%s'", capturedTo)
+	}
+	if capturedSubject != "【Muzee】ログイン用リンク" {
+		t.Errorf("Unexpected subject: %s", capturedSubject)
+	}
+	if !strings.Contains(capturedHTML, loginURL) {
+		t.Error("Expected HTML to contain the login link")
+	}
+}
+
+func TestSendNewDeviceAlert(t *testing.T) {
+	var capturedTo, capturedSubject, capturedHTML string
+	mockClient := &mockEmailClient{
+		sendFunc: func(to, subject, html string) error {
+			capturedTo = to
+			capturedSubject = subject
+			capturedHTML = html
+			return nil
+		},
+	}
+	usecase := NewEmailUsecase(mockClient)
+
+	at := time.Date(2030, 1, 1, 0, 30, 0, 0, time.UTC)
+	if err := usecase.SendNewDeviceAlert("user@example.com", "203.0.113.7", "<script>Agent</script>", at); err != nil {
+		t.Fatalf("SendNewDeviceAlert() error = %v", err)
+	}
+
+	if capturedTo != "user@example.com" {
+		t.Errorf("Expected email to be 'user@example.com', got '%s'", capturedTo)
+	}
+	if capturedSubject != "【Muzee】新しいデバイスからのログイン" {
+		t.Errorf("Unexpected subject: %s", capturedSubject)
+	}
+	if !strings.Contains(capturedHTML, "203.0.113.7") {
+		t.Error("Expected HTML to contain the IP address")
+	}
+	if !strings.Contains(capturedHTML, "2030年1月1日 09:30 (JST)") {
+		t.Error("Expected HTML to contain the login time in JST")
+	}
+	if strings.Contains(capturedHTML, "<script>") || !strings.Contains(capturedHTML, "&lt;script&gt;Agent") {
+		t.Error("Expected the user agent to be HTML-escaped")
+	}
+}
+
+func TestSendDeviceVerificationCode(t *testing.T) {
+	var capturedSubject, capturedHTML string
+	mockClient := &mockEmailClient{
+		sendFunc: func(to, subject, html string) error {
+			capturedSubject = subject
+			capturedHTML = html
+			return nil
+		},
+	}
+	usecase := NewEmailUsecase(mockClient)
+
+	if err := usecase.SendDeviceVerificationCode("user@example.com", "123456", "203.0.113.7", "TestAgent"); err != nil {
+		t.Fatalf("SendDeviceVerificationCode() error = %v", err)
+	}
+
+	if capturedSubject != "【Muzee】新しいデバイスの確認コード" {
+		t.Errorf("Unexpected subject: %s", capturedSubject)
+	}
+	for _, want := range []string{"123456", "203.0.113.7", "TestAgent", "10分"} {
+		if !strings.Contains(capturedHTML, want) {
+			t.Errorf("Expected HTML to contain %q", want)
+		}
+	}
+}
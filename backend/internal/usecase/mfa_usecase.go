@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"github.com/keu-5/muzee/backend/config"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/repository"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+const recoveryCodeCount = 10
+
+var (
+	ErrTOTPAlreadyEnabled = errors.New("totp already enabled")
+	ErrTOTPNotEnrolled    = errors.New("totp not enrolled")
+	ErrTOTPNotEnabled     = errors.New("totp not enabled")
+	ErrInvalidMFACode     = errors.New("invalid mfa code")
+)
+
+type TOTPEnrollment struct {
+	Secret string
+	URI    string
+}
+
+type MFAUsecase interface {
+	BeginTOTPEnrollment(ctx context.Context, user *domain.User) (*TOTPEnrollment, error)
+	ConfirmTOTPEnrollment(ctx context.Context, user *domain.User, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, user *domain.User, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, user *domain.User, code string) ([]string, error)
+	VerifyCode(ctx context.Context, user *domain.User, code string) error
+}
+
+type mfaUsecase struct {
+	userRepo repository.UserRepository
+	cfg      *config.Config
+	now      func() time.Time
+}
+
+func NewMFAUsecase(userRepo repository.UserRepository, cfg *config.Config) MFAUsecase {
+	return &mfaUsecase{
+		userRepo: userRepo,
+		cfg:      cfg,
+		now:      time.Now,
+	}
+}
+
+func (u *mfaUsecase) BeginTOTPEnrollment(ctx context.Context, user *domain.User) (*TOTPEnrollment, error) {
+	if user.TOTPEnabled {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+
+	secret, err := util.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := util.ParseEncryptionKey(u.cfg.MFAEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := util.EncryptString(key, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepo.SetPendingTOTPSecret(ctx, user.ID, encrypted); err != nil {
+		return nil, err
+	}
+
+	return &TOTPEnrollment{
+		Secret: secret,
+		URI:    util.BuildTOTPURI(u.cfg.MFAIssuer, user.Email, secret),
+	}, nil
+}
+
+func (u *mfaUsecase) ConfirmTOTPEnrollment(ctx context.Context, user *domain.User, code string) ([]string, error) {
+	if user.TOTPEnabled {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+	if user.TOTPSecret == nil {
+		return nil, ErrTOTPNotEnrolled
+	}
+
+	secret, err := u.decryptSecret(*user.TOTPSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	step, ok := util.ValidateTOTPCode(secret, code, u.now(), user.TOTPLastUsedStep)
+	if !ok {
+		return nil, ErrInvalidMFACode
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepo.EnableTOTP(ctx, user.ID, step, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (u *mfaUsecase) DisableTOTP(ctx context.Context, user *domain.User, code string) error {
+	if err := u.VerifyCode(ctx, user, code); err != nil {
+		return err
+	}
+	return u.userRepo.DisableTOTP(ctx, user.ID)
+}
+
+func (u *mfaUsecase) RegenerateRecoveryCodes(ctx context.Context, user *domain.User, code string) ([]string, error) {
+	if err := u.VerifyCode(ctx, user, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepo.UpdateRecoveryCodeHashes(ctx, user.ID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// VerifyCode accepts either a current TOTP code or an unused recovery code, consuming the latter
+func (u *mfaUsecase) VerifyCode(ctx context.Context, user *domain.User, code string) error {
+	if !user.TOTPEnabled || user.TOTPSecret == nil {
+		return ErrTOTPNotEnabled
+	}
+
+	secret, err := u.decryptSecret(*user.TOTPSecret)
+	if err != nil {
+		return err
+	}
+
+	if step, ok := util.ValidateTOTPCode(secret, code, u.now(), user.TOTPLastUsedStep); ok {
+		consumed, err := u.userRepo.ConsumeTOTPStep(ctx, user.ID, step)
+		if err != nil {
+			return err
+		}
+		if !consumed {
+			return ErrInvalidMFACode
+		}
+		return nil
+	}
+
+	// リカバリーコードとして照合し、一致したものは使い捨てにする
+	codeHash := util.HashToken(util.NormalizeRecoveryCode(code))
+	remaining := make([]string, 0, len(user.TOTPRecoveryCodeHashes))
+	matched := false
+	for _, hash := range user.TOTPRecoveryCodeHashes {
+		if !matched && subtle.ConstantTimeCompare([]byte(hash), []byte(codeHash)) == 1 {
+			matched = true
+			continue
+		}
+		remaining = append(remaining, hash)
+	}
+	if !matched {
+		return ErrInvalidMFACode
+	}
+
+	return u.userRepo.UpdateRecoveryCodeHashes(ctx, user.ID, remaining)
+}
+
+func (u *mfaUsecase) decryptSecret(encrypted string) (string, error) {
+	key, err := util.ParseEncryptionKey(u.cfg.MFAEncryptionKey)
+	if err != nil {
+		return "", err
+	}
+	return util.DecryptString(key, encrypted)
+}
+
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := util.GenerateRecoveryCode()
+		if err != nil {
+			return nil, nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, util.HashToken(util.NormalizeRecoveryCode(code)))
+	}
+	return codes, hashes, nil
+}
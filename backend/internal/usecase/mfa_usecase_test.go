@@ -0,0 +1,254 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keu-5/muzee/backend/config"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+func newTestMFAConfig() *config.Config {
+	return &config.Config{
+		MFAEncryptionKey: base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+		MFAIssuer:        "Muzee",
+	}
+}
+
+// newTOTPUser returns a user whose encrypted secret is secret
+func newTOTPUser(t *testing.T, cfg *config.Config, secret string, enabled bool) *domain.User {
+	key, err := util.ParseEncryptionKey(cfg.MFAEncryptionKey)
+	if err != nil {
+		t.Fatalf("failed to parse key: %v", err)
+	}
+	encrypted, err := util.EncryptString(key, secret)
+	if err != nil {
+		t.Fatalf("failed to encrypt secret: %v", err)
+	}
+	return &domain.User{
+		ID:          1,
+		Email:       "test@example.com",
+		TOTPSecret:  &encrypted,
+		TOTPEnabled: enabled,
+	}
+}
+
+func TestBeginTOTPEnrollment(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestMFAConfig()
+
+	var stored string
+	mockRepo := &mockUserRepository{
+		setPendingTOTPSecretFunc: func(ctx context.Context, id int64, encryptedSecret string) error {
+			stored = encryptedSecret
+			return nil
+		},
+	}
+	uc := NewMFAUsecase(mockRepo, cfg)
+
+	enrollment, err := uc.BeginTOTPEnrollment(ctx, &domain.User{ID: 1, Email: "test@example.com"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if enrollment.Secret == "" {
+		t.Fatal("Expected secret to be generated")
+	}
+	if !strings.HasPrefix(enrollment.URI, "otpauth://totp/Muzee:test@example.com?") {
+		t.Errorf("Unexpected URI: %s", enrollment.URI)
+	}
+	if !strings.Contains(enrollment.URI, "secret="+enrollment.Secret) {
+		t.Errorf("Expected URI to contain the secret: %s", enrollment.URI)
+	}
+
+	// 平文のシークレットは保存されない
+	if stored == "" || stored == enrollment.Secret {
+		t.Errorf("Expected encrypted secret to be stored, got %q", stored)
+	}
+}
+
+func TestBeginTOTPEnrollment_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("already enabled", func(t *testing.T) {
+		uc := NewMFAUsecase(&mockUserRepository{}, newTestMFAConfig())
+		_, err := uc.BeginTOTPEnrollment(ctx, &domain.User{ID: 1, TOTPEnabled: true})
+		if !errors.Is(err, ErrTOTPAlreadyEnabled) {
+			t.Errorf("Expected ErrTOTPAlreadyEnabled, got %v", err)
+		}
+	})
+
+	t.Run("encryption key not configured", func(t *testing.T) {
+		uc := NewMFAUsecase(&mockUserRepository{}, &config.Config{MFAIssuer: "Muzee"})
+		_, err := uc.BeginTOTPEnrollment(ctx, &domain.User{ID: 1})
+		if err == nil {
+			t.Error("Expected error when encryption key is missing")
+		}
+	})
+}
+
+func TestConfirmTOTPEnrollment(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestMFAConfig()
+	secret, _ := util.GenerateTOTPSecret()
+	now := time.Now()
+
+	t.Run("valid code enables totp", func(t *testing.T) {
+		var gotHashes []string
+		var gotStep int64
+		mockRepo := &mockUserRepository{
+			enableTOTPFunc: func(ctx context.Context, id int64, usedStep int64, recoveryCodeHashes []string) error {
+				gotStep = usedStep
+				gotHashes = recoveryCodeHashes
+				return nil
+			},
+		}
+		uc := NewMFAUsecase(mockRepo, cfg)
+		code, _ := util.GenerateTOTPCode(secret, now)
+
+		codes, err := uc.ConfirmTOTPEnrollment(ctx, newTOTPUser(t, cfg, secret, false), code)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(codes) != recoveryCodeCount || len(gotHashes) != recoveryCodeCount {
+			t.Fatalf("Expected %d recovery codes, got %d codes and %d hashes", recoveryCodeCount, len(codes), len(gotHashes))
+		}
+		if gotHashes[0] != util.HashToken(util.NormalizeRecoveryCode(codes[0])) {
+			t.Error("Expected recovery codes to be stored hashed")
+		}
+		if gotStep == 0 {
+			t.Error("Expected used step to be recorded")
+		}
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		uc := NewMFAUsecase(&mockUserRepository{}, cfg)
+		_, err := uc.ConfirmTOTPEnrollment(ctx, newTOTPUser(t, cfg, secret, false), "000000")
+		if !errors.Is(err, ErrInvalidMFACode) {
+			t.Errorf("Expected ErrInvalidMFACode, got %v", err)
+		}
+	})
+
+	t.Run("not enrolled", func(t *testing.T) {
+		uc := NewMFAUsecase(&mockUserRepository{}, cfg)
+		_, err := uc.ConfirmTOTPEnrollment(ctx, &domain.User{ID: 1}, "123456")
+		if !errors.Is(err, ErrTOTPNotEnrolled) {
+			t.Errorf("Expected ErrTOTPNotEnrolled, got %v", err)
+		}
+	})
+}
+
+func TestMFAVerifyCode(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestMFAConfig()
+	secret, _ := util.GenerateTOTPSecret()
+	now := time.Now()
+
+	t.Run("valid totp code", func(t *testing.T) {
+		uc := NewMFAUsecase(&mockUserRepository{}, cfg)
+		code, _ := util.GenerateTOTPCode(secret, now)
+
+		if err := uc.VerifyCode(ctx, newTOTPUser(t, cfg, secret, true), code); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("replayed totp code", func(t *testing.T) {
+		mockRepo := &mockUserRepository{
+			consumeTOTPStepFunc: func(ctx context.Context, id int64, step int64) (bool, error) {
+				// 同じステップが既に使用済み
+				return false, nil
+			},
+		}
+		uc := NewMFAUsecase(mockRepo, cfg)
+		code, _ := util.GenerateTOTPCode(secret, now)
+
+		err := uc.VerifyCode(ctx, newTOTPUser(t, cfg, secret, true), code)
+		if !errors.Is(err, ErrInvalidMFACode) {
+			t.Errorf("Expected ErrInvalidMFACode, got %v", err)
+		}
+	})
+
+	t.Run("recovery code is consumed", func(t *testing.T) {
+		codes, hashes, err := generateRecoveryCodes()
+		if err != nil {
+			t.Fatalf("failed to generate recovery codes: %v", err)
+		}
+
+		var remaining []string
+		mockRepo := &mockUserRepository{
+			updateRecoveryCodeHashesFunc: func(ctx context.Context, id int64, recoveryCodeHashes []string) error {
+				remaining = recoveryCodeHashes
+				return nil
+			},
+		}
+		uc := NewMFAUsecase(mockRepo, cfg)
+		user := newTOTPUser(t, cfg, secret, true)
+		user.TOTPRecoveryCodeHashes = hashes
+
+		// 大文字・ハイフンなしで入力されても受け付ける
+		input := strings.ToUpper(strings.ReplaceAll(codes[3], "-", ""))
+		if err := uc.VerifyCode(ctx, user, input); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(remaining) != recoveryCodeCount-1 {
+			t.Errorf("Expected %d remaining codes, got %d", recoveryCodeCount-1, len(remaining))
+		}
+		for _, hash := range remaining {
+			if hash == hashes[3] {
+				t.Error("Expected used recovery code to be removed")
+			}
+		}
+	})
+
+	t.Run("wrong code", func(t *testing.T) {
+		uc := NewMFAUsecase(&mockUserRepository{}, cfg)
+		err := uc.VerifyCode(ctx, newTOTPUser(t, cfg, secret, true), "abcde-fghjk")
+		if !errors.Is(err, ErrInvalidMFACode) {
+			t.Errorf("Expected ErrInvalidMFACode, got %v", err)
+		}
+	})
+
+	t.Run("totp not enabled", func(t *testing.T) {
+		uc := NewMFAUsecase(&mockUserRepository{}, cfg)
+		err := uc.VerifyCode(ctx, newTOTPUser(t, cfg, secret, false), "123456")
+		if !errors.Is(err, ErrTOTPNotEnabled) {
+			t.Errorf("Expected ErrTOTPNotEnabled, got %v", err)
+		}
+	})
+}
+
+func TestMFADisableTOTP(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestMFAConfig()
+	secret, _ := util.GenerateTOTPSecret()
+
+	disabled := false
+	mockRepo := &mockUserRepository{
+		disableTOTPFunc: func(ctx context.Context, id int64) error {
+			disabled = true
+			return nil
+		},
+	}
+	uc := NewMFAUsecase(mockRepo, cfg)
+	user := newTOTPUser(t, cfg, secret, true)
+
+	if err := uc.DisableTOTP(ctx, user, "000000"); !errors.Is(err, ErrInvalidMFACode) {
+		t.Errorf("Expected ErrInvalidMFACode, got %v", err)
+	}
+	if disabled {
+		t.Fatal("Expected totp to stay enabled after invalid code")
+	}
+
+	code, _ := util.GenerateTOTPCode(secret, time.Now())
+	if err := uc.DisableTOTP(ctx, user, code); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !disabled {
+		t.Error("Expected totp to be disabled")
+	}
+}
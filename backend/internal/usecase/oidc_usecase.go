@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/infrastructure"
+	"github.com/keu-5/muzee/backend/internal/repository"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+var (
+	ErrOIDCProviderNotFound = errors.New("oidc provider not found")
+	ErrOIDCAuthentication   = errors.New("oidc authentication failed")
+	ErrOIDCEmailNotVerified = errors.New("oidc email not verified")
+)
+
+// OIDCProvider is implemented by infrastructure.OIDCProvider
+type OIDCProvider interface {
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, code, codeVerifier string) (*infrastructure.OIDCIdentity, error)
+}
+
+type OIDCUsecase interface {
+	AuthorizationURL(ctx context.Context, provider, state, nonce, codeChallenge string) (string, error)
+	Authenticate(ctx context.Context, provider, code, codeVerifier, nonce string) (*domain.User, error)
+}
+
+type oidcUsecase struct {
+	providers    map[string]OIDCProvider
+	userUC       UserUsecase
+	authUC       AuthUsecase
+	identityRepo repository.UserIdentityRepository
+}
+
+func NewOIDCUsecase(providers map[string]OIDCProvider, userUC UserUsecase, authUC AuthUsecase, identityRepo repository.UserIdentityRepository) OIDCUsecase {
+	return &oidcUsecase{
+		providers:    providers,
+		userUC:       userUC,
+		authUC:       authUC,
+		identityRepo: identityRepo,
+	}
+}
+
+func (u *oidcUsecase) AuthorizationURL(ctx context.Context, provider, state, nonce, codeChallenge string) (string, error) {
+	p, ok := u.providers[provider]
+	if !ok {
+		return "", ErrOIDCProviderNotFound
+	}
+	return p.AuthCodeURL(ctx, state, nonce, codeChallenge)
+}
+
+// Authenticate exchanges the authorization code and resolves the User for the identity.
+// Known identities sign in directly; otherwise the identity is linked to the user with the
+// same verified email, or a new user is created.
+func (u *oidcUsecase) Authenticate(ctx context.Context, provider, code, codeVerifier, nonce string) (*domain.User, error) {
+	p, ok := u.providers[provider]
+	if !ok {
+		return nil, ErrOIDCProviderNotFound
+	}
+
+	identity, err := p.Exchange(ctx, code, codeVerifier)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrOIDCAuthentication, err)
+	}
+	if subtle.ConstantTimeCompare([]byte(identity.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrOIDCAuthentication)
+	}
+
+	// 1. 連携済みのアカウント
+	linked, err := u.identityRepo.GetByProviderSubject(ctx, provider, identity.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if linked != nil {
+		user, err := u.userUC.GetUserByID(ctx, linked.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			return nil, fmt.Errorf("user %d for identity %d not found", linked.UserID, linked.ID)
+		}
+		return user, nil
+	}
+
+	// 2. 未連携の場合は、プロバイダが確認済みのメールアドレスでのみ紐付ける
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, ErrOIDCEmailNotVerified
+	}
+	email := util.NormalizeEmail(identity.Email)
+
+	user, err := u.userUC.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		user, err = u.createUser(ctx, email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := u.identityRepo.Create(ctx, user.ID, provider, identity.Subject, &email); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// createUser creates a user without a usable password; the password can be set later via password reset
+func (u *oidcUsecase) createUser(ctx context.Context, email string) (*domain.User, error) {
+	randomPassword, err := util.GenerateURLSafeToken(48)
+	if err != nil {
+		return nil, err
+	}
+	passwordHash, err := u.authUC.HashPassword(randomPassword)
+	if err != nil {
+		return nil, err
+	}
+	return u.userUC.CreateUser(ctx, email, passwordHash)
+}
@@ -0,0 +1,353 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/keu-5/muzee/backend/config"
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/infrastructure"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+// Mock UserIdentityRepository
+type mockUserIdentityRepository struct {
+	createFunc               func(ctx context.Context, userID int64, provider, subject string, email *string) (*domain.UserIdentity, error)
+	getByProviderSubjectFunc func(ctx context.Context, provider, subject string) (*domain.UserIdentity, error)
+}
+
+func (m *mockUserIdentityRepository) Create(ctx context.Context, userID int64, provider, subject string, email *string) (*domain.UserIdentity, error) {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, userID, provider, subject, email)
+	}
+	return &domain.UserIdentity{
+		ID:       1,
+		UserID:   userID,
+		Provider: provider,
+		Subject:  subject,
+		Email:    email,
+	}, nil
+}
+
+func (m *mockUserIdentityRepository) GetByProviderSubject(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	if m.getByProviderSubjectFunc != nil {
+		return m.getByProviderSubjectFunc(ctx, provider, subject)
+	}
+	return nil, nil
+}
+
+// mockOIDCServer is a minimal OpenID Connect provider that issues RS256 ID tokens
+type mockOIDCServer struct {
+	server   *httptest.Server
+	key      *rsa.PrivateKey
+	clientID string
+
+	mu    sync.Mutex
+	codes map[string]mockOIDCGrant
+}
+
+type mockOIDCGrant struct {
+	codeChallenge string
+	claims        jwt.MapClaims
+}
+
+func newMockOIDCServer(t *testing.T) *mockOIDCServer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+	m := &mockOIDCServer{
+		key:      key,
+		clientID: "muzee-test",
+		codes:    map[string]mockOIDCGrant{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.server.URL,
+			"authorization_endpoint": m.server.URL + "/authorize",
+			"token_endpoint":         m.server.URL + "/token",
+			"jwks_uri":               m.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(util.JWKS{Keys: []util.JWK{{
+			Kty: "RSA",
+			Kid: "mock-key",
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		m.mu.Lock()
+		grant, ok := m.codes[r.PostForm.Get("code")]
+		delete(m.codes, r.PostForm.Get("code"))
+		m.mu.Unlock()
+
+		// PKCE: code_verifierから再計算したチャレンジが認可リクエストと一致すること
+		if !ok || r.PostForm.Get("client_id") != m.clientID ||
+			util.PKCEChallengeS256(r.PostForm.Get("code_verifier")) != grant.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, grant.claims)
+		token.Header["kid"] = "mock-key"
+		idToken, _ := token.SignedString(m.key)
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "provider-access-token",
+			"token_type":   "Bearer",
+			"id_token":     idToken,
+		})
+	})
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+func (m *mockOIDCServer) provider() *infrastructure.OIDCProvider {
+	return infrastructure.NewOIDCProvider(config.OIDCProviderConfig{
+		Name:        "mock",
+		IssuerURL:   m.server.URL,
+		ClientID:    m.clientID,
+		RedirectURL: "http://localhost:3000/auth/callback/mock",
+		Scopes:      []string{"openid", "email"},
+	}, m.server.Client())
+}
+
+// authorize simulates the user signing in at the provider and returns the authorization code
+func (m *mockOIDCServer) authorize(t *testing.T, authURL, subject, email string, emailVerified bool) string {
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("invalid authorization url: %v", err)
+	}
+	query := parsed.Query()
+	if query.Get("code_challenge_method") != "S256" {
+		t.Fatalf("expected S256 code challenge, got %q", query.Get("code_challenge_method"))
+	}
+
+	code, _ := util.GenerateURLSafeToken(16)
+	m.mu.Lock()
+	m.codes[code] = mockOIDCGrant{
+		codeChallenge: query.Get("code_challenge"),
+		claims: jwt.MapClaims{
+			"iss":            m.server.URL,
+			"aud":            query.Get("client_id"),
+			"sub":            subject,
+			"email":          email,
+			"email_verified": emailVerified,
+			"nonce":          query.Get("nonce"),
+			"iat":            time.Now().Unix(),
+			"exp":            time.Now().Add(5 * time.Minute).Unix(),
+		},
+	}
+	m.mu.Unlock()
+	return code
+}
+
+type oidcTestFlow struct {
+	uc       OIDCUsecase
+	server   *mockOIDCServer
+	verifier string
+	nonce    string
+}
+
+func newOIDCTestFlow(t *testing.T, userUC UserUsecase, identityRepo *mockUserIdentityRepository) *oidcTestFlow {
+	server := newMockOIDCServer(t)
+	uc := NewOIDCUsecase(
+		map[string]OIDCProvider{"mock": server.provider()},
+		userUC,
+		NewAuthUsecase(userUC, testPasswordHashConfig),
+		identityRepo,
+	)
+	verifier, _ := util.GeneratePKCEVerifier()
+	return &oidcTestFlow{uc: uc, server: server, verifier: verifier, nonce: "test-nonce"}
+}
+
+// login runs the full redirect flow against the mock provider
+func (f *oidcTestFlow) login(t *testing.T, subject, email string, emailVerified bool) (*domain.User, error) {
+	ctx := context.Background()
+	authURL, err := f.uc.AuthorizationURL(ctx, "mock", "test-state", f.nonce, util.PKCEChallengeS256(f.verifier))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	code := f.server.authorize(t, authURL, subject, email, emailVerified)
+	return f.uc.Authenticate(ctx, "mock", code, f.verifier, f.nonce)
+}
+
+func TestOIDCAuthenticate_CreatesUser(t *testing.T) {
+	var createdEmail string
+	var linked *domain.UserIdentity
+	userUC := &mockUserUsecase{
+		createUserFunc: func(ctx context.Context, email, passwordHash string) (*domain.User, error) {
+			createdEmail = email
+			if passwordHash == "" {
+				t.Error("Expected an unusable password hash to be set")
+			}
+			return &domain.User{ID: 10, Email: email, PasswordHash: passwordHash}, nil
+		},
+	}
+	identityRepo := &mockUserIdentityRepository{
+		createFunc: func(ctx context.Context, userID int64, provider, subject string, email *string) (*domain.UserIdentity, error) {
+			linked = &domain.UserIdentity{UserID: userID, Provider: provider, Subject: subject, Email: email}
+			return linked, nil
+		},
+	}
+	flow := newOIDCTestFlow(t, userUC, identityRepo)
+
+	user, err := flow.login(t, "sub-new", "New.User@Example.com", true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if user.ID != 10 {
+		t.Errorf("Expected user 10, got %d", user.ID)
+	}
+	if createdEmail != "new.user@example.com" {
+		t.Errorf("Expected normalized email, got %q", createdEmail)
+	}
+	if linked == nil || linked.UserID != 10 || linked.Provider != "mock" || linked.Subject != "sub-new" {
+		t.Errorf("Expected identity to be linked, got %+v", linked)
+	}
+}
+
+func TestOIDCAuthenticate_LinksExistingUserByVerifiedEmail(t *testing.T) {
+	existing := &domain.User{ID: 20, Email: "existing@example.com"}
+	userUC := &mockUserUsecase{
+		getUserByEmailFunc: func(ctx context.Context, email string) (*domain.User, error) {
+			if email == existing.Email {
+				return existing, nil
+			}
+			return nil, nil
+		},
+		createUserFunc: func(ctx context.Context, email, passwordHash string) (*domain.User, error) {
+			t.Error("Expected no user to be created")
+			return nil, errors.New("unexpected create")
+		},
+	}
+	var linkedUserID int64
+	identityRepo := &mockUserIdentityRepository{
+		createFunc: func(ctx context.Context, userID int64, provider, subject string, email *string) (*domain.UserIdentity, error) {
+			linkedUserID = userID
+			return &domain.UserIdentity{UserID: userID}, nil
+		},
+	}
+	flow := newOIDCTestFlow(t, userUC, identityRepo)
+
+	user, err := flow.login(t, "sub-existing", "existing@example.com", true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if user.ID != 20 || linkedUserID != 20 {
+		t.Errorf("Expected identity linked to user 20, got user %d linked %d", user.ID, linkedUserID)
+	}
+}
+
+func TestOIDCAuthenticate_KnownIdentity(t *testing.T) {
+	userUC := &mockUserUsecase{
+		getUserByIDFunc: func(ctx context.Context, id int64) (*domain.User, error) {
+			return &domain.User{ID: id, Email: "old@example.com"}, nil
+		},
+		getUserByEmailFunc: func(ctx context.Context, email string) (*domain.User, error) {
+			t.Error("Expected email lookup to be skipped for a linked identity")
+			return nil, nil
+		},
+	}
+	identityRepo := &mockUserIdentityRepository{
+		getByProviderSubjectFunc: func(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+			return &domain.UserIdentity{ID: 1, UserID: 30, Provider: provider, Subject: subject}, nil
+		},
+	}
+	flow := newOIDCTestFlow(t, userUC, identityRepo)
+
+	// 連携済みであれば、プロバイダ側のメールアドレスが未確認・変更済みでもログインできる
+	user, err := flow.login(t, "sub-known", "changed@example.com", false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if user.ID != 30 {
+		t.Errorf("Expected user 30, got %d", user.ID)
+	}
+}
+
+func TestOIDCAuthenticate_UnverifiedEmail(t *testing.T) {
+	userUC := &mockUserUsecase{
+		getUserByEmailFunc: func(ctx context.Context, email string) (*domain.User, error) {
+			t.Error("Expected unverified email not to be looked up")
+			return nil, nil
+		},
+	}
+	flow := newOIDCTestFlow(t, userUC, &mockUserIdentityRepository{})
+
+	_, err := flow.login(t, "sub-unverified", "victim@example.com", false)
+	if !errors.Is(err, ErrOIDCEmailNotVerified) {
+		t.Errorf("Expected ErrOIDCEmailNotVerified, got %v", err)
+	}
+}
+
+func TestOIDCAuthenticate_Failures(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("wrong code verifier", func(t *testing.T) {
+		flow := newOIDCTestFlow(t, &mockUserUsecase{}, &mockUserIdentityRepository{})
+		authURL, _ := flow.uc.AuthorizationURL(ctx, "mock", "state", flow.nonce, util.PKCEChallengeS256(flow.verifier))
+		code := flow.server.authorize(t, authURL, "sub", "a@example.com", true)
+
+		otherVerifier, _ := util.GeneratePKCEVerifier()
+		_, err := flow.uc.Authenticate(ctx, "mock", code, otherVerifier, flow.nonce)
+		if !errors.Is(err, ErrOIDCAuthentication) {
+			t.Errorf("Expected ErrOIDCAuthentication, got %v", err)
+		}
+	})
+
+	t.Run("nonce mismatch", func(t *testing.T) {
+		flow := newOIDCTestFlow(t, &mockUserUsecase{}, &mockUserIdentityRepository{})
+		authURL, _ := flow.uc.AuthorizationURL(ctx, "mock", "state", "nonce-a", util.PKCEChallengeS256(flow.verifier))
+		code := flow.server.authorize(t, authURL, "sub", "a@example.com", true)
+
+		_, err := flow.uc.Authenticate(ctx, "mock", code, flow.verifier, "nonce-b")
+		if !errors.Is(err, ErrOIDCAuthentication) {
+			t.Errorf("Expected ErrOIDCAuthentication, got %v", err)
+		}
+	})
+
+	t.Run("id token for another client", func(t *testing.T) {
+		flow := newOIDCTestFlow(t, &mockUserUsecase{}, &mockUserIdentityRepository{})
+		authURL, _ := flow.uc.AuthorizationURL(ctx, "mock", "state", flow.nonce, util.PKCEChallengeS256(flow.verifier))
+		code := flow.server.authorize(t, authURL, "sub", "a@example.com", true)
+		flow.server.mu.Lock()
+		flow.server.codes[code].claims["aud"] = "someone-else"
+		flow.server.mu.Unlock()
+
+		_, err := flow.uc.Authenticate(ctx, "mock", code, flow.verifier, flow.nonce)
+		if !errors.Is(err, ErrOIDCAuthentication) {
+			t.Errorf("Expected ErrOIDCAuthentication, got %v", err)
+		}
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		flow := newOIDCTestFlow(t, &mockUserUsecase{}, &mockUserIdentityRepository{})
+		if _, err := flow.uc.AuthorizationURL(ctx, "unknown", "state", "nonce", "challenge"); !errors.Is(err, ErrOIDCProviderNotFound) {
+			t.Errorf("Expected ErrOIDCProviderNotFound, got %v", err)
+		}
+		if _, err := flow.uc.Authenticate(ctx, "unknown", "code", "verifier", "nonce"); !errors.Is(err, ErrOIDCProviderNotFound) {
+			t.Errorf("Expected ErrOIDCProviderNotFound, got %v", err)
+		}
+	})
+}
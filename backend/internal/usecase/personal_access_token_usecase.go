@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/repository"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+// Lifetime and number limits of personal access tokens
+const (
+	DefaultPersonalAccessTokenTTL  = 30 * 24 * time.Hour
+	MaxPersonalAccessTokenTTL      = 365 * 24 * time.Hour
+	MaxPersonalAccessTokensPerUser = 50
+)
+
+// personalAccessTokenTouchInterval limits how often last-used tracking writes to the database
+const personalAccessTokenTouchInterval = time.Minute
+
+var (
+	ErrPersonalAccessTokenNotFound = errors.New("personal access token not found")
+	ErrInvalidPersonalAccessToken  = errors.New("invalid personal access token")
+	ErrTooManyPersonalAccessTokens = errors.New("too many personal access tokens")
+)
+
+type PersonalAccessTokenUsecase interface {
+	CreateToken(ctx context.Context, userID int64, name string, scopes []string, ttl time.Duration) (*domain.PersonalAccessToken, string, error)
+	ListTokens(ctx context.Context, userID int64) ([]*domain.PersonalAccessToken, error)
+	RevokeToken(ctx context.Context, userID, tokenID int64) error
+	Authenticate(ctx context.Context, rawToken, ipAddress string) (*domain.PersonalAccessToken, *domain.User, error)
+}
+
+type personalAccessTokenUsecase struct {
+	tokenRepo repository.PersonalAccessTokenRepository
+	userRepo  repository.UserRepository
+}
+
+func NewPersonalAccessTokenUsecase(tokenRepo repository.PersonalAccessTokenRepository, userRepo repository.UserRepository) PersonalAccessTokenUsecase {
+	return &personalAccessTokenUsecase{
+		tokenRepo: tokenRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// CreateToken issues a new token and returns it together with the raw value, which is never stored.
+// A zero ttl uses the default lifetime; longer ones are capped.
+func (u *personalAccessTokenUsecase) CreateToken(ctx context.Context, userID int64, name string, scopes []string, ttl time.Duration) (*domain.PersonalAccessToken, string, error) {
+	count, err := u.tokenRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if count >= MaxPersonalAccessTokensPerUser {
+		return nil, "", ErrTooManyPersonalAccessTokens
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultPersonalAccessTokenTTL
+	}
+	if ttl > MaxPersonalAccessTokenTTL {
+		ttl = MaxPersonalAccessTokenTTL
+	}
+
+	rawToken, displayPrefix, err := util.GeneratePersonalAccessToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	token, err := u.tokenRepo.Create(ctx, userID, name, util.HashToken(rawToken), displayPrefix, normalizeScopes(scopes), time.Now().Add(ttl))
+	if err != nil {
+		return nil, "", err
+	}
+	return token, rawToken, nil
+}
+
+func (u *personalAccessTokenUsecase) ListTokens(ctx context.Context, userID int64) ([]*domain.PersonalAccessToken, error) {
+	return u.tokenRepo.ListByUserID(ctx, userID)
+}
+
+func (u *personalAccessTokenUsecase) RevokeToken(ctx context.Context, userID, tokenID int64) error {
+	deleted, err := u.tokenRepo.Delete(ctx, userID, tokenID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrPersonalAccessTokenNotFound
+	}
+	return nil
+}
+
+// Authenticate resolves a raw token to the token and its owner and records the use.
+// Unknown and expired tokens, and tokens of deleted users, return ErrInvalidPersonalAccessToken.
+func (u *personalAccessTokenUsecase) Authenticate(ctx context.Context, rawToken, ipAddress string) (*domain.PersonalAccessToken, *domain.User, error) {
+	if !util.IsPersonalAccessToken(rawToken) {
+		return nil, nil, ErrInvalidPersonalAccessToken
+	}
+
+	token, err := u.tokenRepo.GetByHash(ctx, util.HashToken(rawToken))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	if token == nil || !now.Before(token.ExpiresAt) {
+		return nil, nil, ErrInvalidPersonalAccessToken
+	}
+
+	user, err := u.userRepo.GetByID(ctx, token.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, ErrInvalidPersonalAccessToken
+	}
+
+	// 最終使用日時の更新はリクエストごとではなく一定間隔に抑える
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= personalAccessTokenTouchInterval || token.LastUsedIP != ipAddress {
+		ipAddress = truncate(ipAddress, deviceIPAddressMaxLen)
+		if err := u.tokenRepo.TouchLastUsed(ctx, token.ID, now, ipAddress); err != nil {
+			return nil, nil, err
+		}
+		token.LastUsedAt = &now
+		token.LastUsedIP = ipAddress
+	}
+
+	return token, user, nil
+}
+
+// normalizeScopes removes duplicates and sorts the scopes
+func normalizeScopes(scopes []string) []string {
+	seen := make(map[string]struct{}, len(scopes))
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if _, ok := seen[scope]; ok {
+			continue
+		}
+		seen[scope] = struct{}{}
+		result = append(result, scope)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+// Mock PersonalAccessTokenRepository
+type mockPersonalAccessTokenRepository struct {
+	tokens  map[string]*domain.PersonalAccessToken
+	count   int
+	touches int
+}
+
+func newMockPersonalAccessTokenRepository() *mockPersonalAccessTokenRepository {
+	return &mockPersonalAccessTokenRepository{tokens: make(map[string]*domain.PersonalAccessToken)}
+}
+
+func (m *mockPersonalAccessTokenRepository) Create(ctx context.Context, userID int64, name, tokenHash, tokenPrefix string, scopes []string, expiresAt time.Time) (*domain.PersonalAccessToken, error) {
+	token := &domain.PersonalAccessToken{
+		ID:          int64(len(m.tokens) + 1),
+		UserID:      userID,
+		Name:        name,
+		TokenPrefix: tokenPrefix,
+		Scopes:      scopes,
+		ExpiresAt:   expiresAt,
+		CreatedAt:   time.Now(),
+	}
+	m.tokens[tokenHash] = token
+	return token, nil
+}
+
+func (m *mockPersonalAccessTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	token, ok := m.tokens[tokenHash]
+	if !ok {
+		return nil, nil
+	}
+	copied := *token
+	return &copied, nil
+}
+
+func (m *mockPersonalAccessTokenRepository) ListByUserID(ctx context.Context, userID int64) ([]*domain.PersonalAccessToken, error) {
+	return []*domain.PersonalAccessToken{}, nil
+}
+
+func (m *mockPersonalAccessTokenRepository) CountByUserID(ctx context.Context, userID int64) (int, error) {
+	return m.count, nil
+}
+
+func (m *mockPersonalAccessTokenRepository) TouchLastUsed(ctx context.Context, id int64, at time.Time, ipAddress string) error {
+	m.touches++
+	for _, token := range m.tokens {
+		if token.ID == id {
+			token.LastUsedAt = &at
+			token.LastUsedIP = ipAddress
+		}
+	}
+	return nil
+}
+
+func (m *mockPersonalAccessTokenRepository) Delete(ctx context.Context, userID, id int64) (bool, error) {
+	for hash, token := range m.tokens {
+		if token.ID == id && token.UserID == userID {
+			delete(m.tokens, hash)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestCreateToken_StoresOnlyHash(t *testing.T) {
+	repo := newMockPersonalAccessTokenRepository()
+	uc := NewPersonalAccessTokenUsecase(repo, &mockUserRepository{})
+
+	token, rawToken, err := uc.CreateToken(context.Background(), 1, "ci", []string{"me:write", "me:read", "me:write"}, 0)
+	if err != nil {
+		t.Fatalf("CreateToken() error = %v", err)
+	}
+
+	if !strings.HasPrefix(rawToken, util.PersonalAccessTokenPrefix) {
+		t.Errorf("Expected token to start with %q, got %q", util.PersonalAccessTokenPrefix, rawToken)
+	}
+	if !strings.HasPrefix(rawToken, token.TokenPrefix) || len(token.TokenPrefix) >= len(rawToken) {
+		t.Errorf("Expected a display prefix of the token, got %q", token.TokenPrefix)
+	}
+	if _, ok := repo.tokens[util.HashToken(rawToken)]; !ok {
+		t.Error("Expected the token to be stored by its hash")
+	}
+	if _, ok := repo.tokens[rawToken]; ok {
+		t.Error("Expected the raw token not to be stored")
+	}
+	if strings.Join(token.Scopes, ",") != "me:read,me:write" {
+		t.Errorf("Expected deduplicated, sorted scopes, got %v", token.Scopes)
+	}
+	if d := time.Until(token.ExpiresAt) - DefaultPersonalAccessTokenTTL; d > time.Minute || d < -time.Minute {
+		t.Errorf("Expected the default lifetime, got expiry %v", token.ExpiresAt)
+	}
+}
+
+func TestCreateToken_CapsLifetime(t *testing.T) {
+	repo := newMockPersonalAccessTokenRepository()
+	uc := NewPersonalAccessTokenUsecase(repo, &mockUserRepository{})
+
+	token, _, err := uc.CreateToken(context.Background(), 1, "ci", []string{"me:read"}, 10*MaxPersonalAccessTokenTTL)
+	if err != nil {
+		t.Fatalf("CreateToken() error = %v", err)
+	}
+	if time.Until(token.ExpiresAt) > MaxPersonalAccessTokenTTL {
+		t.Errorf("Expected lifetime to be capped at %v, got expiry %v", MaxPersonalAccessTokenTTL, token.ExpiresAt)
+	}
+}
+
+func TestCreateToken_TooMany(t *testing.T) {
+	repo := newMockPersonalAccessTokenRepository()
+	repo.count = MaxPersonalAccessTokensPerUser
+	uc := NewPersonalAccessTokenUsecase(repo, &mockUserRepository{})
+
+	if _, _, err := uc.CreateToken(context.Background(), 1, "ci", []string{"me:read"}, 0); !errors.Is(err, ErrTooManyPersonalAccessTokens) {
+		t.Errorf("Expected ErrTooManyPersonalAccessTokens, got %v", err)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	repo := newMockPersonalAccessTokenRepository()
+	deletedUserID := int64(99)
+	userRepo := &mockUserRepository{
+		getByIDFunc: func(ctx context.Context, id int64) (*domain.User, error) {
+			if id == deletedUserID {
+				return nil, nil
+			}
+			return &domain.User{ID: id, Email: "bot@example.com"}, nil
+		},
+	}
+	uc := NewPersonalAccessTokenUsecase(repo, userRepo)
+
+	_, valid, err := uc.CreateToken(context.Background(), 1, "valid", []string{"me:read"}, time.Hour)
+	if err != nil {
+		t.Fatalf("CreateToken() error = %v", err)
+	}
+	_, orphaned, _ := uc.CreateToken(context.Background(), deletedUserID, "orphaned", []string{"me:read"}, time.Hour)
+	_, expired, _ := uc.CreateToken(context.Background(), 1, "expired", []string{"me:read"}, time.Hour)
+	repo.tokens[util.HashToken(expired)].ExpiresAt = time.Now().Add(-time.Second)
+
+	token, user, err := uc.Authenticate(context.Background(), valid, "192.0.2.1")
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if user.ID != 1 || token.UserID != 1 {
+		t.Errorf("Expected user 1, got user %d token user %d", user.ID, token.UserID)
+	}
+	if token.LastUsedAt == nil || token.LastUsedIP != "192.0.2.1" {
+		t.Errorf("Expected last use to be recorded, got %v %q", token.LastUsedAt, token.LastUsedIP)
+	}
+
+	// 同じIPから続けて使われた場合は書き込まない
+	if _, _, err := uc.Authenticate(context.Background(), valid, "192.0.2.1"); err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if repo.touches != 1 {
+		t.Errorf("Expected last use to be written once, got %d writes", repo.touches)
+	}
+
+	for name, raw := range map[string]string{
+		"not a personal access token": "eyJhbGciOiJIUzI1NiJ9.e30.sig",
+		"unknown":                     util.PersonalAccessTokenPrefix + "unknown",
+		"expired":                     expired,
+		"deleted user":                orphaned,
+	} {
+		if _, _, err := uc.Authenticate(context.Background(), raw, "192.0.2.1"); !errors.Is(err, ErrInvalidPersonalAccessToken) {
+			t.Errorf("%s: expected ErrInvalidPersonalAccessToken, got %v", name, err)
+		}
+	}
+}
+
+func TestRevokeToken_NotFound(t *testing.T) {
+	repo := newMockPersonalAccessTokenRepository()
+	uc := NewPersonalAccessTokenUsecase(repo, &mockUserRepository{})
+
+	token, _, _ := uc.CreateToken(context.Background(), 1, "ci", []string{"me:read"}, 0)
+	if err := uc.RevokeToken(context.Background(), 2, token.ID); !errors.Is(err, ErrPersonalAccessTokenNotFound) {
+		t.Errorf("Expected ErrPersonalAccessTokenNotFound for another user's token, got %v", err)
+	}
+	if err := uc.RevokeToken(context.Background(), 1, token.ID); err != nil {
+		t.Errorf("RevokeToken() error = %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/repository"
+)
+
+var (
+	ErrRoleNotFound = errors.New("role not found")
+	ErrUserNotFound = errors.New("user not found")
+)
+
+// builtinRole is a role created at startup; its permissions are reset on every start
+type builtinRole struct {
+	name        string
+	description string
+	permissions []string
+}
+
+var builtinRoles = []builtinRole{
+	{
+		name:        domain.RoleAdmin,
+		description: "All permissions",
+		permissions: domain.AllPermissions,
+	},
+	{
+		name:        domain.RoleSupport,
+		description: "Investigates account issues",
+		permissions: []string{domain.PermissionSecurityEventsRead, domain.PermissionRolesRead},
+	},
+}
+
+type RoleUsecase interface {
+	EnsureBuiltinRoles(ctx context.Context) error
+	ListRoles(ctx context.Context) ([]*domain.Role, error)
+	AssignRole(ctx context.Context, userID int64, roleName string) error
+	RevokeRole(ctx context.Context, userID int64, roleName string) error
+}
+
+type roleUsecase struct {
+	roleRepo repository.RoleRepository
+}
+
+func NewRoleUsecase(roleRepo repository.RoleRepository) RoleUsecase {
+	return &roleUsecase{
+		roleRepo: roleRepo,
+	}
+}
+
+// EnsureBuiltinRoles creates the built-in roles and brings their permissions up to date
+func (u *roleUsecase) EnsureBuiltinRoles(ctx context.Context) error {
+	for _, r := range builtinRoles {
+		if _, err := u.roleRepo.Upsert(ctx, r.name, r.description, r.permissions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *roleUsecase) ListRoles(ctx context.Context) ([]*domain.Role, error) {
+	return u.roleRepo.List(ctx)
+}
+
+// AssignRole grants the role to the user; granting a role the user already has is a no-op
+func (u *roleUsecase) AssignRole(ctx context.Context, userID int64, roleName string) error {
+	role, err := u.getRole(ctx, roleName)
+	if err != nil {
+		return err
+	}
+
+	exists, err := u.roleRepo.AddToUser(ctx, userID, role.ID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// RevokeRole takes the role away from the user; revoking a role the user does not have is a no-op
+func (u *roleUsecase) RevokeRole(ctx context.Context, userID int64, roleName string) error {
+	role, err := u.getRole(ctx, roleName)
+	if err != nil {
+		return err
+	}
+
+	exists, err := u.roleRepo.RemoveFromUser(ctx, userID, role.ID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func (u *roleUsecase) getRole(ctx context.Context, name string) (*domain.Role, error) {
+	role, err := u.roleRepo.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, ErrRoleNotFound
+	}
+	return role, nil
+}
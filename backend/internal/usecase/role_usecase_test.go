@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/keu-5/muzee/backend/internal/domain"
+)
+
+// Mock RoleRepository
+type mockRoleRepository struct {
+	roles     map[string]*domain.Role
+	userIDs   map[int64]bool
+	userRoles map[int64]map[int64]bool
+}
+
+func newMockRoleRepository(userIDs ...int64) *mockRoleRepository {
+	repo := &mockRoleRepository{
+		roles:     make(map[string]*domain.Role),
+		userIDs:   make(map[int64]bool),
+		userRoles: make(map[int64]map[int64]bool),
+	}
+	for _, id := range userIDs {
+		repo.userIDs[id] = true
+		repo.userRoles[id] = make(map[int64]bool)
+	}
+	return repo
+}
+
+func (m *mockRoleRepository) Upsert(ctx context.Context, name, description string, permissions []string) (*domain.Role, error) {
+	role, ok := m.roles[name]
+	if !ok {
+		role = &domain.Role{ID: int64(len(m.roles) + 1), Name: name}
+		m.roles[name] = role
+	}
+	role.Description = description
+	role.Permissions = permissions
+	return role, nil
+}
+
+func (m *mockRoleRepository) GetByName(ctx context.Context, name string) (*domain.Role, error) {
+	return m.roles[name], nil
+}
+
+func (m *mockRoleRepository) List(ctx context.Context) ([]*domain.Role, error) {
+	roles := make([]*domain.Role, 0, len(m.roles))
+	for _, role := range m.roles {
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+func (m *mockRoleRepository) AddToUser(ctx context.Context, userID, roleID int64) (bool, error) {
+	if !m.userIDs[userID] {
+		return false, nil
+	}
+	m.userRoles[userID][roleID] = true
+	return true, nil
+}
+
+func (m *mockRoleRepository) RemoveFromUser(ctx context.Context, userID, roleID int64) (bool, error) {
+	if !m.userIDs[userID] {
+		return false, nil
+	}
+	delete(m.userRoles[userID], roleID)
+	return true, nil
+}
+
+func TestEnsureBuiltinRoles(t *testing.T) {
+	repo := newMockRoleRepository()
+	repo.roles[domain.RoleAdmin] = &domain.Role{ID: 1, Name: domain.RoleAdmin, Permissions: []string{"stale"}}
+	uc := NewRoleUsecase(repo)
+
+	if err := uc.EnsureBuiltinRoles(context.Background()); err != nil {
+		t.Fatalf("EnsureBuiltinRoles() error = %v", err)
+	}
+
+	admin := repo.roles[domain.RoleAdmin]
+	if admin.ID != 1 {
+		t.Errorf("Expected the existing admin role to be updated, got ID %d", admin.ID)
+	}
+	if len(admin.Permissions) != len(domain.AllPermissions) {
+		t.Errorf("Expected admin to have every permission, got %v", admin.Permissions)
+	}
+	if _, ok := repo.roles[domain.RoleSupport]; !ok {
+		t.Error("Expected the support role to be created")
+	}
+}
+
+func TestAssignRole(t *testing.T) {
+	repo := newMockRoleRepository(10)
+	uc := NewRoleUsecase(repo)
+	if err := uc.EnsureBuiltinRoles(context.Background()); err != nil {
+		t.Fatalf("EnsureBuiltinRoles() error = %v", err)
+	}
+
+	if err := uc.AssignRole(context.Background(), 10, domain.RoleSupport); err != nil {
+		t.Fatalf("AssignRole() error = %v", err)
+	}
+	if !repo.userRoles[10][repo.roles[domain.RoleSupport].ID] {
+		t.Error("Expected user 10 to have the support role")
+	}
+
+	if err := uc.AssignRole(context.Background(), 10, "unknown"); !errors.Is(err, ErrRoleNotFound) {
+		t.Errorf("Expected ErrRoleNotFound, got %v", err)
+	}
+	if err := uc.AssignRole(context.Background(), 11, domain.RoleSupport); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("Expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestRevokeRole(t *testing.T) {
+	repo := newMockRoleRepository(10)
+	uc := NewRoleUsecase(repo)
+	if err := uc.EnsureBuiltinRoles(context.Background()); err != nil {
+		t.Fatalf("EnsureBuiltinRoles() error = %v", err)
+	}
+	if err := uc.AssignRole(context.Background(), 10, domain.RoleAdmin); err != nil {
+		t.Fatalf("AssignRole() error = %v", err)
+	}
+
+	if err := uc.RevokeRole(context.Background(), 10, domain.RoleAdmin); err != nil {
+		t.Fatalf("RevokeRole() error = %v", err)
+	}
+	if repo.userRoles[10][repo.roles[domain.RoleAdmin].ID] {
+		t.Error("Expected the admin role to be revoked")
+	}
+
+	if err := uc.RevokeRole(context.Background(), 11, domain.RoleAdmin); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("Expected ErrUserNotFound, got %v", err)
+	}
+}
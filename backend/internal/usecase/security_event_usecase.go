@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/keu-5/muzee/backend/internal/domain"
+	"github.com/keu-5/muzee/backend/internal/repository"
+	"github.com/keu-5/muzee/backend/internal/util"
+)
+
+// Page sizes of security event queries
+const (
+	DefaultSecurityEventLimit = 50
+	MaxSecurityEventLimit     = 200
+)
+
+// Column lengths of SecurityEvent
+const (
+	securityEventEmailMaxLen    = 255
+	securityEventClientIDMaxLen = 255
+	securityEventDetailMaxLen   = 1000
+)
+
+type SecurityEventUsecase interface {
+	Record(ctx context.Context, event *domain.SecurityEvent) error
+	ListUserEvents(ctx context.Context, userID int64, beforeID int64, limit int) ([]*domain.SecurityEvent, error)
+	SearchEvents(ctx context.Context, filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, error)
+}
+
+type securityEventUsecase struct {
+	securityEventRepo repository.SecurityEventRepository
+}
+
+func NewSecurityEventUsecase(securityEventRepo repository.SecurityEventRepository) SecurityEventUsecase {
+	return &securityEventUsecase{
+		securityEventRepo: securityEventRepo,
+	}
+}
+
+// Record stores an event, cutting request-supplied values to the column lengths
+func (u *securityEventUsecase) Record(ctx context.Context, event *domain.SecurityEvent) error {
+	stored := *event
+	stored.Email = truncate(util.NormalizeEmail(stored.Email), securityEventEmailMaxLen)
+	stored.IPAddress = truncate(stored.IPAddress, deviceIPAddressMaxLen)
+	stored.UserAgent = truncate(stored.UserAgent, deviceUserAgentMaxLen)
+	stored.ClientID = truncate(stored.ClientID, securityEventClientIDMaxLen)
+	if stored.Detail != nil {
+		detail := truncate(*stored.Detail, securityEventDetailMaxLen)
+		stored.Detail = &detail
+	}
+
+	_, err := u.securityEventRepo.Create(ctx, &stored)
+	return err
+}
+
+// ListUserEvents returns the user's own events, newest first
+func (u *securityEventUsecase) ListUserEvents(ctx context.Context, userID int64, beforeID int64, limit int) ([]*domain.SecurityEvent, error) {
+	return u.SearchEvents(ctx, domain.SecurityEventFilter{
+		UserID:   &userID,
+		BeforeID: beforeID,
+		Limit:    limit,
+	})
+}
+
+// SearchEvents returns the events matching the filter, newest first
+func (u *securityEventUsecase) SearchEvents(ctx context.Context, filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, error) {
+	filter.Email = util.NormalizeEmail(filter.Email)
+	filter.Limit = clampSecurityEventLimit(filter.Limit)
+	return u.securityEventRepo.List(ctx, filter)
+}
+
+func clampSecurityEventLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultSecurityEventLimit
+	}
+	if limit > MaxSecurityEventLimit {
+		return MaxSecurityEventLimit
+	}
+	return limit
+}
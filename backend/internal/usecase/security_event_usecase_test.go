@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/keu-5/muzee/backend/internal/domain"
+)
+
+// Mock SecurityEventRepository
+type mockSecurityEventRepository struct {
+	created    []*domain.SecurityEvent
+	lastFilter domain.SecurityEventFilter
+}
+
+func (m *mockSecurityEventRepository) Create(ctx context.Context, event *domain.SecurityEvent) (*domain.SecurityEvent, error) {
+	m.created = append(m.created, event)
+	return event, nil
+}
+
+func (m *mockSecurityEventRepository) List(ctx context.Context, filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, error) {
+	m.lastFilter = filter
+	return []*domain.SecurityEvent{}, nil
+}
+
+func TestRecordSecurityEvent_NormalizesAndTruncates(t *testing.T) {
+	repo := &mockSecurityEventRepository{}
+	uc := NewSecurityEventUsecase(repo)
+
+	detail := strings.Repeat("x", 2000)
+	event := &domain.SecurityEvent{
+		Type:      domain.SecurityEventLogin,
+		Email:     "  User@Example.COM ",
+		IPAddress: "203.0.113.7",
+		UserAgent: strings.Repeat("a", 1000),
+		ClientID:  strings.Repeat("c", 300),
+		Detail:    &detail,
+	}
+	if err := uc.Record(context.Background(), event); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	if len(repo.created) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(repo.created))
+	}
+	stored := repo.created[0]
+	if stored.Email != "user@example.com" {
+		t.Errorf("Expected normalized email, got %q", stored.Email)
+	}
+	if len(stored.UserAgent) != deviceUserAgentMaxLen {
+		t.Errorf("Expected user agent to be truncated to %d, got %d", deviceUserAgentMaxLen, len(stored.UserAgent))
+	}
+	if len(stored.ClientID) != securityEventClientIDMaxLen {
+		t.Errorf("Expected client ID to be truncated to %d, got %d", securityEventClientIDMaxLen, len(stored.ClientID))
+	}
+	if len(*stored.Detail) != securityEventDetailMaxLen {
+		t.Errorf("Expected detail to be truncated to %d, got %d", securityEventDetailMaxLen, len(*stored.Detail))
+	}
+	if event.Email != "  User@Example.COM " || len(*event.Detail) != 2000 {
+		t.Error("Expected the caller's event to be left untouched")
+	}
+}
+
+func TestListUserEvents_ScopesToUser(t *testing.T) {
+	repo := &mockSecurityEventRepository{}
+	uc := NewSecurityEventUsecase(repo)
+
+	if _, err := uc.ListUserEvents(context.Background(), 42, 100, 0); err != nil {
+		t.Fatalf("ListUserEvents() error = %v", err)
+	}
+	if repo.lastFilter.UserID == nil || *repo.lastFilter.UserID != 42 {
+		t.Errorf("Expected filter on user 42, got %v", repo.lastFilter.UserID)
+	}
+	if repo.lastFilter.BeforeID != 100 {
+		t.Errorf("Expected BeforeID 100, got %d", repo.lastFilter.BeforeID)
+	}
+	if repo.lastFilter.Limit != DefaultSecurityEventLimit {
+		t.Errorf("Expected default limit %d, got %d", DefaultSecurityEventLimit, repo.lastFilter.Limit)
+	}
+}
+
+func TestSearchEvents_ClampsLimit(t *testing.T) {
+	tests := []struct {
+		limit int
+		want  int
+	}{
+		{limit: -1, want: DefaultSecurityEventLimit},
+		{limit: 0, want: DefaultSecurityEventLimit},
+		{limit: 10, want: 10},
+		{limit: 10000, want: MaxSecurityEventLimit},
+	}
+
+	for _, tt := range tests {
+		repo := &mockSecurityEventRepository{}
+		uc := NewSecurityEventUsecase(repo)
+
+		if _, err := uc.SearchEvents(context.Background(), domain.SecurityEventFilter{Email: "Admin@Example.com", Limit: tt.limit}); err != nil {
+			t.Fatalf("SearchEvents() error = %v", err)
+		}
+		if repo.lastFilter.Limit != tt.want {
+			t.Errorf("limit %d: got %d, want %d", tt.limit, repo.lastFilter.Limit, tt.want)
+		}
+		if repo.lastFilter.Email != "admin@example.com" {
+			t.Errorf("Expected normalized email filter, got %q", repo.lastFilter.Email)
+		}
+	}
+}
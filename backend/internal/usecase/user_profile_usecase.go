@@ -2,55 +2,86 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"mime/multipart"
 
 	"github.com/keu-5/muzee/backend/config"
 	"github.com/keu-5/muzee/backend/internal/domain"
-	"github.com/keu-5/muzee/backend/internal/infrastructure"
 	"github.com/keu-5/muzee/backend/internal/repository"
+	"github.com/keu-5/muzee/backend/internal/util"
 )
 
-const userIconsFolder = "user-icons"
+const (
+	userIconsFolder   = "user-icons"
+	userBannersFolder = "user-banners"
+
+	maxUsernameSuggestions = 5
+)
+
+var (
+	ErrUserProfileNotFound   = errors.New("user profile not found")
+	ErrUsernameAlreadyExists = errors.New("username already exists")
+	ErrUsernameReserved      = errors.New("username is reserved")
+	ErrUsernameConfusable    = errors.New("username is confusable with an existing username")
+	ErrInvalidUsername       = errors.New("invalid username")
+	ErrInvalidImage          = errors.New("invalid image")
+)
+
+// FileStorage stores uploaded objects; implemented by infrastructure.StorageService
+type FileStorage interface {
+	FileDeleter
+	PutObject(ctx context.Context, bucketName string, objectName string, data []byte, contentType string) error
+	GenerateUniqueObjectName(prefix string, filename string) string
+}
 
 type UserProfileUsecase interface {
-	CreateUserProfile(ctx context.Context, userID int64, name string, username string, iconFile *multipart.FileHeader) (*domain.UserProfile, error)
+	CreateUserProfile(ctx context.Context, userID int64, name string, username string, details domain.UserProfileDetails, iconFile *multipart.FileHeader, bannerFile *multipart.FileHeader) (*domain.UserProfile, error)
 	GetUserProfileByUserID(ctx context.Context, userID int64) (*domain.UserProfile, error)
-	IsUsernameAvailable(ctx context.Context, username string) (bool, error)
+	GetPublicUserProfile(ctx context.Context, username string, viewerID *int64) (*domain.UserProfile, *domain.ProfileRelationship, error)
+	UpdateUserProfile(ctx context.Context, userID int64, name *string, username *string, details domain.UserProfileDetails, iconFile *multipart.FileHeader, bannerFile *multipart.FileHeader) (*domain.UserProfile, error)
+	DeleteUserProfileIcon(ctx context.Context, userID int64) (*domain.UserProfile, error)
+	DeleteUserProfileBanner(ctx context.Context, userID int64) (*domain.UserProfile, error)
+	CheckUsernameAvailability(ctx context.Context, username string) (*domain.UsernameAvailability, error)
 }
 
 type userProfileUsecase struct {
 	userProfileRepo repository.UserProfileRepository
-	storageService  *infrastructure.StorageService
+	fileStorage     FileStorage
 	cfg             *config.Config
+	// reservedUsernames holds the skeletons of the reserved usernames
+	reservedUsernames map[string]bool
 }
 
-func NewUserProfileUsecase(userProfileRepo repository.UserProfileRepository, storageService *infrastructure.StorageService, cfg *config.Config) UserProfileUsecase {
+func NewUserProfileUsecase(userProfileRepo repository.UserProfileRepository, fileStorage FileStorage, cfg *config.Config) UserProfileUsecase {
+	reserved := make(map[string]bool, len(cfg.ReservedUsernames))
+	for _, username := range cfg.ReservedUsernames {
+		reserved[domain.UsernameSkeleton(username)] = true
+	}
+
 	return &userProfileUsecase{
-		userProfileRepo: userProfileRepo,
-		storageService:  storageService,
-		cfg:             cfg,
+		userProfileRepo:   userProfileRepo,
+		fileStorage:       fileStorage,
+		cfg:               cfg,
+		reservedUsernames: reserved,
 	}
 }
 
-func (u *userProfileUsecase) CreateUserProfile(ctx context.Context, userID int64, name string, username string, iconFile *multipart.FileHeader) (*domain.UserProfile, error) {
-	var iconPath *string
-
-	if iconFile != nil {
-		// Generate unique object name: user-icons/user_{userID}/{randomhex}.{ext}
-		prefix := fmt.Sprintf("%s/user_%d", userIconsFolder, userID)
-		objectName := u.storageService.GenerateUniqueObjectName(prefix, iconFile.Filename)
-
-		// Upload user icons to public bucket
-		if err := u.storageService.UploadFile(ctx, u.cfg.S3PublicBucket, objectName, iconFile); err != nil {
-			return nil, err
-		}
+func (u *userProfileUsecase) CreateUserProfile(ctx context.Context, userID int64, name string, username string, details domain.UserProfileDetails, iconFile *multipart.FileHeader, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
+	if err := u.checkUsername(ctx, username, userID); err != nil {
+		return nil, err
+	}
 
-		iconPath = &objectName
+	iconPath, bannerPath, err := u.uploadImages(ctx, userID, iconFile, bannerFile)
+	if err != nil {
+		return nil, err
 	}
 
-	userProfile, err := u.userProfileRepo.Create(ctx, userID, name, username, iconPath)
+	userProfile, err := u.userProfileRepo.Create(ctx, userID, name, username, iconPath, bannerPath, details)
 	if err != nil {
+		u.discardIcon(ctx, iconPath)
+		u.discardBanner(ctx, bannerPath)
 		return nil, err
 	}
 	return userProfile, nil
@@ -64,10 +95,308 @@ func (u *userProfileUsecase) GetUserProfileByUserID(ctx context.Context, userID
 	return userProfile, nil
 }
 
-func (u *userProfileUsecase) IsUsernameAvailable(ctx context.Context, username string) (bool, error) {
+// GetPublicUserProfile looks up a profile by username for display to other users.
+// Fields the viewer may not see are removed; the relationship is nil for anonymous viewers.
+func (u *userProfileUsecase) GetPublicUserProfile(ctx context.Context, username string, viewerID *int64) (*domain.UserProfile, *domain.ProfileRelationship, error) {
+	profile, err := u.userProfileRepo.GetByUsername(ctx, username)
+	if err != nil {
+		return nil, nil, err
+	}
+	if profile == nil {
+		return nil, nil, ErrUserProfileNotFound
+	}
+
+	var relationship *domain.ProfileRelationship
+	if viewerID != nil {
+		relationship = &domain.ProfileRelationship{
+			IsSelf: *viewerID == profile.UserID,
+		}
+	}
+	return domain.FilterUserProfile(profile, relationship), relationship, nil
+}
+
+// UpdateUserProfile changes the given fields; nil arguments are left unchanged.
+// A new icon or banner replaces the current one, which is deleted once the profile points at the new objects.
+func (u *userProfileUsecase) UpdateUserProfile(ctx context.Context, userID int64, name *string, username *string, details domain.UserProfileDetails, iconFile *multipart.FileHeader, bannerFile *multipart.FileHeader) (*domain.UserProfile, error) {
+	current, err := u.userProfileRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, ErrUserProfileNotFound
+	}
+
+	update := domain.UserProfileUpdate{Name: name, Details: details}
+	if username != nil && *username != current.Username {
+		// 大文字小文字だけの変更は自分のユーザーネームと衝突するため、書式のみ確認する
+		if domain.CanonicalUsername(*username) == domain.CanonicalUsername(current.Username) {
+			if !domain.ValidUsername(*username) {
+				return nil, ErrInvalidUsername
+			}
+		} else if err := u.checkUsername(ctx, *username, userID); err != nil {
+			return nil, err
+		}
+		update.Username = username
+	}
+
+	// アップロードに失敗した場合はプロフィールを変更しない
+	update.IconPath, update.BannerPath, err = u.uploadImages(ctx, userID, iconFile, bannerFile)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := u.userProfileRepo.Update(ctx, userID, update)
+	if err != nil || updated == nil {
+		u.discardIcon(ctx, update.IconPath)
+		u.discardBanner(ctx, update.BannerPath)
+		if err != nil {
+			return nil, err
+		}
+		return nil, ErrUserProfileNotFound
+	}
+
+	if update.IconPath != nil {
+		u.discardIcon(ctx, current.IconPath)
+	}
+	if update.BannerPath != nil {
+		u.discardBanner(ctx, current.BannerPath)
+	}
+	return updated, nil
+}
+
+// DeleteUserProfileIcon removes the icon from the profile first and then from storage,
+// so the profile never points at a deleted object
+func (u *userProfileUsecase) DeleteUserProfileIcon(ctx context.Context, userID int64) (*domain.UserProfile, error) {
+	current, err := u.userProfileRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, ErrUserProfileNotFound
+	}
+	if current.IconPath == nil {
+		return current, nil
+	}
+
+	updated, err := u.userProfileRepo.Update(ctx, userID, domain.UserProfileUpdate{ClearIcon: true})
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrUserProfileNotFound
+	}
+
+	u.discardIcon(ctx, current.IconPath)
+	return updated, nil
+}
+
+// DeleteUserProfileBanner removes the banner in the same order as DeleteUserProfileIcon
+func (u *userProfileUsecase) DeleteUserProfileBanner(ctx context.Context, userID int64) (*domain.UserProfile, error) {
+	current, err := u.userProfileRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, ErrUserProfileNotFound
+	}
+	if current.BannerPath == nil {
+		return current, nil
+	}
+
+	updated, err := u.userProfileRepo.Update(ctx, userID, domain.UserProfileUpdate{ClearBanner: true})
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrUserProfileNotFound
+	}
+
+	u.discardBanner(ctx, current.BannerPath)
+	return updated, nil
+}
+
+// CheckUsernameAvailability reports whether a username can be registered; when it cannot,
+// the result carries the reason and available alternatives, best first
+func (u *userProfileUsecase) CheckUsernameAvailability(ctx context.Context, username string) (*domain.UsernameAvailability, error) {
+	reason, err := u.usernameUnavailableReason(ctx, username, 0)
+	if err != nil {
+		return nil, err
+	}
+	if reason == "" {
+		return &domain.UsernameAvailability{Available: true}, nil
+	}
+
+	suggestions, err := u.suggestUsernames(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.UsernameAvailability{
+		Reason:      reason,
+		Suggestions: suggestions,
+	}, nil
+}
+
+// checkUsername returns the error for a username the user cannot take
+func (u *userProfileUsecase) checkUsername(ctx context.Context, username string, userID int64) error {
+	reason, err := u.usernameUnavailableReason(ctx, username, userID)
+	if err != nil {
+		return err
+	}
+
+	switch reason {
+	case domain.UsernameInvalid:
+		return ErrInvalidUsername
+	case domain.UsernameReserved:
+		return ErrUsernameReserved
+	case domain.UsernameTaken:
+		return ErrUsernameAlreadyExists
+	case domain.UsernameConfusable:
+		return ErrUsernameConfusable
+	default:
+		return nil
+	}
+}
+
+// usernameUnavailableReason returns why the username cannot be used, or "" when it is available.
+// Usernames that look like another user's are refused; userID is 0 for anonymous checks.
+func (u *userProfileUsecase) usernameUnavailableReason(ctx context.Context, username string, userID int64) (domain.UsernameUnavailableReason, error) {
+	if !domain.ValidUsername(username) {
+		return domain.UsernameInvalid, nil
+	}
+	skeleton := domain.UsernameSkeleton(username)
+	if u.reservedUsernames[skeleton] {
+		return domain.UsernameReserved, nil
+	}
+
 	exists, err := u.userProfileRepo.ExistsByUsername(ctx, username)
 	if err != nil {
-		return false, err
+		return "", err
+	}
+	if exists {
+		return domain.UsernameTaken, nil
+	}
+
+	used, err := u.userProfileRepo.FindUsedUsernameSkeletons(ctx, []string{skeleton}, userID)
+	if err != nil {
+		return "", err
+	}
+	if len(used) > 0 {
+		return domain.UsernameConfusable, nil
+	}
+	return "", nil
+}
+
+// suggestUsernames returns up to maxUsernameSuggestions available alternatives in the order of
+// domain.UsernameCandidates, skipping candidates that look like each other or like the username
+func (u *userProfileUsecase) suggestUsernames(ctx context.Context, username string) ([]string, error) {
+	seen := map[string]bool{domain.UsernameSkeleton(username): true}
+	var candidates, skeletons []string
+	for _, candidate := range domain.UsernameCandidates(username) {
+		skeleton := domain.UsernameSkeleton(candidate)
+		if seen[skeleton] || u.reservedUsernames[skeleton] {
+			continue
+		}
+		seen[skeleton] = true
+		candidates = append(candidates, candidate)
+		skeletons = append(skeletons, skeleton)
+	}
+
+	used, err := u.userProfileRepo.FindUsedUsernameSkeletons(ctx, skeletons, 0)
+	if err != nil {
+		return nil, err
+	}
+	usedSkeletons := make(map[string]bool, len(used))
+	for _, skeleton := range used {
+		usedSkeletons[skeleton] = true
+	}
+
+	suggestions := make([]string, 0, maxUsernameSuggestions)
+	for i, candidate := range candidates {
+		if usedSkeletons[skeletons[i]] {
+			continue
+		}
+		suggestions = append(suggestions, candidate)
+		if len(suggestions) == maxUsernameSuggestions {
+			break
+		}
+	}
+	return suggestions, nil
+}
+
+// uploadImages uploads the given icon and banner and returns their prefixes (nil when not given).
+// If one upload fails, the other is discarded.
+func (u *userProfileUsecase) uploadImages(ctx context.Context, userID int64, iconFile *multipart.FileHeader, bannerFile *multipart.FileHeader) (*string, *string, error) {
+	var iconPath, bannerPath *string
+
+	if iconFile != nil {
+		prefix, err := u.uploadImage(ctx, userIconsFolder, userID, iconFile, util.ProcessImage, domain.IconSizes)
+		if err != nil {
+			return nil, nil, err
+		}
+		iconPath = &prefix
+	}
+
+	if bannerFile != nil {
+		prefix, err := u.uploadImage(ctx, userBannersFolder, userID, bannerFile, util.ProcessBannerImage, domain.BannerSizes)
+		if err != nil {
+			u.discardIcon(ctx, iconPath)
+			return nil, nil, err
+		}
+		bannerPath = &prefix
+	}
+	return iconPath, bannerPath, nil
+}
+
+// uploadImage stores the renditions of an image in the public bucket and returns their prefix.
+// The uploaded bytes are never stored as received; only re-encoded renditions are kept.
+func (u *userProfileUsecase) uploadImage(ctx context.Context, folder string, userID int64, file *multipart.FileHeader, process func([]byte, []int) ([]util.ImageRendition, error), sizes []int) (string, error) {
+	src, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return "", err
+	}
+	renditions, err := process(data, sizes)
+	if err != nil {
+		if errors.Is(err, util.ErrUnsupportedImage) || errors.Is(err, util.ErrImageTooLarge) {
+			return "", fmt.Errorf("%w: %v", ErrInvalidImage, err)
+		}
+		return "", err
+	}
+
+	// Generate unique prefix: {folder}/user_{userID}/{uuid}/{size}.jpg
+	prefix := fmt.Sprintf("%s/user_%d", folder, userID)
+	imagePrefix := u.fileStorage.GenerateUniqueObjectName(prefix, "")
+
+	// Upload user images to public bucket
+	for _, rendition := range renditions {
+		objectName := domain.RenditionPath(imagePrefix, rendition.Size)
+		if err := u.fileStorage.PutObject(ctx, u.cfg.S3PublicBucket, objectName, rendition.Data, rendition.ContentType); err != nil {
+			u.discardObjects(ctx, domain.RenditionPaths(imagePrefix, sizes))
+			return "", err
+		}
+	}
+	return imagePrefix, nil
+}
+
+// discardIcon deletes an icon that is no longer referenced by any profile
+func (u *userProfileUsecase) discardIcon(ctx context.Context, iconPath *string) {
+	u.discardObjects(ctx, domain.IconObjectNames(iconPath))
+}
+
+// discardBanner deletes a banner that is no longer referenced by any profile
+func (u *userProfileUsecase) discardBanner(ctx context.Context, bannerPath *string) {
+	u.discardObjects(ctx, domain.BannerObjectNames(bannerPath))
+}
+
+// discardObjects deletes unreferenced objects.
+// Failures only leave orphaned objects behind, so they do not fail the request.
+func (u *userProfileUsecase) discardObjects(ctx context.Context, objectNames []string) {
+	for _, objectName := range objectNames {
+		_ = u.fileStorage.DeleteFile(ctx, u.cfg.S3PublicBucket, objectName)
 	}
-	return !exists, nil
 }
@@ -1,42 +1,136 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
 	"mime/multipart"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/keu-5/muzee/backend/config"
 	"github.com/keu-5/muzee/backend/internal/domain"
-	"github.com/keu-5/muzee/backend/internal/infrastructure"
 )
 
 // Mock UserProfileRepository
 type mockUserProfileRepository struct {
-	createFunc           func(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error)
+	createFunc           func(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error)
 	getByUserIDFunc      func(ctx context.Context, userID int64) (*domain.UserProfile, error)
+	getByUsernameFunc    func(ctx context.Context, username string) (*domain.UserProfile, error)
+	updateFunc           func(ctx context.Context, userID int64, update domain.UserProfileUpdate) (*domain.UserProfile, error)
 	existsByUserIDFunc   func(ctx context.Context, userID int64) (bool, error)
 	existsByUsernameFunc func(ctx context.Context, username string) (bool, error)
+	findSkeletonsFunc    func(ctx context.Context, skeletons []string, excludeUserID int64) ([]string, error)
+	deleteByUserIDFunc   func(ctx context.Context, userID int64) error
 }
 
-func newMockStorageService() *infrastructure.StorageService {
-	// We need to return nil here and handle it in tests with proper mocking
+// Mock FileStorage
+type mockFileStorage struct {
+	uploadErr error
+	uploaded  map[string][]byte
+	deleted   []string
+	generated int
+}
+
+func newMockStorageService() *mockFileStorage {
+	return &mockFileStorage{}
+}
+
+func (m *mockFileStorage) PutObject(ctx context.Context, bucketName string, objectName string, data []byte, contentType string) error {
+	if m.uploadErr != nil {
+		return m.uploadErr
+	}
+	if m.uploaded == nil {
+		m.uploaded = make(map[string][]byte)
+	}
+	m.uploaded[objectName] = data
+	return nil
+}
+
+func (m *mockFileStorage) DeleteFile(ctx context.Context, bucketName string, objectName string) error {
+	m.deleted = append(m.deleted, objectName)
 	return nil
 }
 
-func (m *mockUserProfileRepository) Create(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error) {
+func (m *mockFileStorage) GenerateUniqueObjectName(prefix string, filename string) string {
+	m.generated++
+	return fmt.Sprintf("%s/object-%d%s", prefix, m.generated, filepath.Ext(filename))
+}
+
+// newTestIconFile returns an uploaded form file holding the given bytes
+func newTestIconFile(t *testing.T, filename string, data []byte) *multipart.FileHeader {
+	t.Helper()
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("icon", filename)
+	if err != nil {
+		t.Fatalf("CreateFormFile() error = %v", err)
+	}
+	part.Write(data)
+	writer.Close()
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatalf("ReadForm() error = %v", err)
+	}
+	return form.File["icon"][0]
+}
+
+// testWebP is a 4x2 lossless WebP whose left half is red and right half is blue
+var testWebP = []byte{
+	0x52, 0x49, 0x46, 0x46, 0x1C, 0x00, 0x00, 0x00, 0x57, 0x45, 0x42, 0x50,
+	0x56, 0x50, 0x38, 0x4C, 0x0F, 0x00, 0x00, 0x00, 0x2F, 0x03, 0x40, 0x00,
+	0x00, 0x88, 0x03, 0xFC, 0x1F, 0xE0, 0xBF, 0xFF, 0x51, 0x5A, 0x0A, 0x00,
+}
+
+// newTestPNG encodes a width x height image whose left half is red and right half is blue
+func newTestPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if x < width/2 {
+				img.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				img.Set(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+// iconRenditionNames returns the object names of the renditions under an icon prefix
+func iconRenditionNames(iconPrefix string) []string {
+	return domain.IconObjectNames(&iconPrefix)
+}
+
+func (m *mockUserProfileRepository) Create(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error) {
 	if m.createFunc != nil {
-		return m.createFunc(ctx, userID, name, username, iconPath)
+		return m.createFunc(ctx, userID, name, username, iconPath, bannerPath, details)
 	}
 	return &domain.UserProfile{
-		ID:        1,
-		UserID:    userID,
-		Name:      name,
-		Username:  username,
-		IconPath:  iconPath,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:         1,
+		UserID:     userID,
+		Name:       name,
+		Username:   username,
+		IconPath:   iconPath,
+		BannerPath: bannerPath,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
 	}, nil
 }
 
@@ -55,6 +149,20 @@ func (m *mockUserProfileRepository) GetByUserID(ctx context.Context, userID int6
 	}, nil
 }
 
+func (m *mockUserProfileRepository) GetByUsername(ctx context.Context, username string) (*domain.UserProfile, error) {
+	if m.getByUsernameFunc != nil {
+		return m.getByUsernameFunc(ctx, username)
+	}
+	return nil, nil
+}
+
+func (m *mockUserProfileRepository) Update(ctx context.Context, userID int64, update domain.UserProfileUpdate) (*domain.UserProfile, error) {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, userID, update)
+	}
+	return nil, nil
+}
+
 func (m *mockUserProfileRepository) ExistsByUserID(ctx context.Context, userID int64) (bool, error) {
 	if m.existsByUserIDFunc != nil {
 		return m.existsByUserIDFunc(ctx, userID)
@@ -69,6 +177,20 @@ func (m *mockUserProfileRepository) ExistsByUsername(ctx context.Context, userna
 	return false, nil
 }
 
+func (m *mockUserProfileRepository) FindUsedUsernameSkeletons(ctx context.Context, skeletons []string, excludeUserID int64) ([]string, error) {
+	if m.findSkeletonsFunc != nil {
+		return m.findSkeletonsFunc(ctx, skeletons, excludeUserID)
+	}
+	return nil, nil
+}
+
+func (m *mockUserProfileRepository) DeleteByUserID(ctx context.Context, userID int64) error {
+	if m.deleteByUserIDFunc != nil {
+		return m.deleteByUserIDFunc(ctx, userID)
+	}
+	return nil
+}
+
 func TestNewUserProfileUsecase(t *testing.T) {
 	mockRepo := &mockUserProfileRepository{}
 	mockStorage := newMockStorageService()
@@ -93,7 +215,7 @@ func TestCreateUserProfile(t *testing.T) {
 		profileName  string
 		username     string
 		iconFile     *multipart.FileHeader
-		mockCreate   func(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error)
+		mockCreate   func(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error)
 		wantName     string
 		wantUsername string
 		wantErr      bool
@@ -104,7 +226,7 @@ func TestCreateUserProfile(t *testing.T) {
 			profileName: "Test User",
 			username:    "testuser",
 			iconFile:    nil,
-			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error) {
+			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error) {
 				return &domain.UserProfile{
 					ID:        1,
 					UserID:    userID,
@@ -125,7 +247,7 @@ func TestCreateUserProfile(t *testing.T) {
 			profileName: "Error User",
 			username:    "erroruser",
 			iconFile:    nil,
-			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error) {
+			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error) {
 				return nil, errors.New("database error")
 			},
 			wantName:     "",
@@ -138,7 +260,7 @@ func TestCreateUserProfile(t *testing.T) {
 			profileName: "Duplicate User",
 			username:    "duplicate",
 			iconFile:    nil,
-			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error) {
+			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error) {
 				return nil, errors.New("unique constraint violation")
 			},
 			wantName:     "",
@@ -151,7 +273,7 @@ func TestCreateUserProfile(t *testing.T) {
 			profileName: "Test User",
 			username:    "test@user",
 			iconFile:    nil,
-			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error) {
+			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error) {
 				return nil, errors.New("validation error")
 			},
 			wantName:     "",
@@ -164,7 +286,7 @@ func TestCreateUserProfile(t *testing.T) {
 			profileName: "Test User",
 			username:    "test user",
 			iconFile:    nil,
-			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error) {
+			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error) {
 				return nil, errors.New("validation error")
 			},
 			wantName:     "",
@@ -177,7 +299,7 @@ func TestCreateUserProfile(t *testing.T) {
 			profileName: "Test User",
 			username:    "test_user",
 			iconFile:    nil,
-			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error) {
+			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error) {
 				return &domain.UserProfile{
 					ID:        1,
 					UserID:    userID,
@@ -198,7 +320,7 @@ func TestCreateUserProfile(t *testing.T) {
 			profileName: "Test User",
 			username:    "test-user",
 			iconFile:    nil,
-			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error) {
+			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error) {
 				return &domain.UserProfile{
 					ID:        1,
 					UserID:    userID,
@@ -219,7 +341,7 @@ func TestCreateUserProfile(t *testing.T) {
 			profileName: "Test User",
 			username:    "test123",
 			iconFile:    nil,
-			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath *string) (*domain.UserProfile, error) {
+			mockCreate: func(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error) {
 				return &domain.UserProfile{
 					ID:        1,
 					UserID:    userID,
@@ -248,7 +370,7 @@ func TestCreateUserProfile(t *testing.T) {
 			}
 			usecase := NewUserProfileUsecase(mockRepo, mockStorage, cfg)
 
-			profile, err := usecase.CreateUserProfile(ctx, tt.userID, tt.profileName, tt.username, tt.iconFile)
+			profile, err := usecase.CreateUserProfile(ctx, tt.userID, tt.profileName, tt.username, domain.UserProfileDetails{}, tt.iconFile, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CreateUserProfile() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -276,83 +398,144 @@ func TestCreateUserProfile(t *testing.T) {
 	}
 }
 
-func TestIsUsernameAvailable(t *testing.T) {
+func TestCheckUsernameAvailability(t *testing.T) {
 	ctx := context.Background()
 
 	tests := []struct {
-		name               string
-		username           string
-		mockExistsByUsername func(ctx context.Context, username string) (bool, error)
-		wantAvailable      bool
-		wantErr            bool
+		name            string
+		username        string
+		existing        []string
+		existsErr       error
+		wantAvailable   bool
+		wantReason      domain.UsernameUnavailableReason
+		wantSuggestions []string
+		wantErr         bool
 	}{
 		{
-			name:     "username is available",
-			username: "newuser",
-			mockExistsByUsername: func(ctx context.Context, username string) (bool, error) {
-				return false, nil
-			},
+			name:          "username is available",
+			username:      "newuser",
 			wantAvailable: true,
-			wantErr:       false,
 		},
 		{
-			name:     "username is not available",
-			username: "existinguser",
-			mockExistsByUsername: func(ctx context.Context, username string) (bool, error) {
-				return true, nil
-			},
-			wantAvailable: false,
-			wantErr:       false,
+			name:          "username with underscore and digits",
+			username:      "user_name123",
+			wantAvailable: true,
 		},
 		{
-			name:     "repository error",
-			username: "testuser",
-			mockExistsByUsername: func(ctx context.Context, username string) (bool, error) {
-				return false, errors.New("database error")
-			},
-			wantAvailable: false,
-			wantErr:       true,
+			name:            "username taken",
+			username:        "existinguser",
+			existing:        []string{"existinguser"},
+			wantReason:      domain.UsernameTaken,
+			wantSuggestions: []string{"existinguser1", "existinguser2", "existinguser3", "existinguser4", "existinguser5"},
 		},
 		{
-			name:     "empty username check",
-			username: "",
-			mockExistsByUsername: func(ctx context.Context, username string) (bool, error) {
-				return false, nil
-			},
-			wantAvailable: true,
-			wantErr:       false,
+			name:            "taken ignoring case",
+			username:        "Alice",
+			existing:        []string{"alice"},
+			wantReason:      domain.UsernameTaken,
+			wantSuggestions: []string{"Alice1", "Alice2", "Alice3", "Alice4", "Alice5"},
 		},
 		{
-			name:     "username with special characters",
-			username: "user_name123",
-			mockExistsByUsername: func(ctx context.Context, username string) (bool, error) {
-				return false, nil
-			},
-			wantAvailable: true,
-			wantErr:       false,
+			name:            "suggestions skip used and look-alike names",
+			username:        "alice",
+			existing:        []string{"alice", "alicel", "alice2"},
+			wantReason:      domain.UsernameTaken,
+			wantSuggestions: []string{"alice3", "alice4", "alice5", "alice6", "alice7"},
+		},
+		{
+			name:            "confusable with an existing username",
+			username:        "paypa1",
+			existing:        []string{"PayPal"},
+			wantReason:      domain.UsernameConfusable,
+			wantSuggestions: []string{"paypa2", "paypa3", "paypa4", "paypa5", "paypa6"},
+		},
+		{
+			name:            "reserved",
+			username:        "Admin",
+			wantReason:      domain.UsernameReserved,
+			wantSuggestions: []string{"Admin1", "Admin2", "Admin3", "Admin4", "Admin5"},
+		},
+		{
+			name:       "confusable with a reserved username",
+			username:   "adm1n",
+			wantReason: domain.UsernameReserved,
+		},
+		{
+			name:            "invalid characters",
+			username:        "test@user!",
+			wantReason:      domain.UsernameInvalid,
+			wantSuggestions: []string{"testuser", "testuser1", "testuser2", "testuser3", "testuser4"},
+		},
+		{
+			name:       "non-ASCII look-alike letters",
+			username:   "аlice",
+			wantReason: domain.UsernameInvalid,
+		},
+		{
+			name:       "empty username",
+			username:   "",
+			wantReason: domain.UsernameInvalid,
+		},
+		{
+			name:      "repository error",
+			username:  "testuser",
+			existsErr: errors.New("database error"),
+			wantErr:   true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := &mockUserProfileRepository{
-				existsByUsernameFunc: tt.mockExistsByUsername,
+				existsByUsernameFunc: func(ctx context.Context, username string) (bool, error) {
+					for _, existing := range tt.existing {
+						if strings.EqualFold(existing, username) {
+							return true, tt.existsErr
+						}
+					}
+					return false, tt.existsErr
+				},
+				findSkeletonsFunc: func(ctx context.Context, skeletons []string, excludeUserID int64) ([]string, error) {
+					var used []string
+					for _, existing := range tt.existing {
+						if slices.Contains(skeletons, domain.UsernameSkeleton(existing)) {
+							used = append(used, domain.UsernameSkeleton(existing))
+						}
+					}
+					return used, nil
+				},
 			}
-			mockStorage := newMockStorageService()
 			cfg := &config.Config{
-				S3PublicBucket:  "public-uploads",
-				S3PrivateBucket: "private-uploads",
+				S3PublicBucket:    "public-uploads",
+				S3PrivateBucket:   "private-uploads",
+				ReservedUsernames: []string{"admin", "settings"},
 			}
-			usecase := NewUserProfileUsecase(mockRepo, mockStorage, cfg)
+			usecase := NewUserProfileUsecase(mockRepo, newMockStorageService(), cfg)
 
-			available, err := usecase.IsUsernameAvailable(ctx, tt.username)
+			availability, err := usecase.CheckUsernameAvailability(ctx, tt.username)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("IsUsernameAvailable() error = %v, wantErr %v", err, tt.wantErr)
+				t.Fatalf("CheckUsernameAvailability() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
 				return
 			}
 
-			if available != tt.wantAvailable {
-				t.Errorf("IsUsernameAvailable() available = %v, want %v", available, tt.wantAvailable)
+			if availability.Available != tt.wantAvailable {
+				t.Errorf("CheckUsernameAvailability() available = %v, want %v", availability.Available, tt.wantAvailable)
+			}
+			if availability.Reason != tt.wantReason {
+				t.Errorf("CheckUsernameAvailability() reason = %v, want %v", availability.Reason, tt.wantReason)
+			}
+			if tt.wantSuggestions != nil && !slices.Equal(availability.Suggestions, tt.wantSuggestions) {
+				t.Errorf("CheckUsernameAvailability() suggestions = %v, want %v", availability.Suggestions, tt.wantSuggestions)
+			}
+			if tt.wantAvailable && len(availability.Suggestions) != 0 {
+				t.Errorf("Expected no suggestions for an available username, got %v", availability.Suggestions)
+			}
+			for _, suggestion := range availability.Suggestions {
+				if !domain.ValidUsername(suggestion) {
+					t.Errorf("Suggestion %q is not a valid username", suggestion)
+				}
 			}
 		})
 	}
@@ -479,3 +662,570 @@ func TestGetUserProfileByUserID(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateUserProfile(t *testing.T) {
+	ctx := context.Background()
+	oldIcon := "user-icons/user_42/old.png"
+	newName := "New Name"
+	sameUsername := "current"
+	takenUsername := "taken"
+	caseUsername := "Current"
+	reservedUsername := "Settings"
+	confusableUsername := "paypa1"
+	invalidUsername := "bad name"
+	icon := newTestIconFile(t, "icon.png", newTestPNG(t, 40, 20))
+	notImage := newTestIconFile(t, "icon.png", []byte("This is not an image"))
+	newIcon := "user-icons/user_42/object-1"
+
+	tests := []struct {
+		name        string
+		current     *domain.UserProfile
+		profileName *string
+		username    *string
+		iconFile    *multipart.FileHeader
+		uploadErr   error
+		updateErr   error
+		wantErr     error
+		wantAnyErr  bool
+		wantUpdate  *domain.UserProfileUpdate
+		wantDeleted []string
+	}{
+		{
+			name:        "name only",
+			current:     &domain.UserProfile{Username: "current", IconPath: &oldIcon},
+			profileName: &newName,
+			wantUpdate:  &domain.UserProfileUpdate{Name: &newName},
+		},
+		{
+			name:       "unchanged username is not checked",
+			current:    &domain.UserProfile{Username: "current"},
+			username:   &sameUsername,
+			wantUpdate: &domain.UserProfileUpdate{},
+		},
+		{
+			name:     "username taken",
+			current:  &domain.UserProfile{Username: "current"},
+			username: &takenUsername,
+			wantErr:  ErrUsernameAlreadyExists,
+		},
+		{
+			name:       "case-only change is not checked",
+			current:    &domain.UserProfile{Username: "current"},
+			username:   &caseUsername,
+			wantUpdate: &domain.UserProfileUpdate{Username: &caseUsername},
+		},
+		{
+			name:     "username reserved",
+			current:  &domain.UserProfile{Username: "current"},
+			username: &reservedUsername,
+			wantErr:  ErrUsernameReserved,
+		},
+		{
+			name:     "username confusable with another user's",
+			current:  &domain.UserProfile{Username: "current"},
+			username: &confusableUsername,
+			wantErr:  ErrUsernameConfusable,
+		},
+		{
+			name:     "invalid username",
+			current:  &domain.UserProfile{Username: "current"},
+			username: &invalidUsername,
+			wantErr:  ErrInvalidUsername,
+		},
+		{
+			name:    "profile not found",
+			current: nil,
+			wantErr: ErrUserProfileNotFound,
+		},
+		{
+			name:        "icon replaced",
+			current:     &domain.UserProfile{Username: "current", IconPath: &oldIcon},
+			iconFile:    icon,
+			wantDeleted: []string{oldIcon},
+		},
+		{
+			name:        "upload failure leaves profile unchanged",
+			current:     &domain.UserProfile{Username: "current", IconPath: &oldIcon},
+			iconFile:    icon,
+			uploadErr:   errors.New("storage error"),
+			wantAnyErr:  true,
+			wantDeleted: iconRenditionNames(newIcon),
+		},
+		{
+			name:     "invalid image",
+			current:  &domain.UserProfile{Username: "current", IconPath: &oldIcon},
+			iconFile: notImage,
+			wantErr:  ErrInvalidImage,
+		},
+		{
+			name:        "update failure discards new icon",
+			current:     &domain.UserProfile{Username: "current", IconPath: &oldIcon},
+			iconFile:    icon,
+			updateErr:   errors.New("database error"),
+			wantAnyErr:  true,
+			wantDeleted: iconRenditionNames(newIcon),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUpdate *domain.UserProfileUpdate
+			mockRepo := &mockUserProfileRepository{
+				getByUserIDFunc: func(ctx context.Context, userID int64) (*domain.UserProfile, error) {
+					return tt.current, nil
+				},
+				existsByUsernameFunc: func(ctx context.Context, username string) (bool, error) {
+					if strings.EqualFold(username, sameUsername) {
+						t.Error("ExistsByUsername should not be called for the current username")
+					}
+					return username == takenUsername, nil
+				},
+				findSkeletonsFunc: func(ctx context.Context, skeletons []string, excludeUserID int64) ([]string, error) {
+					if excludeUserID != 42 {
+						t.Errorf("Expected the user's own profile to be excluded, got %d", excludeUserID)
+					}
+					// 他のユーザーが「paypal」を使用している
+					if slices.Contains(skeletons, "paypal") {
+						return []string{"paypal"}, nil
+					}
+					return nil, nil
+				},
+				updateFunc: func(ctx context.Context, userID int64, update domain.UserProfileUpdate) (*domain.UserProfile, error) {
+					gotUpdate = &update
+					if tt.updateErr != nil {
+						return nil, tt.updateErr
+					}
+					return &domain.UserProfile{UserID: userID, IconPath: update.IconPath}, nil
+				},
+			}
+			storage := newMockStorageService()
+			storage.uploadErr = tt.uploadErr
+			usecase := NewUserProfileUsecase(mockRepo, storage, &config.Config{S3PublicBucket: "public-uploads", ReservedUsernames: []string{"settings"}})
+
+			profile, err := usecase.UpdateUserProfile(ctx, 42, tt.profileName, tt.username, domain.UserProfileDetails{}, tt.iconFile, nil)
+			if tt.wantErr != nil || tt.wantAnyErr {
+				if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+					t.Fatalf("UpdateUserProfile() error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("UpdateUserProfile() error = %v", err)
+			}
+
+			if (tt.uploadErr != nil || errors.Is(tt.wantErr, ErrInvalidImage)) && gotUpdate != nil {
+				t.Error("Expected no profile update after a failed upload")
+			}
+			if tt.wantUpdate != nil {
+				if gotUpdate == nil {
+					t.Fatal("Expected a profile update")
+				}
+				if (gotUpdate.Name == nil) != (tt.wantUpdate.Name == nil) || (gotUpdate.Username == nil) != (tt.wantUpdate.Username == nil) || gotUpdate.IconPath != nil {
+					t.Errorf("Update = %+v, want %+v", gotUpdate, tt.wantUpdate)
+				}
+			}
+			if tt.iconFile != nil && err == nil {
+				if profile.IconPath == nil || *profile.IconPath != newIcon {
+					t.Errorf("Expected the profile to point at the new icon, got %v", profile.IconPath)
+				}
+				if len(storage.uploaded) != len(domain.IconSizes) {
+					t.Errorf("Expected %d renditions, got %d", len(domain.IconSizes), len(storage.uploaded))
+				}
+			}
+			if fmt.Sprint(storage.deleted) != fmt.Sprint(tt.wantDeleted) {
+				t.Errorf("Deleted objects = %v, want %v", storage.deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestDeleteUserProfileIcon(t *testing.T) {
+	ctx := context.Background()
+	icon := "user-icons/user_42/icon.png"
+
+	tests := []struct {
+		name        string
+		current     *domain.UserProfile
+		updateErr   error
+		wantErr     bool
+		wantCleared bool
+		wantDeleted []string
+	}{
+		{name: "icon removed", current: &domain.UserProfile{IconPath: &icon}, wantCleared: true, wantDeleted: []string{icon}},
+		{name: "no icon", current: &domain.UserProfile{}},
+		{name: "profile not found", current: nil, wantErr: true},
+		{name: "update failure keeps object", current: &domain.UserProfile{IconPath: &icon}, updateErr: errors.New("database error"), wantErr: true, wantCleared: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cleared bool
+			mockRepo := &mockUserProfileRepository{
+				getByUserIDFunc: func(ctx context.Context, userID int64) (*domain.UserProfile, error) {
+					return tt.current, nil
+				},
+				updateFunc: func(ctx context.Context, userID int64, update domain.UserProfileUpdate) (*domain.UserProfile, error) {
+					cleared = update.ClearIcon
+					if tt.updateErr != nil {
+						return nil, tt.updateErr
+					}
+					return &domain.UserProfile{UserID: userID}, nil
+				},
+			}
+			storage := newMockStorageService()
+			usecase := NewUserProfileUsecase(mockRepo, storage, &config.Config{S3PublicBucket: "public-uploads"})
+
+			profile, err := usecase.DeleteUserProfileIcon(ctx, 42)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeleteUserProfileIcon() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.current == nil && !errors.Is(err, ErrUserProfileNotFound) {
+				t.Errorf("Expected ErrUserProfileNotFound, got %v", err)
+			}
+			if !tt.wantErr && profile.IconPath != nil {
+				t.Errorf("Expected no icon, got %v", *profile.IconPath)
+			}
+			if cleared != tt.wantCleared {
+				t.Errorf("ClearIcon = %v, want %v", cleared, tt.wantCleared)
+			}
+			if fmt.Sprint(storage.deleted) != fmt.Sprint(tt.wantDeleted) {
+				t.Errorf("Deleted objects = %v, want %v", storage.deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestGetPublicUserProfile(t *testing.T) {
+	ctx := context.Background()
+	ownerID := int64(42)
+	otherID := int64(7)
+
+	tests := []struct {
+		name             string
+		username         string
+		viewerID         *int64
+		wantErr          error
+		wantRelationship *domain.ProfileRelationship
+	}{
+		{name: "anonymous viewer", username: "owner"},
+		{name: "owner views own profile", username: "owner", viewerID: &ownerID, wantRelationship: &domain.ProfileRelationship{IsSelf: true}},
+		{name: "other user", username: "owner", viewerID: &otherID, wantRelationship: &domain.ProfileRelationship{IsSelf: false}},
+		{name: "unknown username", username: "nobody", wantErr: ErrUserProfileNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockUserProfileRepository{
+				getByUsernameFunc: func(ctx context.Context, username string) (*domain.UserProfile, error) {
+					if username != "owner" {
+						return nil, nil
+					}
+					return &domain.UserProfile{ID: 1, UserID: ownerID, Username: "owner"}, nil
+				},
+			}
+			usecase := NewUserProfileUsecase(mockRepo, newMockStorageService(), &config.Config{})
+
+			profile, relationship, err := usecase.GetPublicUserProfile(ctx, tt.username, tt.viewerID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("GetPublicUserProfile() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetPublicUserProfile() error = %v", err)
+			}
+			if profile.Username != tt.username {
+				t.Errorf("Username = %v, want %v", profile.Username, tt.username)
+			}
+			if (relationship == nil) != (tt.wantRelationship == nil) {
+				t.Fatalf("Relationship = %+v, want %+v", relationship, tt.wantRelationship)
+			}
+			if relationship != nil && *relationship != *tt.wantRelationship {
+				t.Errorf("Relationship = %+v, want %+v", *relationship, *tt.wantRelationship)
+			}
+		})
+	}
+}
+
+func TestUploadIconRenditions(t *testing.T) {
+	ctx := context.Background()
+
+	// 40x20の画像にEXIFの向き6（時計回りに90度回転）を付けたJPEG
+	var buf bytes.Buffer
+	src, _ := png.Decode(bytes.NewReader(newTestPNG(t, 40, 20)))
+	if err := jpeg.Encode(&buf, src, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatalf("jpeg.Encode() error = %v", err)
+	}
+	exif := []byte{
+		0xFF, 0xE1, 0x00, 0x22, 'E', 'x', 'i', 'f', 0x00, 0x00,
+		'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08,
+		0x00, 0x01,
+		0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, 0x06, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	rotated := append(append([]byte{0xFF, 0xD8}, exif...), buf.Bytes()[2:]...)
+
+	tests := []struct {
+		name string
+		file *multipart.FileHeader
+		// color of the bottom-left corner of the square crop
+		wantBottomLeftBlue bool
+	}{
+		{name: "png without orientation", file: newTestIconFile(t, "icon.png", newTestPNG(t, 40, 20)), wantBottomLeftBlue: false},
+		{name: "jpeg rotated by exif", file: newTestIconFile(t, "icon.jpg", rotated), wantBottomLeftBlue: true},
+		{name: "webp", file: newTestIconFile(t, "icon.webp", testWebP), wantBottomLeftBlue: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockUserProfileRepository{}
+			storage := newMockStorageService()
+			usecase := NewUserProfileUsecase(mockRepo, storage, &config.Config{S3PublicBucket: "public-uploads"})
+
+			profile, err := usecase.CreateUserProfile(ctx, 42, "Test User", "testuser", domain.UserProfileDetails{}, tt.file, nil)
+			if err != nil {
+				t.Fatalf("CreateUserProfile() error = %v", err)
+			}
+			if profile.IconPath == nil || *profile.IconPath != "user-icons/user_42/object-1" {
+				t.Fatalf("Expected the icon prefix to be stored, got %v", profile.IconPath)
+			}
+
+			for _, size := range domain.IconSizes {
+				data, ok := storage.uploaded[domain.RenditionPath(*profile.IconPath, size)]
+				if !ok {
+					t.Fatalf("Expected a %dpx rendition", size)
+				}
+				if bytes.Contains(data, []byte("Exif")) {
+					t.Errorf("Expected EXIF to be stripped from the %dpx rendition", size)
+				}
+				img, format, err := image.Decode(bytes.NewReader(data))
+				if err != nil {
+					t.Fatalf("Decode() error = %v", err)
+				}
+				if format != "jpeg" || img.Bounds().Dx() != size || img.Bounds().Dy() != size {
+					t.Errorf("Expected a %dx%d jpeg, got %dx%d %s", size, size, img.Bounds().Dx(), img.Bounds().Dy(), format)
+				}
+
+				r, _, b, _ := img.At(1, size-2).RGBA()
+				if (b > r) != tt.wantBottomLeftBlue {
+					t.Errorf("Bottom-left pixel of the %dpx rendition: r=%d b=%d, want blue=%v", size, r>>8, b>>8, tt.wantBottomLeftBlue)
+				}
+			}
+		})
+	}
+}
+
+func TestGetPublicUserProfile_FieldVisibility(t *testing.T) {
+	ctx := context.Background()
+	ownerID := int64(42)
+	otherID := int64(7)
+	bio := "hello"
+	location := "Tokyo"
+	pronouns := "they/them"
+	banner := "user-banners/user_42/banner"
+	birthday := time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		viewerID     *int64
+		wantLocation bool
+		wantBirthday bool
+	}{
+		{name: "anonymous viewer", viewerID: nil},
+		{name: "other user", viewerID: &otherID},
+		{name: "owner", viewerID: &ownerID, wantLocation: true, wantBirthday: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockUserProfileRepository{
+				getByUsernameFunc: func(ctx context.Context, username string) (*domain.UserProfile, error) {
+					return &domain.UserProfile{
+						UserID:     ownerID,
+						Username:   username,
+						BannerPath: &banner,
+						Bio:        &bio,
+						Links:      []string{"https://example.com"},
+						Location:   &location,
+						Birthday:   &birthday,
+						Pronouns:   &pronouns,
+						Visibility: map[domain.ProfileField]domain.Visibility{
+							domain.ProfileFieldLocation: domain.VisibilityFollowers,
+							domain.ProfileFieldBirthday: domain.VisibilityOnlyMe,
+						},
+					}, nil
+				},
+			}
+			usecase := NewUserProfileUsecase(mockRepo, newMockStorageService(), &config.Config{})
+
+			profile, _, err := usecase.GetPublicUserProfile(ctx, "owner", tt.viewerID)
+			if err != nil {
+				t.Fatalf("GetPublicUserProfile() error = %v", err)
+			}
+			if profile.Bio == nil || profile.Pronouns == nil || profile.BannerPath == nil || len(profile.Links) != 1 {
+				t.Errorf("Expected public fields to be visible, got %+v", profile)
+			}
+			if (profile.Location != nil) != tt.wantLocation {
+				t.Errorf("Location visible = %v, want %v", profile.Location != nil, tt.wantLocation)
+			}
+			if (profile.Birthday != nil) != tt.wantBirthday {
+				t.Errorf("Birthday visible = %v, want %v", profile.Birthday != nil, tt.wantBirthday)
+			}
+		})
+	}
+}
+
+func TestUploadBannerRenditions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("banner cropped to 3:1", func(t *testing.T) {
+		storage := newMockStorageService()
+		usecase := NewUserProfileUsecase(&mockUserProfileRepository{}, storage, &config.Config{S3PublicBucket: "public-uploads"})
+
+		banner := newTestIconFile(t, "banner.png", newTestPNG(t, 40, 40))
+		profile, err := usecase.CreateUserProfile(ctx, 42, "Test User", "testuser", domain.UserProfileDetails{}, nil, banner)
+		if err != nil {
+			t.Fatalf("CreateUserProfile() error = %v", err)
+		}
+		if profile.BannerPath == nil || *profile.BannerPath != "user-banners/user_42/object-1" {
+			t.Fatalf("Expected the banner prefix to be stored, got %v", profile.BannerPath)
+		}
+
+		for _, width := range domain.BannerSizes {
+			data, ok := storage.uploaded[domain.RenditionPath(*profile.BannerPath, width)]
+			if !ok {
+				t.Fatalf("Expected a %dpx rendition", width)
+			}
+			cfg, err := jpeg.DecodeConfig(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("DecodeConfig() error = %v", err)
+			}
+			if cfg.Width != width || cfg.Height != width/3 {
+				t.Errorf("Expected a %dx%d rendition, got %dx%d", width, width/3, cfg.Width, cfg.Height)
+			}
+		}
+	})
+
+	t.Run("invalid banner discards the new icon", func(t *testing.T) {
+		storage := newMockStorageService()
+		var created bool
+		mockRepo := &mockUserProfileRepository{
+			createFunc: func(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error) {
+				created = true
+				return nil, nil
+			},
+		}
+		usecase := NewUserProfileUsecase(mockRepo, storage, &config.Config{S3PublicBucket: "public-uploads"})
+
+		icon := newTestIconFile(t, "icon.png", newTestPNG(t, 40, 20))
+		banner := newTestIconFile(t, "banner.png", []byte("This is not an image"))
+		_, err := usecase.CreateUserProfile(ctx, 42, "Test User", "testuser", domain.UserProfileDetails{}, icon, banner)
+		if !errors.Is(err, ErrInvalidImage) {
+			t.Fatalf("CreateUserProfile() error = %v, want %v", err, ErrInvalidImage)
+		}
+		if created {
+			t.Error("Expected no profile to be created")
+		}
+		if want := iconRenditionNames("user-icons/user_42/object-1"); fmt.Sprint(storage.deleted) != fmt.Sprint(want) {
+			t.Errorf("Deleted objects = %v, want %v", storage.deleted, want)
+		}
+	})
+}
+
+func TestDeleteUserProfileBanner(t *testing.T) {
+	ctx := context.Background()
+	banner := "user-banners/user_42/banner"
+
+	tests := []struct {
+		name        string
+		current     *domain.UserProfile
+		wantErr     bool
+		wantCleared bool
+		wantDeleted []string
+	}{
+		{name: "banner removed", current: &domain.UserProfile{BannerPath: &banner}, wantCleared: true, wantDeleted: domain.BannerObjectNames(&banner)},
+		{name: "no banner", current: &domain.UserProfile{}},
+		{name: "profile not found", current: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cleared bool
+			mockRepo := &mockUserProfileRepository{
+				getByUserIDFunc: func(ctx context.Context, userID int64) (*domain.UserProfile, error) {
+					return tt.current, nil
+				},
+				updateFunc: func(ctx context.Context, userID int64, update domain.UserProfileUpdate) (*domain.UserProfile, error) {
+					cleared = update.ClearBanner
+					return &domain.UserProfile{UserID: userID}, nil
+				},
+			}
+			storage := newMockStorageService()
+			usecase := NewUserProfileUsecase(mockRepo, storage, &config.Config{S3PublicBucket: "public-uploads"})
+
+			profile, err := usecase.DeleteUserProfileBanner(ctx, 42)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeleteUserProfileBanner() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && profile.BannerPath != nil {
+				t.Errorf("Expected no banner, got %v", *profile.BannerPath)
+			}
+			if cleared != tt.wantCleared {
+				t.Errorf("ClearBanner = %v, want %v", cleared, tt.wantCleared)
+			}
+			if fmt.Sprint(storage.deleted) != fmt.Sprint(tt.wantDeleted) {
+				t.Errorf("Deleted objects = %v, want %v", storage.deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestCreateUserProfile_UsernameRules(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		username string
+		wantErr  error
+	}{
+		{name: "available", username: "newuser"},
+		{name: "taken ignoring case", username: "OLIVER", wantErr: ErrUsernameAlreadyExists},
+		{name: "confusable", username: "0liver", wantErr: ErrUsernameConfusable},
+		{name: "reserved", username: "ADMIN", wantErr: ErrUsernameReserved},
+		{name: "invalid", username: "名前", wantErr: ErrInvalidUsername},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created bool
+			mockRepo := &mockUserProfileRepository{
+				existsByUsernameFunc: func(ctx context.Context, username string) (bool, error) {
+					return strings.EqualFold(username, "oliver"), nil
+				},
+				findSkeletonsFunc: func(ctx context.Context, skeletons []string, excludeUserID int64) ([]string, error) {
+					if slices.Contains(skeletons, domain.UsernameSkeleton("oliver")) {
+						return []string{domain.UsernameSkeleton("oliver")}, nil
+					}
+					return nil, nil
+				},
+				createFunc: func(ctx context.Context, userID int64, name string, username string, iconPath, bannerPath *string, details domain.UserProfileDetails) (*domain.UserProfile, error) {
+					created = true
+					return &domain.UserProfile{UserID: userID, Username: username}, nil
+				},
+			}
+			storage := newMockStorageService()
+			usecase := NewUserProfileUsecase(mockRepo, storage, &config.Config{S3PublicBucket: "public-uploads", ReservedUsernames: []string{"admin"}})
+
+			icon := newTestIconFile(t, "icon.png", newTestPNG(t, 40, 20))
+			_, err := usecase.CreateUserProfile(ctx, 42, "Test User", tt.username, domain.UserProfileDetails{}, icon, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateUserProfile() error = %v, want %v", err, tt.wantErr)
+			}
+			if created != (tt.wantErr == nil) {
+				t.Errorf("Profile created = %v, want %v", created, tt.wantErr == nil)
+			}
+			if tt.wantErr != nil && len(storage.uploaded) != 0 {
+				t.Error("Expected no upload for a refused username")
+			}
+		})
+	}
+}
@@ -2,17 +2,23 @@ package usecase
 
 import (
 	"context"
-	"strings"
+	"errors"
 
 	"github.com/keu-5/muzee/backend/internal/domain"
 	"github.com/keu-5/muzee/backend/internal/repository"
+	"github.com/keu-5/muzee/backend/internal/util"
 )
 
+var ErrEmailAlreadyExists = errors.New("email already exists")
+
 type UserUsecase interface {
 	CreateUser(ctx context.Context, email, passwordHash string) (*domain.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*domain.User, error)
 	GetUserByID(ctx context.Context, id int64) (*domain.User, error)
 	CheckUserProfileExists(ctx context.Context, userID int64) (bool, error)
+	UpdatePassword(ctx context.Context, userID int64, passwordHash string) error
+	UpdateEmail(ctx context.Context, userID int64, email string) error
+	CancelAccountDeletion(ctx context.Context, userID int64) error
 }
 
 type userUsecase struct {
@@ -28,7 +34,7 @@ func NewUserUsecase(userRepo repository.UserRepository, userProfileRepo reposito
 }
 
 func (u *userUsecase) CreateUser(ctx context.Context, email, passwordHash string) (*domain.User, error) {
-	email = strings.ToLower(strings.TrimSpace(email))
+	email = util.NormalizeEmail(email)
 	user, err := u.userRepo.Create(ctx, email, passwordHash)
 	if err != nil {
 		return nil, err
@@ -37,7 +43,7 @@ func (u *userUsecase) CreateUser(ctx context.Context, email, passwordHash string
 }
 
 func (u *userUsecase) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
-	email = strings.ToLower(strings.TrimSpace(email))
+	email = util.NormalizeEmail(email)
 	return u.userRepo.GetByEmail(ctx, email)
 }
 
@@ -48,3 +54,25 @@ func (u *userUsecase) GetUserByID(ctx context.Context, id int64) (*domain.User,
 func (u *userUsecase) CheckUserProfileExists(ctx context.Context, userID int64) (bool, error) {
 	return u.userProfileRepo.ExistsByUserID(ctx, userID)
 }
+
+func (u *userUsecase) UpdatePassword(ctx context.Context, userID int64, passwordHash string) error {
+	return u.userRepo.UpdatePasswordHash(ctx, userID, passwordHash)
+}
+
+// UpdateEmail changes the login email of the user; the address must not belong to another user
+func (u *userUsecase) UpdateEmail(ctx context.Context, userID int64, email string) error {
+	email = util.NormalizeEmail(email)
+	existing, err := u.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != userID {
+		return ErrEmailAlreadyExists
+	}
+	return u.userRepo.UpdateEmail(ctx, userID, email)
+}
+
+// CancelAccountDeletion keeps the account when the user comes back within the grace period
+func (u *userUsecase) CancelAccountDeletion(ctx context.Context, userID int64) error {
+	return u.userRepo.CancelDeletion(ctx, userID)
+}
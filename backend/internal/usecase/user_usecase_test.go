@@ -14,6 +14,20 @@ type mockUserRepository struct {
 	createFunc     func(ctx context.Context, email, passwordHash string) (*domain.User, error)
 	getByEmailFunc func(ctx context.Context, email string) (*domain.User, error)
 	getByIDFunc    func(ctx context.Context, id int64) (*domain.User, error)
+
+	updatePasswordHashFunc func(ctx context.Context, id int64, passwordHash string) error
+	updateEmailFunc        func(ctx context.Context, id int64, email string) error
+
+	setPendingTOTPSecretFunc     func(ctx context.Context, id int64, encryptedSecret string) error
+	enableTOTPFunc               func(ctx context.Context, id int64, usedStep int64, recoveryCodeHashes []string) error
+	disableTOTPFunc              func(ctx context.Context, id int64) error
+	consumeTOTPStepFunc          func(ctx context.Context, id int64, step int64) (bool, error)
+	updateRecoveryCodeHashesFunc func(ctx context.Context, id int64, recoveryCodeHashes []string) error
+
+	scheduleDeletionFunc   func(ctx context.Context, id int64, at time.Time) error
+	cancelDeletionFunc     func(ctx context.Context, id int64) error
+	listDueForDeletionFunc func(ctx context.Context, before time.Time, limit int) ([]*domain.User, error)
+	deleteFunc             func(ctx context.Context, id int64) error
 }
 
 func (m *mockUserRepository) Create(ctx context.Context, email, passwordHash string) (*domain.User, error) {
@@ -49,6 +63,83 @@ func (m *mockUserRepository) GetByID(ctx context.Context, id int64) (*domain.Use
 	}, nil
 }
 
+func (m *mockUserRepository) UpdatePasswordHash(ctx context.Context, id int64, passwordHash string) error {
+	if m.updatePasswordHashFunc != nil {
+		return m.updatePasswordHashFunc(ctx, id, passwordHash)
+	}
+	return nil
+}
+
+func (m *mockUserRepository) UpdateEmail(ctx context.Context, id int64, email string) error {
+	if m.updateEmailFunc != nil {
+		return m.updateEmailFunc(ctx, id, email)
+	}
+	return nil
+}
+
+func (m *mockUserRepository) SetPendingTOTPSecret(ctx context.Context, id int64, encryptedSecret string) error {
+	if m.setPendingTOTPSecretFunc != nil {
+		return m.setPendingTOTPSecretFunc(ctx, id, encryptedSecret)
+	}
+	return nil
+}
+
+func (m *mockUserRepository) EnableTOTP(ctx context.Context, id int64, usedStep int64, recoveryCodeHashes []string) error {
+	if m.enableTOTPFunc != nil {
+		return m.enableTOTPFunc(ctx, id, usedStep, recoveryCodeHashes)
+	}
+	return nil
+}
+
+func (m *mockUserRepository) DisableTOTP(ctx context.Context, id int64) error {
+	if m.disableTOTPFunc != nil {
+		return m.disableTOTPFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *mockUserRepository) ConsumeTOTPStep(ctx context.Context, id int64, step int64) (bool, error) {
+	if m.consumeTOTPStepFunc != nil {
+		return m.consumeTOTPStepFunc(ctx, id, step)
+	}
+	return true, nil
+}
+
+func (m *mockUserRepository) UpdateRecoveryCodeHashes(ctx context.Context, id int64, recoveryCodeHashes []string) error {
+	if m.updateRecoveryCodeHashesFunc != nil {
+		return m.updateRecoveryCodeHashesFunc(ctx, id, recoveryCodeHashes)
+	}
+	return nil
+}
+
+func (m *mockUserRepository) ScheduleDeletion(ctx context.Context, id int64, at time.Time) error {
+	if m.scheduleDeletionFunc != nil {
+		return m.scheduleDeletionFunc(ctx, id, at)
+	}
+	return nil
+}
+
+func (m *mockUserRepository) CancelDeletion(ctx context.Context, id int64) error {
+	if m.cancelDeletionFunc != nil {
+		return m.cancelDeletionFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *mockUserRepository) ListDueForDeletion(ctx context.Context, before time.Time, limit int) ([]*domain.User, error) {
+	if m.listDueForDeletionFunc != nil {
+		return m.listDueForDeletionFunc(ctx, before, limit)
+	}
+	return nil, nil
+}
+
+func (m *mockUserRepository) Delete(ctx context.Context, id int64) error {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(ctx, id)
+	}
+	return nil
+}
+
 func TestNewUserUsecase(t *testing.T) {
 	mockRepo := &mockUserRepository{}
 	mockProfileRepo := &mockUserProfileRepository{}
@@ -490,3 +581,120 @@ func TestCheckUserProfileExists(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdatePassword(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name                   string
+		userID                 int64
+		passwordHash           string
+		mockUpdatePasswordHash func(ctx context.Context, id int64, passwordHash string) error
+		wantErr                bool
+	}{
+		{
+			name:         "successful update",
+			userID:       123,
+			passwordHash: "new_hashed_password",
+			mockUpdatePasswordHash: func(ctx context.Context, id int64, passwordHash string) error {
+				if id != 123 {
+					return errors.New("unexpected user ID")
+				}
+				if passwordHash != "new_hashed_password" {
+					return errors.New("unexpected password hash")
+				}
+				return nil
+			},
+			wantErr: false,
+		},
+		{
+			name:         "repository error",
+			userID:       456,
+			passwordHash: "new_hashed_password",
+			mockUpdatePasswordHash: func(ctx context.Context, id int64, passwordHash string) error {
+				return errors.New("database error")
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockUserRepository{
+				updatePasswordHashFunc: tt.mockUpdatePasswordHash,
+			}
+			mockProfileRepo := &mockUserProfileRepository{}
+			usecase := NewUserUsecase(mockRepo, mockProfileRepo)
+
+			err := usecase.UpdatePassword(ctx, tt.userID, tt.passwordHash)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdatePassword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateEmail(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name            string
+		userID          int64
+		email           string
+		mockGetByEmail  func(ctx context.Context, email string) (*domain.User, error)
+		wantStoredEmail string
+		wantErr         error
+	}{
+		{
+			name:   "successful update with normalization",
+			userID: 123,
+			email:  "  New@Example.COM ",
+			mockGetByEmail: func(ctx context.Context, email string) (*domain.User, error) {
+				return nil, nil
+			},
+			wantStoredEmail: "new@example.com",
+		},
+		{
+			name:   "email used by another user",
+			userID: 123,
+			email:  "taken@example.com",
+			mockGetByEmail: func(ctx context.Context, email string) (*domain.User, error) {
+				return &domain.User{ID: 456, Email: email}, nil
+			},
+			wantErr: ErrEmailAlreadyExists,
+		},
+		{
+			name:   "email already owned by the same user",
+			userID: 123,
+			email:  "mine@example.com",
+			mockGetByEmail: func(ctx context.Context, email string) (*domain.User, error) {
+				return &domain.User{ID: 123, Email: email}, nil
+			},
+			wantStoredEmail: "mine@example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var storedEmail string
+			mockRepo := &mockUserRepository{
+				getByEmailFunc: tt.mockGetByEmail,
+				updateEmailFunc: func(ctx context.Context, id int64, email string) error {
+					storedEmail = email
+					return nil
+				},
+			}
+			mockProfileRepo := &mockUserProfileRepository{}
+			usecase := NewUserUsecase(mockRepo, mockProfileRepo)
+
+			err := usecase.UpdateEmail(ctx, tt.userID, tt.email)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateEmail() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if storedEmail != tt.wantStoredEmail {
+				t.Errorf("Expected stored email '%s', got '%s'", tt.wantStoredEmail, storedEmail)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package util
+
+import "strings"
+
+// NormalizeEmail trims surrounding spaces and lowercases an email address so that
+// variants typed by the same person map to the same key
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
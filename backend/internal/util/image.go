@@ -0,0 +1,281 @@
+package util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // register decoder
+	"image/jpeg"
+	_ "image/png" // register decoder
+	"net/http"
+
+	_ "golang.org/x/image/webp" // register decoder
+)
+
+// Limits checked from the image header before decoding, so a small file cannot
+// expand into a huge bitmap (decompression bomb)
+const (
+	MaxImageDimension = 8192
+	MaxImagePixels    = 4096 * 4096
+)
+
+const renditionJPEGQuality = 85
+
+var (
+	ErrUnsupportedImage = errors.New("unsupported image format")
+	ErrImageTooLarge    = errors.New("image dimensions too large")
+)
+
+// decodableImageTypes are the formats with a registered decoder
+var decodableImageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// ImageRendition is a re-encoded, cropped copy of an image; Size is its width in pixels
+type ImageRendition struct {
+	Size        int
+	Data        []byte
+	ContentType string
+}
+
+// InspectImage sniffs the format from the magic bytes and checks the dimensions in the header
+// without decoding the pixels. It returns the detected content type.
+func InspectImage(data []byte) (string, error) {
+	// Content-Typeヘッダーは信用せず、先頭のバイト列から判定する
+	contentType := http.DetectContentType(data)
+	if !decodableImageTypes[contentType] {
+		return "", ErrUnsupportedImage
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return "", ErrUnsupportedImage
+	}
+	if cfg.Width > MaxImageDimension || cfg.Height > MaxImageDimension || cfg.Width*cfg.Height > MaxImagePixels {
+		return "", ErrImageTooLarge
+	}
+	return contentType, nil
+}
+
+// ProcessImage decodes an uploaded image and returns one square JPEG rendition per size.
+// The image is rotated according to its EXIF orientation, center-cropped and flattened
+// onto white. Re-encoding drops all metadata, including EXIF and GPS data.
+func ProcessImage(data []byte, sizes []int) ([]ImageRendition, error) {
+	return processImage(data, sizes, 1, 1)
+}
+
+// ProcessBannerImage is ProcessImage for 3:1 banners; sizes are the rendition widths
+func ProcessBannerImage(data []byte, widths []int) ([]ImageRendition, error) {
+	return processImage(data, widths, 3, 1)
+}
+
+// processImage produces renditions cropped to the aspectW:aspectH ratio
+func processImage(data []byte, widths []int, aspectW, aspectH int) ([]ImageRendition, error) {
+	contentType, err := InspectImage(data)
+	if err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+	}
+
+	canvas := flattenImage(img)
+	if contentType == "image/jpeg" {
+		canvas = applyOrientation(canvas, jpegOrientation(data))
+	}
+	cropped := cropAspect(canvas, aspectW, aspectH)
+
+	renditions := make([]ImageRendition, 0, len(widths))
+	for _, width := range widths {
+		height := max(width*aspectH/aspectW, 1)
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, resizeBox(cropped, width, height), &jpeg.Options{Quality: renditionJPEGQuality}); err != nil {
+			return nil, err
+		}
+		renditions = append(renditions, ImageRendition{
+			Size:        width,
+			Data:        buf.Bytes(),
+			ContentType: "image/jpeg",
+		})
+	}
+	return renditions, nil
+}
+
+// flattenImage copies the image into an opaque RGBA canvas with a white background
+func flattenImage(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	canvas := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(canvas, canvas.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(canvas, canvas.Bounds(), img, b.Min, draw.Over)
+	return canvas
+}
+
+// applyOrientation transforms the image so that orientation 1 (top-left) is upright
+func applyOrientation(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2: // 左右反転
+				sx, sy = w-1-x, y
+			case 3: // 180度回転
+				sx, sy = w-1-x, h-1-y
+			case 4: // 上下反転
+				sx, sy = x, h-1-y
+			case 5: // 転置
+				sx, sy = y, x
+			case 6: // 時計回りに90度回転
+				sx, sy = y, h-1-x
+			case 7: // 反転置
+				sx, sy = w-1-y, h-1-x
+			case 8: // 反時計回りに90度回転
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], src.Pix[src.PixOffset(sx, sy):src.PixOffset(sx, sy)+4])
+		}
+	}
+	return dst
+}
+
+// cropAspect returns the largest centered area of the image with the aspectW:aspectH ratio
+func cropAspect(src *image.RGBA, aspectW, aspectH int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w*aspectH > h*aspectW {
+		w = max(h*aspectW/aspectH, 1)
+	} else {
+		h = max(w*aspectH/aspectW, 1)
+	}
+	x0 := b.Min.X + (b.Dx()-w)/2
+	y0 := b.Min.Y + (b.Dy()-h)/2
+	return src.SubImage(image.Rect(x0, y0, x0+w, y0+h)).(*image.RGBA)
+}
+
+// resizeBox scales an image to width x height by averaging the source pixels
+// covered by each destination pixel; smaller sources are scaled up by repetition
+func resizeBox(src *image.RGBA, width, height int) *image.RGBA {
+	b := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := b.Min.Y + y*b.Dy()/height
+		y1 := max(b.Min.Y+(y+1)*b.Dy()/height, y0+1)
+		for x := 0; x < width; x++ {
+			x0 := b.Min.X + x*b.Dx()/width
+			x1 := max(b.Min.X+(x+1)*b.Dx()/width, x0+1)
+
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				i := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint64(src.Pix[i])
+					g += uint64(src.Pix[i+1])
+					bl += uint64(src.Pix[i+2])
+					a += uint64(src.Pix[i+3])
+					n++
+					i += 4
+				}
+			}
+
+			j := dst.PixOffset(x, y)
+			dst.Pix[j] = uint8(r / n)
+			dst.Pix[j+1] = uint8(g / n)
+			dst.Pix[j+2] = uint8(bl / n)
+			dst.Pix[j+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// jpegOrientation reads the EXIF orientation tag (0x0112) from a JPEG; 1 means upright or unknown
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return 1
+		}
+		marker := data[pos+1]
+		// SOS以降は画像データなのでEXIFは存在しない
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2 : pos+4]))
+		if length < 2 || pos+2+length > len(data) {
+			return 1
+		}
+		segment := data[pos+4 : pos+2+length]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return exifOrientation(segment[6:])
+		}
+		pos += 2 + length
+	}
+	return 1
+}
+
+// exifOrientation reads the orientation from IFD0 of a TIFF structure
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	if order.Uint16(tiff[2:4]) != 0x002A {
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[ifd : ifd+2]))
+	for i := 0; i < count; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		// tag 0x0112, type SHORT (3), count 1; the value is stored inline
+		if order.Uint16(tiff[entry:entry+2]) == 0x0112 && order.Uint16(tiff[entry+2:entry+4]) == 3 {
+			orientation := int(order.Uint16(tiff[entry+8 : entry+10]))
+			if orientation < 1 || orientation > 8 {
+				return 1
+			}
+			return orientation
+		}
+	}
+	return 1
+}
@@ -1,6 +1,9 @@
 package util
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -8,6 +11,15 @@ import (
 	"github.com/google/uuid"
 )
 
+// AccessTokenTTL is how long an access token stays valid after issuance
+const AccessTokenTTL = 15 * time.Minute
+
+// MagicLinkTTL is how long an emailed login link stays valid after issuance
+const MagicLinkTTL = 15 * time.Minute
+
+// magicLinkAudience keeps magic link tokens from being accepted anywhere else, such as an access token
+const magicLinkAudience = "magic_link"
+
 type TokenPair struct {
 	AccessToken  string
 	RefreshToken string
@@ -15,27 +27,49 @@ type TokenPair struct {
 }
 
 type JWTClaims struct {
-	UserID     int64  `json:"user_id"`
-	Email      string `json:"email"`
-	HasProfile bool   `json:"has_profile"`
+	UserID int64 `json:"user_id"`
+	// SessionID is the login session the token was issued for
+	SessionID   string   `json:"sid,omitempty"`
+	Email       string   `json:"email"`
+	HasProfile  bool     `json:"has_profile"`
+	Roles       []string `json:"roles,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	// IssuedAtNano carries the issuance time in nanoseconds, since iat is truncated to whole seconds
+	IssuedAtNano int64 `json:"iat_ns,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// GenerateAccessToken generates a JWT access token with 15 minutes expiration
-func GenerateAccessToken(userID int64, email string, hasProfile bool, secret string) (string, error) {
-	expirationTime := time.Now().Add(15 * time.Minute)
+// IssuedAtTime returns when the token was issued, falling back to iat for tokens without iat_ns
+func (c *JWTClaims) IssuedAtTime() time.Time {
+	if c.IssuedAtNano != 0 {
+		return time.Unix(0, c.IssuedAtNano)
+	}
+	if c.IssuedAt != nil {
+		return c.IssuedAt.Time
+	}
+	return time.Time{}
+}
+
+// GenerateAccessToken generates a JWT access token with 15 minutes expiration.
+// roles and permissions are a snapshot taken at issuance; a role change takes effect on the next token.
+func GenerateAccessToken(userID int64, sessionID, email string, hasProfile bool, roles, permissions []string, keys *JWTKeySet) (string, error) {
+	now := time.Now()
 	claims := &JWTClaims{
-		UserID:     userID,
-		Email:      email,
-		HasProfile: hasProfile,
+		UserID:       userID,
+		SessionID:    sessionID,
+		Email:        email,
+		HasProfile:   hasProfile,
+		Roles:        roles,
+		Permissions:  permissions,
+		IssuedAtNano: now.UnixNano(),
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ID:        uuid.New().String(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(AccessTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(secret))
+	tokenString, err := keys.Sign(claims)
 	if err != nil {
 		return "", err
 	}
@@ -49,20 +83,75 @@ func GenerateRefreshToken() (string, error) {
 	return refreshToken.String(), nil
 }
 
+// HashToken returns the SHA-256 hex digest of an opaque token so it can be stored without the raw value
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
 // ValidateAccessToken validates and parses a JWT access token
-func ValidateAccessToken(tokenString string, secret string) (*JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+func ValidateAccessToken(tokenString string, keys *JWTKeySet) (*JWTClaims, error) {
+	token, err := keys.Parse(tokenString, &JWTClaims{})
+	if err != nil {
+		return nil, err
+	}
+
+	if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid {
+		// 用途を限定したトークン（マジックリンクなど）はアクセストークンとして受け付けない
+		if len(claims.Audience) > 0 {
+			return nil, fmt.Errorf("invalid token")
 		}
-		return []byte(secret), nil
-	})
+		return claims, nil
+	}
+
+	return nil, fmt.Errorf("invalid token")
+}
+
+// MagicLinkClaims binds a login link to the user and the client that requested it.
+// NonceHash is the SHA-256 hash of a secret only the requesting client holds, since the client ID can be read from the token.
+type MagicLinkClaims struct {
+	UserID    int64  `json:"user_id"`
+	ClientID  string `json:"client_id"`
+	NonceHash string `json:"nonce_hash"`
+	jwt.RegisteredClaims
+}
 
+// MatchesNonce compares the nonce with the hash in the token in constant time
+func (c *MagicLinkClaims) MatchesNonce(nonce string) bool {
+	return nonce != "" && subtle.ConstantTimeCompare([]byte(c.NonceHash), []byte(HashToken(nonce))) == 1
+}
+
+// GenerateMagicLinkToken signs a single-use login token; its ID must be registered so that it can be consumed once
+func GenerateMagicLinkToken(userID int64, clientID, nonce string, keys *JWTKeySet) (string, *MagicLinkClaims, error) {
+	now := time.Now()
+	claims := &MagicLinkClaims{
+		UserID:    userID,
+		ClientID:  clientID,
+		NonceHash: HashToken(nonce),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Audience:  jwt.ClaimStrings{magicLinkAudience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(MagicLinkTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+
+	tokenString, err := keys.Sign(claims)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return tokenString, claims, nil
+}
+
+// ValidateMagicLinkToken verifies the signature, expiry and audience of a magic link token
+func ValidateMagicLinkToken(tokenString string, keys *JWTKeySet) (*MagicLinkClaims, error) {
+	token, err := keys.Parse(tokenString, &MagicLinkClaims{}, jwt.WithAudience(magicLinkAudience), jwt.WithExpirationRequired())
 	if err != nil {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid {
+	if claims, ok := token.Claims.(*MagicLinkClaims); ok && token.Valid && claims.ID != "" {
 		return claims, nil
 	}
 
@@ -0,0 +1,290 @@
+package util
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTKey is a single signing or verification key identified by its kid
+type JWTKey struct {
+	ID        string
+	Algorithm string
+	signKey   interface{}
+	verifyKey interface{}
+}
+
+// JWTKeySet holds the active signing key and every key still accepted for verification.
+// Tokens carrying a kid are verified with the matching key; tokens without a kid
+// fall back to the legacy HS256 secret, if one is configured.
+type JWTKeySet struct {
+	active *JWTKey
+	keys   map[string]*JWTKey
+	legacy *JWTKey
+}
+
+// JWK is the public representation of a verification key (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set document
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// PublicKey decodes an RSA, EC (P-256/P-384) or Ed25519 key published by another issuer
+func (k JWK) PublicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("jwk %q: invalid modulus: %w", k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("jwk %q: invalid exponent: %w", k.Kid, err)
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("jwk %q: unsupported curve %q", k.Kid, k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("jwk %q: invalid x: %w", k.Kid, err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("jwk %q: invalid y: %w", k.Kid, err)
+		}
+		return &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("jwk %q: unsupported curve %q", k.Kid, k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("jwk %q: invalid ed25519 key", k.Kid)
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("jwk %q: unsupported key type %q", k.Kid, k.Kty)
+}
+
+// NewHMACKeySet returns a key set that signs and verifies with a shared HS256 secret
+func NewHMACKeySet(secret string) *JWTKeySet {
+	key := &JWTKey{
+		Algorithm: jwt.SigningMethodHS256.Alg(),
+		signKey:   []byte(secret),
+		verifyKey: []byte(secret),
+	}
+	return &JWTKeySet{
+		active: key,
+		keys:   map[string]*JWTKey{},
+		legacy: key,
+	}
+}
+
+// LoadJWTKeySet builds a key set from PEM files named <kid>.pem in keyDir.
+// Private keys (RSA or Ed25519) can sign and verify; public keys are kept for
+// verification only, which lets retired keys outlive the tokens they signed.
+// When keyDir is empty the set falls back to HS256 with legacySecret.
+func LoadJWTKeySet(keyDir, activeKeyID, legacySecret string) (*JWTKeySet, error) {
+	if keyDir == "" {
+		if legacySecret == "" {
+			return nil, errors.New("jwt: either a key directory or a secret must be configured")
+		}
+		return NewHMACKeySet(legacySecret), nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(keyDir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("jwt: failed to list keys: %w", err)
+	}
+
+	ks := &JWTKeySet{keys: map[string]*JWTKey{}}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: failed to read %s: %w", path, err)
+		}
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+		key, err := ParseJWTKey(kid, data)
+		if err != nil {
+			return nil, err
+		}
+		ks.keys[kid] = key
+	}
+
+	active, ok := ks.keys[activeKeyID]
+	if !ok {
+		return nil, fmt.Errorf("jwt: active key %q not found in %s", activeKeyID, keyDir)
+	}
+	if active.signKey == nil {
+		return nil, fmt.Errorf("jwt: active key %q has no private key", activeKeyID)
+	}
+	ks.active = active
+
+	if legacySecret != "" {
+		ks.legacy = &JWTKey{
+			Algorithm: jwt.SigningMethodHS256.Alg(),
+			verifyKey: []byte(legacySecret),
+		}
+	}
+
+	return ks, nil
+}
+
+// ParseJWTKey parses a PEM encoded RSA or Ed25519 key
+func ParseJWTKey(kid string, data []byte) (*JWTKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("jwt: key %q is not PEM encoded", kid)
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("jwt: key %q has unsupported PEM type %q", kid, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jwt: failed to parse key %q: %w", kid, err)
+	}
+
+	return newJWTKey(kid, parsed)
+}
+
+func newJWTKey(kid string, parsed interface{}) (*JWTKey, error) {
+	key := &JWTKey{ID: kid}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Algorithm = jwt.SigningMethodRS256.Alg()
+		key.signKey = k
+		key.verifyKey = &k.PublicKey
+	case *rsa.PublicKey:
+		key.Algorithm = jwt.SigningMethodRS256.Alg()
+		key.verifyKey = k
+	case ed25519.PrivateKey:
+		key.Algorithm = jwt.SigningMethodEdDSA.Alg()
+		key.signKey = k
+		key.verifyKey = k.Public()
+	case ed25519.PublicKey:
+		key.Algorithm = jwt.SigningMethodEdDSA.Alg()
+		key.verifyKey = k
+	default:
+		return nil, fmt.Errorf("jwt: key %q has unsupported type %T", kid, parsed)
+	}
+	return key, nil
+}
+
+// Sign signs claims with the active key and sets the kid header
+func (ks *JWTKeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(ks.active.Algorithm), claims)
+	if ks.active.ID != "" {
+		token.Header["kid"] = ks.active.ID
+	}
+	return token.SignedString(ks.active.signKey)
+}
+
+// Parse verifies tokenString with the key named by its kid header and decodes it into claims
+func (ks *JWTKeySet) Parse(tokenString string, claims jwt.Claims, opts ...jwt.ParserOption) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(tokenString, claims, ks.keyFunc, opts...)
+}
+
+func (ks *JWTKeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	var key *JWTKey
+	if kid, ok := token.Header["kid"].(string); ok && kid != "" {
+		key = ks.keys[kid]
+		if key == nil {
+			return nil, fmt.Errorf("unknown key id: %s", kid)
+		}
+	} else {
+		key = ks.legacy
+		if key == nil {
+			return nil, errors.New("token has no key id")
+		}
+	}
+
+	// アルゴリズムの取り違え攻撃を防ぐため、鍵に紐づくアルゴリズムのみ許可
+	if token.Method.Alg() != key.Algorithm {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return key.verifyKey, nil
+}
+
+// JWKS returns the public verification keys; shared HMAC secrets are never published
+func (ks *JWTKeySet) JWKS() JWKS {
+	kids := make([]string, 0, len(ks.keys))
+	for kid := range ks.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	set := JWKS{Keys: make([]JWK, 0, len(kids))}
+	for _, kid := range kids {
+		key := ks.keys[kid]
+		switch pub := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			set.Keys = append(set.Keys, JWK{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				Alg: key.Algorithm,
+				N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			set.Keys = append(set.Keys, JWK{
+				Kty: "OKP",
+				Kid: kid,
+				Use: "sig",
+				Alg: key.Algorithm,
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(pub),
+			})
+		}
+	}
+	return set
+}
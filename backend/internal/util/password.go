@@ -0,0 +1,40 @@
+package util
+
+import "unicode"
+
+const (
+	// passwordMinCharClasses is how many of lower / upper / digit / symbol a password must mix
+	passwordMinCharClasses = 3
+	// passwordMinUniqueChars rejects passwords built by repeating a few characters, such as "Aa1Aa1Aa1"
+	passwordMinUniqueChars = 5
+)
+
+// IsStrongPassword reports whether the password satisfies the strength policy on top of the length limits
+func IsStrongPassword(password string) bool {
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+	unique := make(map[rune]struct{})
+	for _, r := range password {
+		unique[r] = struct{}{}
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsSpace(r):
+			// 空白は文字種として数えない
+		default:
+			hasSymbol = true
+		}
+	}
+
+	classes := 0
+	for _, has := range []bool{hasLower, hasUpper, hasDigit, hasSymbol} {
+		if has {
+			classes++
+		}
+	}
+
+	return classes >= passwordMinCharClasses && len(unique) >= passwordMinUniqueChars
+}
@@ -0,0 +1,24 @@
+package util
+
+import "strings"
+
+// PersonalAccessTokenPrefix marks personal access tokens so they can be told apart from JWTs and found by secret scanners
+const PersonalAccessTokenPrefix = "mzp_"
+
+// personalAccessTokenDisplayLen is how much of a token is kept in clear text to identify it in listings
+const personalAccessTokenDisplayLen = 12
+
+// GeneratePersonalAccessToken returns a new random personal access token and its displayable prefix
+func GeneratePersonalAccessToken() (token string, displayPrefix string, err error) {
+	random, err := GenerateURLSafeToken(32)
+	if err != nil {
+		return "", "", err
+	}
+	token = PersonalAccessTokenPrefix + random
+	return token, token[:personalAccessTokenDisplayLen], nil
+}
+
+// IsPersonalAccessToken reports whether a bearer token looks like a personal access token
+func IsPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, PersonalAccessTokenPrefix)
+}
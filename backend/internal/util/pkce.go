@@ -0,0 +1,27 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// GenerateURLSafeToken returns n random bytes encoded as unpadded base64url
+func GenerateURLSafeToken(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// GeneratePKCEVerifier generates an RFC 7636 code verifier (43 characters)
+func GeneratePKCEVerifier() (string, error) {
+	return GenerateURLSafeToken(32)
+}
+
+// PKCEChallengeS256 derives the S256 code challenge sent with the authorization request
+func PKCEChallengeS256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math/big"
+	"strings"
 )
 
 // GenerateVerificationCode generates a 6-digit verification code
@@ -14,3 +15,28 @@ func GenerateVerificationCode() (string, error) {
 	}
 	return fmt.Sprintf("%06d", n.Int64()), nil
 }
+
+// 見間違えやすい文字（0/o, 1/l/i）を除いた英数字
+const recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+// GenerateRecoveryCode generates a single-use recovery code formatted as xxxxx-xxxxx
+func GenerateRecoveryCode() (string, error) {
+	buf := make([]byte, 0, 11)
+	for i := 0; i < 10; i++ {
+		if i == 5 {
+			buf = append(buf, '-')
+		}
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(recoveryCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		buf = append(buf, recoveryCodeAlphabet[n.Int64()])
+	}
+	return string(buf), nil
+}
+
+// NormalizeRecoveryCode lowercases a recovery code and strips separators and spaces
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
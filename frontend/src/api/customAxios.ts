@@ -10,6 +10,12 @@ const isServer = typeof window === "undefined";
 let isRefreshing = false;
 let refreshQueue: (() => void)[] = [];
 
+// バックエンドのCSRF対策（double-submit）: csrf_token Cookie の値をヘッダーで送り返す
+const csrfConfig: AxiosRequestConfig = {
+  xsrfCookieName: "csrf_token",
+  xsrfHeaderName: "X-CSRF-Token",
+};
+
 const customAxios = async <T = unknown>(
   config: AxiosRequestConfig,
   options?: AxiosRequestConfig,
@@ -17,6 +23,7 @@ const customAxios = async <T = unknown>(
   const instance = axios.create({
     baseURL: isServer ? "http://backend:8080" : "/api",
     withCredentials: true,
+    ...csrfConfig,
   });
 
   // レスポンスインターセプター：401エラー時にリフレッシュ
@@ -62,6 +69,7 @@ const customAxios = async <T = unknown>(
           const refreshInstance = axios.create({
             baseURL: isServer ? "http://backend:8080" : "/api",
             withCredentials: true,
+            ...csrfConfig,
           });
 
           // リフレッシュAPIを呼び出し